@@ -45,6 +45,7 @@ const (
 	OboTokenPath                                = "obo_token_path"
 	ConfigFileProfileAttrName                   = "config_file_profile"
 	DefinedTagsToIgnore                         = "ignore_defined_tags"
+	ComputedDefinedTagNamespaces                = "computed_defined_tag_namespaces"
 	RealmSpecificServiceEndpointTemplateEnabled = "realm_specific_service_endpoint_template_enabled"
 
 	DefaultConfigFileName    = "config"
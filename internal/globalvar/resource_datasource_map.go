@@ -4,3 +4,7 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 var OciResources map[string]*schema.Resource
 var OciDatasources map[string]*schema.Resource
+
+// OciResourceAliases and OciDatasourceAliases map every alias to the name of the resource/datasource it stands for
+var OciResourceAliases map[string]string
+var OciDatasourceAliases map[string]string
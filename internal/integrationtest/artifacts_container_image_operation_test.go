@@ -0,0 +1,58 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+)
+
+var (
+	// the pre-canned container image of TF_VAR_container_image_ocid is deleted and restored again
+	ArtifactsContainerImageOperationRepresentation = map[string]interface{}{
+		"image_id":  acctest.Representation{RepType: acctest.Required, Create: imageId},
+		"operation": acctest.Representation{RepType: acctest.Required, Create: `delete`, Update: `restore`},
+	}
+)
+
+// issue-routing-tag: artifacts/default
+func TestArtifactsContainerImageOperationResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestArtifactsContainerImageOperationResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	resourceName := "oci_artifacts_container_image_operation.test_container_image_operation"
+
+	acctest.SaveConfigContent("", "", "", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify delete
+		{
+			Config: config +
+				acctest.GenerateResourceFromRepresentationMap("oci_artifacts_container_image_operation", "test_container_image_operation", acctest.Required, acctest.Create, ArtifactsContainerImageOperationRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "image_id", imageId),
+				resource.TestCheckResourceAttr(resourceName, "operation", "delete"),
+				resource.TestCheckResourceAttr(resourceName, "state", "DELETED"),
+			),
+		},
+		// verify restore
+		{
+			Config: config +
+				acctest.GenerateResourceFromRepresentationMap("oci_artifacts_container_image_operation", "test_container_image_operation", acctest.Required, acctest.Update, ArtifactsContainerImageOperationRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "image_id", imageId),
+				resource.TestCheckResourceAttr(resourceName, "operation", "restore"),
+				resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				resource.TestCheckResourceAttrSet(resourceName, "digest"),
+				resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+			),
+		},
+	})
+}
@@ -438,8 +438,8 @@ func TestAutoScalingAutoScalingConfigurationResource_scheduledExecution(t *testi
 
 				func(s *terraform.State) (err error) {
 					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
-					if resId == resId2 {
-						return fmt.Errorf("Resource updated when it was supposed to be recreated.")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
 					}
 					return err
 				},
@@ -647,8 +647,8 @@ func TestAutoScalingAutoScalingConfigurationResource_scheduledExecution_Resource
 
 				func(s *terraform.State) (err error) {
 					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
-					if resId == resId2 {
-						return fmt.Errorf("Resource updated when it was supposed to be recreated.")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
 					}
 					return err
 				},
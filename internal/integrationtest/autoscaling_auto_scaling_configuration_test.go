@@ -354,8 +354,8 @@ func TestAutoScalingAutoScalingConfigurationResource_basic(t *testing.T) {
 
 				func(s *terraform.State) (err error) {
 					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
-					if resId == resId2 {
-						return fmt.Errorf("Resource updated when it was supposed to be recreated.")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
 					}
 					return err
 				},
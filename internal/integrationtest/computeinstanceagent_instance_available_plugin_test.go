@@ -22,6 +22,11 @@ var (
 		"os_version":     acctest.Representation{RepType: acctest.Required, Create: `7.8`},
 	}
 
+	ComputeinstanceagentInstanceAvailablePluginFromImageDataSourceRepresentation = map[string]interface{}{
+		"compartment_id": acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"image_id":       acctest.Representation{RepType: acctest.Required, Create: `${var.InstanceImageOCID[var.region]}`},
+	}
+
 	ComputeinstanceagentInstanceAvailablePluginResourceConfig = ""
 )
 
@@ -56,5 +61,19 @@ func TestComputeinstanceagentInstanceAvailablePluginResource_basic(t *testing.T)
 				resource.TestCheckResourceAttrSet(datasourceName, "available_plugins.0.summary"),
 			),
 		},
+		// verify datasource with the operating system of an image
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_computeinstanceagent_instance_available_plugins", "test_instance_available_plugins", acctest.Required, acctest.Create, ComputeinstanceagentInstanceAvailablePluginFromImageDataSourceRepresentation) +
+				compartmentIdVariableStr + utils.OciImageIdsVariable + ComputeinstanceagentInstanceAvailablePluginResourceConfig,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(datasourceName, "image_id"),
+				resource.TestCheckResourceAttrSet(datasourceName, "os_name"),
+				resource.TestCheckResourceAttrSet(datasourceName, "os_version"),
+
+				resource.TestCheckResourceAttrSet(datasourceName, "available_plugins.#"),
+				resource.TestCheckResourceAttrSet(datasourceName, "available_plugins.0.name"),
+			),
+		},
 	})
 }
@@ -0,0 +1,140 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	ContainerengineClusterAutoscalerRepresentation = map[string]interface{}{
+		"cluster_id":                  acctest.Representation{RepType: acctest.Required, Create: `${oci_containerengine_cluster.test_cluster.id}`},
+		"node_pools":                  acctest.RepresentationGroup{RepType: acctest.Required, Group: ContainerengineClusterAutoscalerNodePoolsRepresentation},
+		"additional_configurations":   acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"scaleDownDelayAfterAdd": "10m"}, Update: map[string]string{"scaleDownDelayAfterAdd": "20m"}},
+		"balance_similar_node_groups": acctest.Representation{RepType: acctest.Optional, Create: `false`, Update: `true`},
+	}
+	ContainerengineClusterAutoscalerNodePoolsRepresentation = map[string]interface{}{
+		"max_size":     acctest.Representation{RepType: acctest.Required, Create: `3`, Update: `5`},
+		"min_size":     acctest.Representation{RepType: acctest.Required, Create: `1`, Update: `2`},
+		"node_pool_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_containerengine_node_pool.test_node_pool.id}`},
+	}
+
+	ContainerengineClusterAutoscalerResourceDependencies = NodePoolRequiredOnlyResource
+)
+
+// issue-routing-tag: containerengine/default
+func TestContainerengineClusterAutoscalerResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestContainerengineClusterAutoscalerResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_containerengine_cluster_autoscaler.test_cluster_autoscaler"
+
+	acctest.ResourceTest(t, testAccCheckContainerengineClusterAutoscalerDestroy, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + ContainerengineClusterAutoscalerResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_containerengine_cluster_autoscaler", "test_cluster_autoscaler", acctest.Optional, acctest.Create, ContainerengineClusterAutoscalerRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+				resource.TestCheckResourceAttr(resourceName, "additional_configurations.%", "1"),
+				resource.TestCheckResourceAttr(resourceName, "additional_configurations.scaleDownDelayAfterAdd", "10m"),
+				resource.TestCheckResourceAttr(resourceName, "balance_similar_node_groups", "false"),
+				resource.TestCheckResourceAttr(resourceName, "node_pools.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "node_pools.0.max_size", "3"),
+				resource.TestCheckResourceAttr(resourceName, "node_pools.0.min_size", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "node_pools.0.node_pool_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "configurations.#"),
+				resource.TestCheckResourceAttrSet(resourceName, "state"),
+			),
+		},
+		// verify updates to updatable parameters
+		{
+			Config: config + compartmentIdVariableStr + ContainerengineClusterAutoscalerResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_containerengine_cluster_autoscaler", "test_cluster_autoscaler", acctest.Optional, acctest.Update, ContainerengineClusterAutoscalerRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+				resource.TestCheckResourceAttr(resourceName, "additional_configurations.%", "1"),
+				resource.TestCheckResourceAttr(resourceName, "additional_configurations.scaleDownDelayAfterAdd", "20m"),
+				resource.TestCheckResourceAttr(resourceName, "balance_similar_node_groups", "true"),
+				resource.TestCheckResourceAttr(resourceName, "node_pools.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "node_pools.0.max_size", "5"),
+				resource.TestCheckResourceAttr(resourceName, "node_pools.0.min_size", "2"),
+				resource.TestCheckResourceAttrSet(resourceName, "node_pools.0.node_pool_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "state"),
+			),
+		},
+		// verify resource import
+		{
+			Config:            config + compartmentIdVariableStr + ContainerengineClusterAutoscalerResourceDependencies + acctest.GenerateResourceFromRepresentationMap("oci_containerengine_cluster_autoscaler", "test_cluster_autoscaler", acctest.Optional, acctest.Update, ContainerengineClusterAutoscalerRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateVerifyIgnore: []string{
+				"remove_addon_resources_on_delete",
+			},
+			ResourceName: resourceName,
+		},
+	})
+}
+
+func testAccCheckContainerengineClusterAutoscalerDestroy(s *terraform.State) error {
+	noResourceFound := true
+	client := acctest.TestAccProvider.Meta().(*tf_client.OracleClients).ContainerEngineClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "oci_containerengine_cluster_autoscaler" {
+			noResourceFound = false
+			request := oci_containerengine.GetAddonRequest{}
+
+			addonName := "ClusterAutoscaler"
+			request.AddonName = &addonName
+
+			if value, ok := rs.Primary.Attributes["cluster_id"]; ok {
+				request.ClusterId = &value
+			}
+
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "containerengine")
+
+			response, err := client.GetAddon(context.Background(), request)
+
+			if err == nil {
+				deletedLifecycleStates := map[string]bool{
+					string(oci_containerengine.AddonLifecycleStateDeleted): true,
+				}
+				if _, ok := deletedLifecycleStates[string(response.LifecycleState)]; !ok {
+					//resource lifecycle state is not in expected deleted lifecycle states.
+					return fmt.Errorf("resource lifecycle state: %s is not in expected deleted lifecycle states", response.LifecycleState)
+				}
+				//resource lifecycle state is in expected deleted lifecycle states. continue with next one.
+				continue
+			}
+
+			//Verify that exception is for '404 not found'.
+			if failure, isServiceError := common.IsServiceError(err); !isServiceError || failure.GetHTTPStatusCode() != 404 {
+				return err
+			}
+		}
+	}
+	if noResourceFound {
+		return fmt.Errorf("at least one resource was expected from the state file, but could not be found")
+	}
+
+	return nil
+}
@@ -18,6 +18,7 @@ var (
 	ContainerengineContainerengineClusterKubeConfigSingularDataSourceRepresentation = map[string]interface{}{
 		"cluster_id":    acctest.Representation{RepType: acctest.Required, Create: `${oci_containerengine_cluster.test_cluster.id}`},
 		"endpoint":      acctest.Representation{RepType: acctest.Optional, Create: `LEGACY_KUBERNETES`},
+		"exec_auth":     acctest.Representation{RepType: acctest.Optional, Create: `instance_principal`},
 		"token_version": acctest.Representation{RepType: acctest.Optional, Create: `2.0.0`},
 	}
 
@@ -55,7 +56,13 @@ func TestContainerengineClusterKubeConfigResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttrSet(singularDatasourceName, "cluster_id"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "endpoint", "LEGACY_KUBERNETES"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "token_version", "2.0.0"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "exec_auth", "instance_principal"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "cluster_ca_certificate"),
 				resource.TestCheckResourceAttrSet(singularDatasourceName, "content"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "exec.#", "1"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "exec.0.command", "oci"),
+				resource.TestCheckTypeSetElemAttr(singularDatasourceName, "exec.0.args.*", "instance_principal"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "server"),
 			),
 		},
 	})
@@ -0,0 +1,63 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	ContainerengineNodePoolNodeDataSourceRepresentation = map[string]interface{}{
+		"node_pool_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_containerengine_node_pool.test_node_pool.id}`},
+		"filter":       acctest.RepresentationGroup{RepType: acctest.Required, Group: ContainerengineNodePoolNodeDataSourceFilterRepresentation},
+	}
+	ContainerengineNodePoolNodeDataSourceFilterRepresentation = map[string]interface{}{
+		"name":   acctest.Representation{RepType: acctest.Required, Create: `node_pool_id`},
+		"values": acctest.Representation{RepType: acctest.Required, Create: []string{`${oci_containerengine_node_pool.test_node_pool.id}`}},
+	}
+
+	ContainerengineNodePoolNodeResourceConfig = ContainerengineNodePoolResourceConfig
+)
+
+// issue-routing-tag: containerengine/default
+func TestContainerengineNodePoolNodeResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestContainerengineNodePoolNodeResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	datasourceName := "data.oci_containerengine_node_pool_nodes.test_node_pool_nodes"
+
+	acctest.SaveConfigContent("", "", "", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify datasource
+		{
+			Config: config + compartmentIdVariableStr + ContainerengineNodePoolNodeResourceConfig +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_containerengine_node_pool_nodes", "test_node_pool_nodes", acctest.Required, acctest.Create, ContainerengineNodePoolNodeDataSourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(datasourceName, "node_pool_id"),
+
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.#"),
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.0.availability_domain"),
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.0.fault_domain"),
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.0.id"),
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.0.kubernetes_version"),
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.0.node_pool_id"),
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.0.state"),
+				resource.TestCheckResourceAttrSet(datasourceName, "nodes.0.subnet_id"),
+			),
+		},
+	})
+}
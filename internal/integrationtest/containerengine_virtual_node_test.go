@@ -0,0 +1,80 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	ContainerengineVirtualNodeSingularDataSourceRepresentation = map[string]interface{}{
+		"virtual_node_id":      acctest.Representation{RepType: acctest.Required, Create: `${data.oci_containerengine_virtual_nodes.test_virtual_nodes.virtual_nodes.0.id}`},
+		"virtual_node_pool_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_containerengine_virtual_node_pool.test_virtual_node_pool.id}`},
+	}
+
+	ContainerengineVirtualNodeDataSourceRepresentation = map[string]interface{}{
+		"virtual_node_pool_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_containerengine_virtual_node_pool.test_virtual_node_pool.id}`},
+	}
+
+	ContainerengineVirtualNodeResourceConfig = ContainerengineVirtualNodePoolResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_containerengine_virtual_node_pool", "test_virtual_node_pool", acctest.Required, acctest.Create, ContainerengineVirtualNodePoolRepresentation)
+)
+
+// issue-routing-tag: containerengine/default
+func TestContainerengineVirtualNodeResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestContainerengineVirtualNodeResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	datasourceName := "data.oci_containerengine_virtual_nodes.test_virtual_nodes"
+	singularDatasourceName := "data.oci_containerengine_virtual_node.test_virtual_node"
+
+	acctest.SaveConfigContent("", "", "", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify datasource
+		{
+			Config: config + compartmentIdVariableStr + ContainerengineVirtualNodeResourceConfig +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_containerengine_virtual_nodes", "test_virtual_nodes", acctest.Required, acctest.Create, ContainerengineVirtualNodeDataSourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(datasourceName, "virtual_node_pool_id"),
+
+				resource.TestCheckResourceAttr(datasourceName, "virtual_nodes.#", "1"),
+				resource.TestCheckResourceAttrSet(datasourceName, "virtual_nodes.0.display_name"),
+				resource.TestCheckResourceAttrSet(datasourceName, "virtual_nodes.0.id"),
+				resource.TestCheckResourceAttrSet(datasourceName, "virtual_nodes.0.kubernetes_version"),
+				resource.TestCheckResourceAttrSet(datasourceName, "virtual_nodes.0.state"),
+				resource.TestCheckResourceAttrSet(datasourceName, "virtual_nodes.0.virtual_node_pool_id"),
+			),
+		},
+		// verify singular datasource
+		{
+			Config: config + compartmentIdVariableStr + ContainerengineVirtualNodeResourceConfig +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_containerengine_virtual_nodes", "test_virtual_nodes", acctest.Required, acctest.Create, ContainerengineVirtualNodeDataSourceRepresentation) +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_containerengine_virtual_node", "test_virtual_node", acctest.Required, acctest.Create, ContainerengineVirtualNodeSingularDataSourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "virtual_node_id"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "virtual_node_pool_id"),
+
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "availability_domain"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "display_name"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "kubernetes_version"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "state"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "subnet_id"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "time_created"),
+			),
+		},
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	CoreBulkVolumeAttachmentRepresentation = map[string]interface{}{
+		"attachment_type": acctest.Representation{RepType: acctest.Required, Create: `paravirtualized`},
+		"instance_id":     acctest.Representation{RepType: acctest.Required, Create: `${oci_core_instance.test_instance.id}`},
+		"volumes": []acctest.RepresentationGroup{
+			{RepType: acctest.Required, Group: CoreBulkVolumeAttachmentVolumesRepresentation1},
+			{RepType: acctest.Required, Group: CoreBulkVolumeAttachmentVolumesRepresentation2},
+		},
+	}
+	CoreBulkVolumeAttachmentVolumesRepresentation1 = map[string]interface{}{
+		"volume_id":    acctest.Representation{RepType: acctest.Required, Create: `${oci_core_volume.test_volume.id}`},
+		"display_name": acctest.Representation{RepType: acctest.Optional, Create: `displayName1`},
+	}
+	CoreBulkVolumeAttachmentVolumesRepresentation2 = map[string]interface{}{
+		"volume_id":    acctest.Representation{RepType: acctest.Required, Create: `${oci_core_volume.test_volume2.id}`},
+		"device":       acctest.Representation{RepType: acctest.Optional, Create: `/dev/oracleoci/oraclevdd`},
+		"display_name": acctest.Representation{RepType: acctest.Optional, Create: `displayName2`},
+		"is_read_only": acctest.Representation{RepType: acctest.Optional, Create: `true`},
+	}
+
+	// Removing the first volume only detaches that volume, the attachment of the second volume is kept
+	CoreBulkVolumeAttachmentRemoveVolumeRepresentation = acctest.RepresentationCopyWithNewProperties(CoreBulkVolumeAttachmentRepresentation, map[string]interface{}{
+		"volumes": acctest.RepresentationGroup{RepType: acctest.Required, Group: CoreBulkVolumeAttachmentVolumesRepresentation2},
+	})
+
+	CoreBulkVolumeAttachmentResourceDependencies = CoreVolumeAttachmentResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_core_volume", "test_volume2", acctest.Required, acctest.Create, CoreVolumeRepresentation)
+)
+
+// issue-routing-tag: core/blockStorage
+func TestCoreBulkVolumeAttachmentResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreBulkVolumeAttachmentResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_bulk_volume_attachment.test_bulk_volume_attachment"
+
+	var attachmentId string
+
+	// Save TF content to Create resource with only required properties. This has to be exactly the same as the config part in the create step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+CoreBulkVolumeAttachmentResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_core_bulk_volume_attachment", "test_bulk_volume_attachment", acctest.Required, acctest.Create, CoreBulkVolumeAttachmentRepresentation), "core", "bulkVolumeAttachment", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + CoreBulkVolumeAttachmentResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_bulk_volume_attachment", "test_bulk_volume_attachment", acctest.Required, acctest.Create, CoreBulkVolumeAttachmentRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "attachment_type", "paravirtualized"),
+				resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.#", "2"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.0.device", "/dev/oracleoci/oraclevdb"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.0.state", "ATTACHED"),
+				resource.TestCheckResourceAttrSet(resourceName, "volumes.0.volume_attachment_id"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.1.device", "/dev/oracleoci/oraclevdc"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.1.state", "ATTACHED"),
+				resource.TestCheckResourceAttrSet(resourceName, "volumes.1.volume_attachment_id"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + CoreBulkVolumeAttachmentResourceDependencies,
+		},
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + CoreBulkVolumeAttachmentResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_bulk_volume_attachment", "test_bulk_volume_attachment", acctest.Optional, acctest.Create, CoreBulkVolumeAttachmentRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "volumes.#", "2"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.0.device", "/dev/oracleoci/oraclevdb"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.0.display_name", "displayName1"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.1.device", "/dev/oracleoci/oraclevdd"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.1.display_name", "displayName2"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.1.is_read_only", "true"),
+
+				func(s *terraform.State) (err error) {
+					attachmentId, err = acctest.FromInstanceState(s, resourceName, "volumes.1.volume_attachment_id")
+					return err
+				},
+			),
+		},
+
+		// verify removing a volume keeps the remaining attachment
+		{
+			Config: config + compartmentIdVariableStr + CoreBulkVolumeAttachmentResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_bulk_volume_attachment", "test_bulk_volume_attachment", acctest.Optional, acctest.Create, CoreBulkVolumeAttachmentRemoveVolumeRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "volumes.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.0.device", "/dev/oracleoci/oraclevdd"),
+				resource.TestCheckResourceAttr(resourceName, "volumes.0.display_name", "displayName2"),
+
+				func(s *terraform.State) (err error) {
+					updatedAttachmentId, err := acctest.FromInstanceState(s, resourceName, "volumes.0.volume_attachment_id")
+					if attachmentId != updatedAttachmentId {
+						return fmt.Errorf("volume was reattached when another volume was removed")
+					}
+					return err
+				},
+			),
+		},
+	})
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	byoipRangeCidrBlock            = utils.GetEnvSettingWithBlankDefault("byoip_range_cidr_block")
+	byoipRangeCidrBlockVariableStr = fmt.Sprintf("variable \"byoip_range_cidr_block\" { default = \"%s\" }\n", byoipRangeCidrBlock)
+
+	CoreByoipRangeRepresentation = map[string]interface{}{
+		"compartment_id": acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"cidr_block":     acctest.Representation{RepType: acctest.Required, Create: `${var.byoip_range_cidr_block}`},
+		"defined_tags":   acctest.Representation{RepType: acctest.Optional, Create: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "value")}`, Update: `${map("${oci_identity_tag_namespace.tag-namespace1.name}.${oci_identity_tag.tag1.name}", "updatedValue")}`},
+		"display_name":   acctest.Representation{RepType: acctest.Optional, Create: `displayName`, Update: `displayName2`},
+		"freeform_tags":  acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"Department": "Finance"}, Update: map[string]string{"Department": "Accounting"}},
+	}
+
+	CoreByoipRangeResourceDependencies = DefinedTagsDependencies + byoipRangeCidrBlockVariableStr
+)
+
+// issue-routing-tag: core/vcnip
+func TestCoreByoipRangeResource_crud(t *testing.T) {
+	httpreplay.SetScenario("TestCoreByoipRangeResource_crud")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_byoip_range.test_byoip_range"
+
+	var resId, resId2 string
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+CoreByoipRangeResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_core_byoip_range", "test_byoip_range", acctest.Optional, acctest.Create, CoreByoipRangeRepresentation), "core", "byoipRange", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + CoreByoipRangeResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_byoip_range", "test_byoip_range", acctest.Required, acctest.Create, CoreByoipRangeRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "cidr_block", byoipRangeCidrBlock),
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttr(resourceName, "is_advertised", "false"),
+				resource.TestCheckResourceAttr(resourceName, "state", "INACTIVE"),
+				resource.TestCheckResourceAttrSet(resourceName, "validation_token"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + CoreByoipRangeResourceDependencies,
+		},
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + CoreByoipRangeResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_byoip_range", "test_byoip_range", acctest.Optional, acctest.Create, CoreByoipRangeRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "cidr_block", byoipRangeCidrBlock),
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "id"),
+				resource.TestCheckResourceAttrSet(resourceName, "lifecycle_details"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify updates to updatable parameters
+		{
+			Config: config + compartmentIdVariableStr + CoreByoipRangeResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_byoip_range", "test_byoip_range", acctest.Optional, acctest.Update, CoreByoipRangeRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "defined_tags.%", "1"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+
+		// verify resource import
+		{
+			Config:            config + CoreByoipRangeResourceDependencies + compartmentIdVariableStr + acctest.GenerateResourceFromRepresentationMap("oci_core_byoip_range", "test_byoip_range", acctest.Optional, acctest.Update, CoreByoipRangeRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateVerifyIgnore: []string{
+				"validate_trigger",
+			},
+			ResourceName: resourceName,
+		},
+	})
+}
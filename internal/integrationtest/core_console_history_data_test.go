@@ -53,6 +53,17 @@ func TestCoreConsoleHistoryContentResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttrSet(singularDatasourceName, "console_history_id"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "length", "10240"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "offset", "0"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "bytes_remaining"),
+			),
+		},
+		// verify the whole history is read when no length is given
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_core_console_history_data", "test_console_history_content", acctest.Required, acctest.Create, CoreCoreConsoleHistoryContentSingularDataSourceRepresentation) +
+				compartmentIdVariableStr + CoreConsoleHistoryContentResourceConfig,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "console_history_id"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "bytes_remaining", "0"),
 			),
 		},
 	})
@@ -118,6 +118,7 @@ func TestCoreDedicatedVmHostResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(resourceName, "fault_domain", "FAULT-DOMAIN-3"),
 				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
 				resource.TestCheckResourceAttrSet(resourceName, "id"),
+				resource.TestCheckResourceAttr(resourceName, "placed_instances.#", "0"),
 				resource.TestCheckResourceAttrSet(resourceName, "remaining_ocpus"),
 				resource.TestCheckResourceAttrSet(resourceName, "state"),
 				resource.TestCheckResourceAttrSet(resourceName, "time_created"),
@@ -176,7 +176,8 @@ func TestCoreDrgAttachmentResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttrSet(resourceName, "compartment_id"),
 				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
 				resource.TestCheckResourceAttrSet(resourceName, "drg_id"),
-				resource.TestCheckResourceAttrSet(resourceName, "drg_route_table_id"),
+				resource.TestCheckResourceAttrPair(resourceName, "drg_route_table_id", "oci_core_drg_route_table.test_drg_route_table_2", "id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ATTACHED"),
 				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
 				resource.TestCheckResourceAttrSet(resourceName, "id"),
 				resource.TestCheckResourceAttr(resourceName, "network_details.#", "1"),
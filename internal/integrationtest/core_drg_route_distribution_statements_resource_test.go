@@ -0,0 +1,172 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	CoreDrgRouteDistributionStatementsResourceRepresentation = map[string]interface{}{
+		"drg_route_distribution_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_drg_route_distribution.test_drg_route_distribution.id}`},
+		"statements":                []acctest.RepresentationGroup{{RepType: acctest.Optional, Group: CoreDrgRouteDistributionStatementsResourceAttachmentTypeRepresentation}, {RepType: acctest.Optional, Group: CoreDrgRouteDistributionStatementsResourceAttachmentIdRepresentation}},
+	}
+	CoreDrgRouteDistributionStatementsResourceAttachmentTypeRepresentation = map[string]interface{}{
+		"action":         acctest.Representation{RepType: acctest.Required, Create: `ACCEPT`},
+		"priority":       acctest.Representation{RepType: acctest.Required, Create: `10`},
+		"match_criteria": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreDrgRouteDistributionStatementStatementsMatchCriteriaRepresentation},
+	}
+	CoreDrgRouteDistributionStatementsResourceAttachmentIdRepresentation = map[string]interface{}{
+		"action":         acctest.Representation{RepType: acctest.Required, Create: `ACCEPT`},
+		"priority":       acctest.Representation{RepType: acctest.Required, Create: `20`},
+		"match_criteria": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreDrgRouteDistributionStatementStatementsMatchCriteriaRepresentation3},
+	}
+)
+
+// issue-routing-tag: core/pnp
+func TestCoreDrgRouteDistributionStatementsResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreDrgRouteDistributionStatementsResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_drg_route_distribution_statements.test_drg_route_distribution_statements"
+
+	var attachmentIdStatementId string
+
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+CoreDrgRouteDistributionStatementResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_core_drg_route_distribution_statements", "test_drg_route_distribution_statements", acctest.Optional, acctest.Create, CoreDrgRouteDistributionStatementsResourceRepresentation), "core", "drgRouteDistributionStatements", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify statements sharing a priority are rejected at plan time
+		{
+			Config: config + compartmentIdVariableStr + CoreDrgRouteDistributionStatementResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_drg_route_distribution_statements", "test_drg_route_distribution_statements", acctest.Optional, acctest.Create,
+					acctest.GetUpdatedRepresentationCopy("statements", []acctest.RepresentationGroup{
+						{RepType: acctest.Optional, Group: CoreDrgRouteDistributionStatementsResourceAttachmentTypeRepresentation},
+						{RepType: acctest.Optional, Group: acctest.GetUpdatedRepresentationCopy("priority", acctest.Representation{RepType: acctest.Required, Create: `10`}, CoreDrgRouteDistributionStatementsResourceAttachmentIdRepresentation)},
+					}, CoreDrgRouteDistributionStatementsResourceRepresentation)),
+			ExpectError: regexp.MustCompile("priority 10 is used more than once"),
+		},
+
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + CoreDrgRouteDistributionStatementResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_drg_route_distribution_statements", "test_drg_route_distribution_statements", acctest.Optional, acctest.Create, CoreDrgRouteDistributionStatementsResourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "drg_route_distribution_id"),
+				resource.TestCheckResourceAttr(resourceName, "statements.#", "2"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "statements", map[string]string{
+					"action":                           "ACCEPT",
+					"priority":                         "10",
+					"match_criteria.0.match_type":      "DRG_ATTACHMENT_TYPE",
+					"match_criteria.0.attachment_type": "VCN",
+				},
+					[]string{
+						"id",
+					}),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "statements", map[string]string{
+					"action":                      "ACCEPT",
+					"priority":                    "20",
+					"match_criteria.0.match_type": "DRG_ATTACHMENT_ID",
+				},
+					[]string{
+						"id",
+						"match_criteria.0.drg_attachment_id",
+					}),
+
+				func(s *terraform.State) (err error) {
+					attachmentIdStatementId, err = drgRouteDistributionStatementIdFromState(s, resourceName, "20")
+					return err
+				},
+			),
+		},
+
+		// verify updates to one statement leave the other statements untouched
+		{
+			Config: config + compartmentIdVariableStr + CoreDrgRouteDistributionStatementResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_drg_route_distribution_statements", "test_drg_route_distribution_statements", acctest.Optional, acctest.Update, CoreDrgRouteDistributionStatementsResourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "statements.#", "2"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "statements", map[string]string{
+					"priority":                         "10",
+					"match_criteria.0.attachment_type": "VIRTUAL_CIRCUIT",
+				},
+					[]string{
+						"id",
+					}),
+
+				func(s *terraform.State) (err error) {
+					attachmentIdStatementId2, err := drgRouteDistributionStatementIdFromState(s, resourceName, "20")
+					if err != nil {
+						return err
+					}
+					if attachmentIdStatementId != attachmentIdStatementId2 {
+						return fmt.Errorf("unchanged distribution statement was recreated")
+					}
+					return nil
+				},
+			),
+		},
+
+		// verify removing a statement
+		{
+			Config: config + compartmentIdVariableStr + CoreDrgRouteDistributionStatementsResourceConfig,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "statements.#", "1"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "statements", map[string]string{
+					"priority": "20",
+				},
+					[]string{
+						"id",
+					}),
+			),
+		},
+
+		// verify resource import
+		{
+			Config:            config + compartmentIdVariableStr + CoreDrgRouteDistributionStatementsResourceConfig,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ResourceName:      resourceName,
+		},
+	})
+}
+
+var CoreDrgRouteDistributionStatementsResourceConfig = CoreDrgRouteDistributionStatementResourceDependencies +
+	acctest.GenerateResourceFromRepresentationMap("oci_core_drg_route_distribution_statements", "test_drg_route_distribution_statements", acctest.Optional, acctest.Update,
+		acctest.RepresentationCopyWithNewProperties(CoreDrgRouteDistributionStatementsResourceRepresentation, map[string]interface{}{
+			"statements": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreDrgRouteDistributionStatementsResourceAttachmentIdRepresentation},
+		}))
+
+func drgRouteDistributionStatementIdFromState(s *terraform.State, resourceName string, priority string) (string, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return "", fmt.Errorf("not found: %s", resourceName)
+	}
+
+	for key, value := range rs.Primary.Attributes {
+		if value != priority || !strings.HasSuffix(key, ".priority") {
+			continue
+		}
+		if id := rs.Primary.Attributes[strings.TrimSuffix(key, "priority")+"id"]; id != "" {
+			return id, nil
+		}
+	}
+
+	return "", fmt.Errorf("distribution statement with priority %s not found in %s", priority, resourceName)
+}
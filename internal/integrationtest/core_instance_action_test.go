@@ -0,0 +1,71 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	CoreInstanceActionRepresentation = map[string]interface{}{
+		"action":      acctest.Representation{RepType: acctest.Required, Create: `STOP`},
+		"instance_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_instance.test_instance.id}`},
+		"triggers":    acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"shape": "VM.Standard2.1"}},
+	}
+
+	CoreInstanceActionStartRepresentation = acctest.RepresentationCopyWithNewProperties(CoreInstanceActionRepresentation, map[string]interface{}{
+		"action": acctest.Representation{RepType: acctest.Required, Create: `START`},
+	})
+
+	CoreInstanceActionResourceDependencies = CoreInstanceResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_core_instance", "test_instance", acctest.Required, acctest.Create, CoreInstanceRepresentation)
+)
+
+// issue-routing-tag: core/computeSharedOwnershipVmAndBm
+func TestCoreInstanceActionResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreInstanceActionResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_instance_action.test_instance_action"
+
+	// Save TF content to Create resource with only required properties. This has to be exactly the same as the config part in the create step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+CoreInstanceActionResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_core_instance_action", "test_instance_action", acctest.Required, acctest.Create, CoreInstanceActionRepresentation), "core", "instanceAction", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify stop
+		{
+			Config: config + compartmentIdVariableStr + CoreInstanceActionResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_instance_action", "test_instance_action", acctest.Required, acctest.Create, CoreInstanceActionRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "action", "STOP"),
+				resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "STOPPED"),
+			),
+		},
+		// verify start with triggers, the change of action forces a new action to be performed
+		{
+			Config: config + compartmentIdVariableStr + CoreInstanceActionResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_instance_action", "test_instance_action", acctest.Optional, acctest.Create, CoreInstanceActionStartRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "action", "START"),
+				resource.TestCheckResourceAttrSet(resourceName, "instance_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+				resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+			),
+		},
+	})
+}
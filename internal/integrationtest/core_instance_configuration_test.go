@@ -86,6 +86,14 @@ var (
 		"instance_id":    acctest.Representation{RepType: acctest.Optional, Create: `${oci_core_instance.test_instance.id}`},
 		"source":         acctest.Representation{RepType: acctest.Optional, Create: `INSTANCE`},
 	}
+	CoreInstanceConfigurationFromInstanceDetailsRepresentation = map[string]interface{}{
+		"compartment_id":   acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"instance_details": acctest.RepresentationGroup{RepType: acctest.Required, Group: CoreInstanceConfigurationInstanceDetailsFromInstanceRepresentation},
+	}
+	CoreInstanceConfigurationInstanceDetailsFromInstanceRepresentation = map[string]interface{}{
+		"instance_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_instance.test_instance.id}`},
+		"source":      acctest.Representation{RepType: acctest.Required, Create: `INSTANCE`},
+	}
 	CoreInstanceConfigurationInstanceDetailsLaunchRepresentation = map[string]interface{}{
 		"instance_type":  acctest.Representation{RepType: acctest.Required, Create: `compute`},
 		"launch_details": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreInstanceConfigurationInstanceDetailsLaunchDetailsRepresentation},
@@ -495,6 +503,24 @@ func TestCoreInstanceConfigurationResource_basic(t *testing.T) {
 		{
 			Config: config + vaultIdVariableStr + kmsKeyIdVariableStr + compartmentIdVariableStr + CoreInstanceConfigurationResourceDependencies,
 		},
+		// verify Create from instance_details source
+		{
+			Config: config + compartmentIdVariableStr + CoreInstanceConfigurationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_instance_configuration", "test_instance_configuration", acctest.Required, acctest.Create, CoreInstanceConfigurationFromInstanceDetailsRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttr(resourceName, "instance_details.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "instance_details.0.instance_type", "compute"),
+				resource.TestCheckResourceAttr(resourceName, "instance_details.0.source", "INSTANCE"),
+				resource.TestCheckResourceAttrSet(resourceName, "instance_details.0.instance_id"),
+				resource.TestCheckResourceAttr(resourceName, "instance_details.0.launch_details.#", "1"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + CoreInstanceConfigurationResourceDependencies,
+		},
 
 		// verify Create with optionals launch_details for E3 flex micro shape
 		{
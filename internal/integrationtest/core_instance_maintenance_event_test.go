@@ -6,6 +6,7 @@ package integrationtest
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/oracle/terraform-provider-oci/httpreplay"
@@ -35,9 +36,13 @@ var (
 		"can_delete_local_storage":      acctest.Representation{RepType: acctest.Optional, Create: `false`, Update: `true`},
 		"display_name":                  acctest.Representation{RepType: acctest.Optional, Create: `displayName`, Update: `displayName2`},
 		"freeform_tags":                 acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"Department": "Finance"}, Update: map[string]string{"Department": "Accounting"}},
-		"time_window_start":             acctest.Representation{RepType: acctest.Optional, Create: `2006-01-02T15:04:05Z`, Update: `2025-01-12T15:04:05Z`},
+		"time_window_start":             acctest.Representation{RepType: acctest.Optional, Create: instanceMaintenanceEventTimeWindowStart, Update: instanceMaintenanceEventTimeWindowStartUpdated},
 	}
 
+	// The maintenance event can only be rescheduled to a window in the future
+	instanceMaintenanceEventTimeWindowStart        = time.Now().UTC().AddDate(0, 0, 1).Truncate(time.Hour).Format(time.RFC3339)
+	instanceMaintenanceEventTimeWindowStartUpdated = time.Now().UTC().AddDate(0, 0, 2).Truncate(time.Hour).Format(time.RFC3339)
+
 	CoreInstanceMaintenanceEventResourceDependencies = utils.OciImageIdsVariable
 	//	acctest.GenerateResourceFromRepresentationMap("oci_core_instance_maintenance_event", "test_instance_maintenance_event", acctest.Required, acctest.Create, CoreInstanceMaintenanceEventRepresentation) +
 	//	acctest.GenerateResourceFromRepresentationMap("oci_core_subnet", "test_subnet", acctest.Required, acctest.Create, acctest.RepresentationCopyWithNewProperties(CoreSubnetRepresentation, map[string]interface{}{
@@ -80,7 +85,7 @@ func TestCoreInstanceMaintenanceEventResource_basic(t *testing.T) {
 			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
 				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
 				resource.TestCheckResourceAttrSet(resourceName, "instance_maintenance_event_id"),
-				resource.TestCheckResourceAttr(resourceName, "time_window_start", `2025-01-12T15:04:05Z`),
+				resource.TestCheckResourceAttr(resourceName, "time_window_start", instanceMaintenanceEventTimeWindowStartUpdated),
 			),
 		},
 		// verify datasource
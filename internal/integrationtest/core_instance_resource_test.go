@@ -1131,6 +1131,7 @@ func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_preserveBoot
 						source_type = "bootVolume"
 						source_id = "{{.preservedBootVolumeId}}"
 						boot_volume_size_in_gbs = "60"
+						is_restart_on_boot_volume_resize_enabled = "true"
 					}
 					preserve_boot_volume = "false"
 					shape = "VM.Standard2.1"
@@ -1145,6 +1146,7 @@ func (s *ResourceCoreInstanceTestSuite) TestAccResourceCoreInstance_preserveBoot
 			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
 				resource.TestCheckResourceAttr(s.ResourceName, "preserve_boot_volume", "false"),
 				resource.TestCheckResourceAttr(s.ResourceName, "source_details.0.boot_volume_size_in_gbs", "60"),
+				resource.TestCheckResourceAttr(s.ResourceName, "source_details.0.is_restart_on_boot_volume_resize_enabled", "true"),
 				// Verify that we got a new Instance
 				func(ts *terraform.State) (err error) {
 					newId, err := acctest.FromInstanceState(ts, s.ResourceName, "id")
@@ -2331,6 +2331,28 @@ func TestCoreInstanceResource_capacityReservation(t *testing.T) {
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "boot_volume_id"),
 				),
 			},
+			// Step 5: verify an empty capacity_reservation_id removes the instance from its reservation in place
+			{
+				Config: config +
+					compartmentIdVariableStr +
+					instanceWithCapacityReservationResourceDependencies +
+					acctest.GenerateResourceFromRepresentationMap("oci_core_instance", "test_instance", acctest.Optional, acctest.Update,
+						acctest.RepresentationCopyWithNewProperties(instanceWithCapacityReservationRepresentation, map[string]interface{}{
+							"capacity_reservation_id": acctest.Representation{RepType: acctest.Optional, Create: ``},
+						})),
+				Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+					resource.TestCheckResourceAttr(resourceName, "capacity_reservation_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
 		},
 	})
 }
@@ -2635,6 +2657,28 @@ func TestCoreInstanceResource_flexShape(t *testing.T) {
 				),
 			},
 
+			// step 6.1: verify setting baseline_ocpu_utilization to BASELINE_1_1 updates the instance to a non-burstable baseline in place
+			{
+				Config: acctest.ProviderTestConfig() + compartmentIdVariableStr + managementEndpointStr + CoreInstanceResourceDependenciesWithoutDHV + utils.FlexVmImageIdsVariable +
+					acctest.GenerateResourceFromRepresentationMap("oci_core_instance", "test_instance", acctest.Optional, acctest.Update,
+						acctest.GetUpdatedRepresentationCopy("shape_config.baseline_ocpu_utilization", acctest.Representation{RepType: acctest.Required, Create: `BASELINE_1_1`}, instanceRepresentationForFlexShape)),
+				Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+					resource.TestCheckResourceAttr(resourceName, "shape_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shape_config.0.baseline_ocpu_utilization", "BASELINE_1_1"),
+					resource.TestCheckResourceAttr(resourceName, "shape_config.0.memory_in_gbs", "4"),
+					resource.TestCheckResourceAttr(resourceName, "shape_config.0.ocpus", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "RUNNING"),
+
+					func(s *terraform.State) (err error) {
+						resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+						if resId != resId2 {
+							return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+						}
+						return err
+					},
+				),
+			},
+
 			// step 7: verify datasource
 			{
 				Config: acctest.ProviderTestConfig() +
@@ -0,0 +1,175 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	CoreNetworkSecurityGroupSecurityRulesResourceRepresentation = map[string]interface{}{
+		"network_security_group_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_network_security_group.test_network_security_group.id}`},
+		"security_rules":            []acctest.RepresentationGroup{{RepType: acctest.Optional, Group: CoreNetworkSecurityGroupSecurityRulesResourceIngressRepresentation}, {RepType: acctest.Optional, Group: CoreNetworkSecurityGroupSecurityRulesResourceEgressRepresentation}},
+	}
+	CoreNetworkSecurityGroupSecurityRulesResourceIngressRepresentation = map[string]interface{}{
+		"direction":   acctest.Representation{RepType: acctest.Required, Create: `INGRESS`},
+		"protocol":    acctest.Representation{RepType: acctest.Required, Create: `6`},
+		"description": acctest.Representation{RepType: acctest.Optional, Create: `ssh`, Update: `ssh from the vcn`},
+		"source":      acctest.Representation{RepType: acctest.Optional, Create: `10.0.0.0/16`},
+		"tcp_options": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreNetworkSecurityGroupSecurityRulesResourceTcpOptionsRepresentation},
+	}
+	CoreNetworkSecurityGroupSecurityRulesResourceEgressRepresentation = map[string]interface{}{
+		"direction":   acctest.Representation{RepType: acctest.Required, Create: `EGRESS`},
+		"protocol":    acctest.Representation{RepType: acctest.Required, Create: `all`},
+		"destination": acctest.Representation{RepType: acctest.Optional, Create: `0.0.0.0/0`},
+	}
+	CoreNetworkSecurityGroupSecurityRulesResourceTcpOptionsRepresentation = map[string]interface{}{
+		"destination_port_range": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreNetworkSecurityGroupSecurityRulesResourceTcpOptionsDestinationPortRangeRepresentation},
+	}
+	CoreNetworkSecurityGroupSecurityRulesResourceTcpOptionsDestinationPortRangeRepresentation = map[string]interface{}{
+		"max": acctest.Representation{RepType: acctest.Required, Create: `22`},
+		"min": acctest.Representation{RepType: acctest.Required, Create: `22`},
+	}
+)
+
+// issue-routing-tag: core/virtualNetwork
+func TestCoreNetworkSecurityGroupSecurityRulesResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreNetworkSecurityGroupSecurityRulesResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_network_security_group_security_rules.test_network_security_group_security_rules"
+
+	var egressRuleId string
+
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+CoreNetworkSecurityGroupSecurityRuleResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group_security_rules", "test_network_security_group_security_rules", acctest.Optional, acctest.Create, CoreNetworkSecurityGroupSecurityRulesResourceRepresentation), "core", "networkSecurityGroupSecurityRules", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + CoreNetworkSecurityGroupSecurityRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group_security_rules", "test_network_security_group_security_rules", acctest.Optional, acctest.Create, CoreNetworkSecurityGroupSecurityRulesResourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "network_security_group_id"),
+				resource.TestCheckResourceAttr(resourceName, "security_rules.#", "2"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "security_rules", map[string]string{
+					"description": "ssh",
+					"direction":   "INGRESS",
+					"protocol":    "6",
+					"source":      "10.0.0.0/16",
+					"source_type": "CIDR_BLOCK",
+					"tcp_options.0.destination_port_range.0.max": "22",
+					"tcp_options.0.destination_port_range.0.min": "22",
+				},
+					[]string{
+						"id",
+						"time_created",
+					}),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "security_rules", map[string]string{
+					"destination":      "0.0.0.0/0",
+					"destination_type": "CIDR_BLOCK",
+					"direction":        "EGRESS",
+					"protocol":         "all",
+				},
+					[]string{
+						"id",
+					}),
+
+				func(s *terraform.State) (err error) {
+					egressRuleId, err = egressSecurityRuleIdFromState(s, resourceName)
+					return err
+				},
+			),
+		},
+
+		// verify updates to one rule leave the other rules untouched
+		{
+			Config: config + compartmentIdVariableStr + CoreNetworkSecurityGroupSecurityRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group_security_rules", "test_network_security_group_security_rules", acctest.Optional, acctest.Update, CoreNetworkSecurityGroupSecurityRulesResourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "security_rules.#", "2"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "security_rules", map[string]string{
+					"description": "ssh from the vcn",
+					"direction":   "INGRESS",
+				},
+					[]string{
+						"id",
+					}),
+
+				func(s *terraform.State) (err error) {
+					egressRuleId2, err := egressSecurityRuleIdFromState(s, resourceName)
+					if err != nil {
+						return err
+					}
+					if egressRuleId != egressRuleId2 {
+						return fmt.Errorf("unchanged security rule was recreated")
+					}
+					return nil
+				},
+			),
+		},
+
+		// verify removing a rule
+		{
+			Config: config + compartmentIdVariableStr + CoreNetworkSecurityGroupSecurityRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group_security_rules", "test_network_security_group_security_rules", acctest.Optional, acctest.Update,
+					acctest.RepresentationCopyWithNewProperties(CoreNetworkSecurityGroupSecurityRulesResourceRepresentation, map[string]interface{}{
+						"security_rules": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreNetworkSecurityGroupSecurityRulesResourceEgressRepresentation},
+					})),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "security_rules.#", "1"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "security_rules", map[string]string{
+					"direction": "EGRESS",
+				},
+					[]string{}),
+			),
+		},
+
+		// verify resource import
+		{
+			Config:            config + compartmentIdVariableStr + CoreNetworkSecurityGroupSecurityRulesResourceConfig,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ResourceName:      resourceName,
+		},
+	})
+}
+
+var CoreNetworkSecurityGroupSecurityRulesResourceConfig = CoreNetworkSecurityGroupSecurityRuleResourceDependencies +
+	acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group_security_rules", "test_network_security_group_security_rules", acctest.Optional, acctest.Update,
+		acctest.RepresentationCopyWithNewProperties(CoreNetworkSecurityGroupSecurityRulesResourceRepresentation, map[string]interface{}{
+			"security_rules": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreNetworkSecurityGroupSecurityRulesResourceEgressRepresentation},
+		}))
+
+func egressSecurityRuleIdFromState(s *terraform.State, resourceName string) (string, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return "", fmt.Errorf("not found: %s", resourceName)
+	}
+
+	for key, value := range rs.Primary.Attributes {
+		if value != "EGRESS" || !strings.HasSuffix(key, ".direction") {
+			continue
+		}
+		if id := rs.Primary.Attributes[strings.TrimSuffix(key, "direction")+"id"]; id != "" {
+			return id, nil
+		}
+	}
+
+	return "", fmt.Errorf("egress security rule not found in %s", resourceName)
+}
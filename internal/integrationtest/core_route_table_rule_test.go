@@ -0,0 +1,115 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	CoreRouteTableRuleInternetGatewayRepresentation = map[string]interface{}{
+		"route_table_id":    acctest.Representation{RepType: acctest.Required, Create: `${oci_core_vcn.test_vcn.default_route_table_id}`},
+		"destination":       acctest.Representation{RepType: acctest.Required, Create: `0.0.0.0/0`},
+		"network_entity_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_internet_gateway.test_internet_gateway.id}`},
+		"description":       acctest.Representation{RepType: acctest.Optional, Create: `internet`, Update: `internet access`},
+		"destination_type":  acctest.Representation{RepType: acctest.Optional, Create: `CIDR_BLOCK`},
+	}
+	CoreRouteTableRuleNatGatewayRepresentation = map[string]interface{}{
+		"route_table_id":    acctest.Representation{RepType: acctest.Required, Create: `${oci_core_vcn.test_vcn.default_route_table_id}`},
+		"destination":       acctest.Representation{RepType: acctest.Required, Create: `192.168.0.0/16`},
+		"network_entity_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_nat_gateway.test_nat_gateway.id}`},
+	}
+
+	CoreRouteTableRuleResourceDependencies = CoreRouteTableResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_core_nat_gateway", "test_nat_gateway", acctest.Required, acctest.Create, CoreNatGatewayRepresentation)
+)
+
+// issue-routing-tag: core/virtualNetwork
+func TestCoreRouteTableRuleResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreRouteTableRuleResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	internetResourceName := "oci_core_route_table_rule.test_internet_route"
+	natResourceName := "oci_core_route_table_rule.test_nat_route"
+
+	var resId, resId2 string
+
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+CoreRouteTableRuleResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_core_route_table_rule", "test_internet_route", acctest.Optional, acctest.Create, CoreRouteTableRuleInternetGatewayRepresentation), "core", "routeTableRule", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify rules added concurrently to the default route table
+		{
+			Config: config + compartmentIdVariableStr + CoreRouteTableRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_route_table_rule", "test_internet_route", acctest.Optional, acctest.Create, CoreRouteTableRuleInternetGatewayRepresentation) +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_route_table_rule", "test_nat_route", acctest.Required, acctest.Create, CoreRouteTableRuleNatGatewayRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(internetResourceName, "route_table_id"),
+				resource.TestCheckResourceAttr(internetResourceName, "description", "internet"),
+				resource.TestCheckResourceAttr(internetResourceName, "destination", "0.0.0.0/0"),
+				resource.TestCheckResourceAttr(internetResourceName, "destination_type", "CIDR_BLOCK"),
+				resource.TestCheckResourceAttrSet(internetResourceName, "network_entity_id"),
+
+				resource.TestCheckResourceAttr(natResourceName, "destination", "192.168.0.0/16"),
+				resource.TestCheckResourceAttr(natResourceName, "destination_type", "CIDR_BLOCK"),
+				resource.TestCheckResourceAttrSet(natResourceName, "network_entity_id"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, internetResourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify updates to updatable parameters
+		{
+			Config: config + compartmentIdVariableStr + CoreRouteTableRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_route_table_rule", "test_internet_route", acctest.Optional, acctest.Update, CoreRouteTableRuleInternetGatewayRepresentation) +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_route_table_rule", "test_nat_route", acctest.Required, acctest.Create, CoreRouteTableRuleNatGatewayRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(internetResourceName, "description", "internet access"),
+				resource.TestCheckResourceAttr(natResourceName, "destination", "192.168.0.0/16"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, internetResourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+
+		// verify removing one rule leaves the other rule in place
+		{
+			Config: config + compartmentIdVariableStr + CoreRouteTableRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_route_table_rule", "test_nat_route", acctest.Required, acctest.Create, CoreRouteTableRuleNatGatewayRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(natResourceName, "destination", "192.168.0.0/16"),
+			),
+		},
+
+		// verify resource import
+		{
+			Config: config + compartmentIdVariableStr + CoreRouteTableRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_route_table_rule", "test_nat_route", acctest.Required, acctest.Create, CoreRouteTableRuleNatGatewayRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ResourceName:      natResourceName,
+		},
+	})
+}
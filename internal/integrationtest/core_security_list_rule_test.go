@@ -0,0 +1,109 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	CoreSecurityListRuleIngressRepresentation = map[string]interface{}{
+		"security_list_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_security_list.test_security_list.id}`},
+		"direction":        acctest.Representation{RepType: acctest.Required, Create: `INGRESS`},
+		"protocol":         acctest.Representation{RepType: acctest.Required, Create: `6`},
+		"description":      acctest.Representation{RepType: acctest.Optional, Create: `https`},
+		"source":           acctest.Representation{RepType: acctest.Required, Create: `10.0.0.0/16`},
+		"tcp_options":      acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreSecurityListRuleTcpOptionsRepresentation},
+	}
+	CoreSecurityListRuleEgressRepresentation = map[string]interface{}{
+		"security_list_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_security_list.test_security_list.id}`},
+		"direction":        acctest.Representation{RepType: acctest.Required, Create: `EGRESS`},
+		"protocol":         acctest.Representation{RepType: acctest.Required, Create: `all`},
+		"destination":      acctest.Representation{RepType: acctest.Required, Create: `0.0.0.0/0`},
+	}
+	CoreSecurityListRuleTcpOptionsRepresentation = map[string]interface{}{
+		"destination_port_range": acctest.RepresentationGroup{RepType: acctest.Optional, Group: CoreSecurityListRuleTcpOptionsDestinationPortRangeRepresentation},
+	}
+	CoreSecurityListRuleTcpOptionsDestinationPortRangeRepresentation = map[string]interface{}{
+		"max": acctest.Representation{RepType: acctest.Required, Create: `443`},
+		"min": acctest.Representation{RepType: acctest.Required, Create: `443`},
+	}
+
+	CoreSecurityListRuleSecurityListRepresentation = acctest.RepresentationCopyWithNewProperties(acctest.GetRepresentationCopyWithMultipleRemovedProperties([]string{"egress_security_rules", "ingress_security_rules"}, CoreSecurityListRepresentation), map[string]interface{}{
+		"lifecycle": acctest.RepresentationGroup{RepType: acctest.Required, Group: CoreSecurityListRuleIgnoreRulesRepresentation},
+	})
+	CoreSecurityListRuleIgnoreRulesRepresentation = map[string]interface{}{
+		"ignore_changes": acctest.Representation{RepType: acctest.Required, Create: []string{`ingress_security_rules`, `egress_security_rules`}},
+	}
+
+	CoreSecurityListRuleResourceDependencies = CoreSecurityListResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_core_security_list", "test_security_list", acctest.Required, acctest.Create, CoreSecurityListRuleSecurityListRepresentation)
+)
+
+// issue-routing-tag: core/virtualNetwork
+func TestCoreSecurityListRuleResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreSecurityListRuleResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	ingressResourceName := "oci_core_security_list_rule.test_ingress_rule"
+	egressResourceName := "oci_core_security_list_rule.test_egress_rule"
+
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+CoreSecurityListRuleResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_core_security_list_rule", "test_ingress_rule", acctest.Optional, acctest.Create, CoreSecurityListRuleIngressRepresentation), "core", "securityListRule", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify rules added concurrently to the same security list
+		{
+			Config: config + compartmentIdVariableStr + CoreSecurityListRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_security_list_rule", "test_ingress_rule", acctest.Optional, acctest.Create, CoreSecurityListRuleIngressRepresentation) +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_security_list_rule", "test_egress_rule", acctest.Required, acctest.Create, CoreSecurityListRuleEgressRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(ingressResourceName, "security_list_id"),
+				resource.TestCheckResourceAttr(ingressResourceName, "description", "https"),
+				resource.TestCheckResourceAttr(ingressResourceName, "direction", "INGRESS"),
+				resource.TestCheckResourceAttr(ingressResourceName, "protocol", "6"),
+				resource.TestCheckResourceAttr(ingressResourceName, "source", "10.0.0.0/16"),
+				resource.TestCheckResourceAttr(ingressResourceName, "source_type", "CIDR_BLOCK"),
+				resource.TestCheckResourceAttr(ingressResourceName, "stateless", "false"),
+				resource.TestCheckResourceAttr(ingressResourceName, "tcp_options.0.destination_port_range.0.max", "443"),
+				resource.TestCheckResourceAttr(ingressResourceName, "tcp_options.0.destination_port_range.0.min", "443"),
+
+				resource.TestCheckResourceAttrSet(egressResourceName, "security_list_id"),
+				resource.TestCheckResourceAttr(egressResourceName, "destination", "0.0.0.0/0"),
+				resource.TestCheckResourceAttr(egressResourceName, "destination_type", "CIDR_BLOCK"),
+				resource.TestCheckResourceAttr(egressResourceName, "direction", "EGRESS"),
+				resource.TestCheckResourceAttr(egressResourceName, "protocol", "all"),
+			),
+		},
+
+		// verify removing one rule leaves the other rule in place
+		{
+			Config: config + compartmentIdVariableStr + CoreSecurityListRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_security_list_rule", "test_egress_rule", acctest.Required, acctest.Create, CoreSecurityListRuleEgressRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(egressResourceName, "direction", "EGRESS"),
+			),
+		},
+
+		// verify resource import
+		{
+			Config:            config + compartmentIdVariableStr + CoreSecurityListRuleResourceDependencies + acctest.GenerateResourceFromRepresentationMap("oci_core_security_list_rule", "test_egress_rule", acctest.Required, acctest.Create, CoreSecurityListRuleEgressRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ResourceName:      egressResourceName,
+		},
+	})
+}
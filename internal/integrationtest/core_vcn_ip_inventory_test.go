@@ -0,0 +1,85 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	CoreVcnIpInventorySingularDataSourceRepresentation = map[string]interface{}{
+		"vcn_id":         acctest.Representation{RepType: acctest.Required, Create: `${var.vcn_id}`},
+		"compartment_id": acctest.Representation{RepType: acctest.Optional, Create: `${var.compartment_id}`},
+	}
+	CoreVcnIpInventorySubtreeSingularDataSourceRepresentation = acctest.RepresentationCopyWithNewProperties(CoreVcnIpInventorySingularDataSourceRepresentation, map[string]interface{}{
+		"compartment_id":            acctest.Representation{RepType: acctest.Required, Create: `${var.tenancy_ocid}`},
+		"compartment_id_in_subtree": acctest.Representation{RepType: acctest.Required, Create: `true`},
+	})
+)
+
+// issue-routing-tag: core/vcnip
+func TestCoreVcnIpInventoryResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestCoreVcnIpInventoryResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	vcnId := utils.GetEnvSettingWithBlankDefault("vcn_ocid")
+	vcnIdVariableStr := fmt.Sprintf("variable \"vcn_id\" { default = \"%s\" }\n", vcnId)
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	singularDatasourceName := "data.oci_core_vcn_ip_inventory.test_vcn_ip_inventory"
+
+	acctest.SaveConfigContent("", "", "", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify singular datasource, the compartment defaults to the one of the VCN
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_core_vcn_ip_inventory", "test_vcn_ip_inventory", acctest.Required, acctest.Create, CoreVcnIpInventorySingularDataSourceRepresentation) +
+				compartmentIdVariableStr + vcnIdVariableStr,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(singularDatasourceName, "vcn_id", vcnId),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "compartment_id"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_address_count"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.#"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.0.ip_id"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.0.ip_address"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.0.assigned_resource_type"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.0.subnet_id"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.0.vnic_id"),
+			),
+		},
+		// verify singular datasource with an explicit compartment
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_core_vcn_ip_inventory", "test_vcn_ip_inventory", acctest.Optional, acctest.Create, CoreVcnIpInventorySingularDataSourceRepresentation) +
+				compartmentIdVariableStr + vcnIdVariableStr,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(singularDatasourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.#"),
+			),
+		},
+		// verify singular datasource across the compartments of the tenancy
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_core_vcn_ip_inventory", "test_vcn_ip_inventory", acctest.Required, acctest.Create, CoreVcnIpInventorySubtreeSingularDataSourceRepresentation) +
+				compartmentIdVariableStr + vcnIdVariableStr,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(singularDatasourceName, "compartment_id_in_subtree", "true"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.#"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "ip_addresses.0.subnet_id"),
+			),
+		},
+	})
+}
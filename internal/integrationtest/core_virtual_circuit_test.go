@@ -392,6 +392,8 @@ func TestCoreVirtualCircuitResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(resourceName, "customer_asn", "10"),
 				resource.TestCheckResourceAttrSet(resourceName, "gateway_id"),
 				resource.TestCheckResourceAttrSet(resourceName, "provider_service_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "provider_name"),
+				resource.TestCheckResourceAttrSet(resourceName, "provider_service_name"),
 				resource.TestCheckResourceAttr(resourceName, "provider_state", "INACTIVE"),
 				resource.TestCheckResourceAttr(resourceName, "type", "PRIVATE"),
 
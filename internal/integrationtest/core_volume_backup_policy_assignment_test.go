@@ -41,6 +41,14 @@ var (
 		"xrc_kms_key_id": acctest.Representation{RepType: acctest.Optional, Create: `${var.kms_key_ocid_cross_region}`},
 	}
 
+	CoreVolumeGroupBackupPolicyAssignmentRepresentation = acctest.RepresentationCopyWithNewProperties(CoreVolumeBackupPolicyAssignmentRepresentation, map[string]interface{}{
+		"asset_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_core_volume_group.test_volume_group.id}`},
+	})
+
+	CoreVolumeGroupBackupPolicyAssignmentResourceDependencies = utils.VolumeBackupPolicyDependency +
+		acctest.GenerateResourceFromRepresentationMap("oci_core_volume_group", "test_volume_group", acctest.Required, acctest.Create, CoreVolumeGroupRepresentation) +
+		VolumeGroupRequiredOnlyResourceDependencies
+
 	CoreVolumeBackupPolicyAssignmentResourceDependencies = utils.VolumeBackupPolicyDependency +
 		acctest.GenerateResourceFromRepresentationMap("oci_core_volume", "test_volume", acctest.Required, acctest.Create, CoreVolumeRepresentation) +
 		AvailabilityDomainConfig +
@@ -127,6 +135,31 @@ func TestCoreVolumeBackupPolicyAssignmentResource_basic(t *testing.T) {
 	})
 }
 
+// issue-routing-tag: core/blockStorage
+func TestCoreVolumeBackupPolicyAssignmentResource_volumeGroup(t *testing.T) {
+	httpreplay.SetScenario("TestCoreVolumeBackupPolicyAssignmentResource_volumeGroup")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_volume_backup_policy_assignment.test_volume_backup_policy_assignment"
+
+	acctest.ResourceTest(t, testAccCheckCoreVolumeBackupPolicyAssignmentDestroy, []resource.TestStep{
+		// verify Create with a volume group as the asset
+		{
+			Config: config + compartmentIdVariableStr + CoreVolumeGroupBackupPolicyAssignmentResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_volume_backup_policy_assignment", "test_volume_backup_policy_assignment", acctest.Required, acctest.Create, CoreVolumeGroupBackupPolicyAssignmentRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrPair(resourceName, "asset_id", "oci_core_volume_group.test_volume_group", "id"),
+				resource.TestCheckResourceAttrSet(resourceName, "policy_id"),
+			),
+		},
+	})
+}
+
 func testAccCheckCoreVolumeBackupPolicyAssignmentDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := acctest.TestAccProvider.Meta().(*tf_client.OracleClients).BlockstorageClient()
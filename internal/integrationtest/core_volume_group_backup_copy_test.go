@@ -208,3 +208,44 @@ func deleteSourceVolumeGroupBackupToCopy() {
 		log.Printf("[WARN] failed to deleteVolumeGroupInRegion with error %v", err)
 	}
 }
+
+// issue-routing-tag: core/blockStorage
+func TestResourceCoreVolumeGroupBackup_copyToRegion(t *testing.T) {
+	httpreplay.SetScenario("TestResourceCoreVolumeGroupBackup_copyToRegion")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_core_volume_group_backup.test_volume_group_backup"
+
+	destinationRegion := utils.GetEnvSettingWithBlankDefault("destination_region")
+	if destinationRegion == "" {
+		t.Skip("Skipping TestResourceCoreVolumeGroupBackup_copyToRegion test because there is no destination region specified")
+	}
+
+	volumeGroupBackupCopyToRegionRepresentation := acctest.RepresentationCopyWithNewProperties(CoreVolumeGroupBackupRepresentation, map[string]interface{}{
+		"copy_to_region": acctest.RepresentationGroup{RepType: acctest.Required, Group: map[string]interface{}{
+			"region":       acctest.Representation{RepType: acctest.Required, Create: destinationRegion},
+			"display_name": acctest.Representation{RepType: acctest.Optional, Create: `displayNameCopy`},
+		}},
+	})
+
+	acctest.ResourceTest(t, testAccCheckCoreVolumeGroupBackupDestroy, []resource.TestStep{
+		// verify Create with a copy to the destination region
+		{
+			Config: config + compartmentIdVariableStr + CoreVolumeGroupBackupResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_volume_group_backup", "test_volume_group_backup", acctest.Optional, acctest.Create, volumeGroupBackupCopyToRegionRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "volume_group_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+				resource.TestCheckResourceAttr(resourceName, "copy_to_region.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "copy_to_region.0.region", destinationRegion),
+				resource.TestCheckResourceAttr(resourceName, "copy_to_region.0.display_name", "displayNameCopy"),
+				resource.TestCheckResourceAttrSet(resourceName, "copy_to_region.0.volume_group_backup_id"),
+			),
+		},
+	})
+}
@@ -227,6 +227,26 @@ func TestCoreVtapResource_basic(t *testing.T) {
 				},
 			),
 		},
+		// verify starting the traffic mirroring
+		{
+			Config: config + compartmentIdVariableStr + CoreVtapResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_core_vtap", "test_vtap", acctest.Optional, acctest.Update,
+					acctest.RepresentationCopyWithNewProperties(CoreVtapRepresentation, map[string]interface{}{
+						"is_vtap_enabled": acctest.Representation{RepType: acctest.Optional, Create: `true`},
+					})),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "is_vtap_enabled", "true"),
+				resource.TestCheckResourceAttr(resourceName, "lifecycle_state_details", "RUNNING"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
 		// verify datasource
 		{
 			Config: config +
@@ -31,6 +31,7 @@ var (
 	DatabaseAutonomousDatabaseInstanceWalletManagementRepresentation = map[string]interface{}{
 		"autonomous_database_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_autonomous_database.test_autonomous_database.id}`},
 		"grace_period":           acctest.Representation{RepType: acctest.Optional, Create: `10`, Update: `11`},
+		"rotation_trigger":       acctest.Representation{RepType: acctest.Optional, Create: `1`, Update: `2`},
 		"should_rotate":          acctest.Representation{RepType: acctest.Optional, Create: `false`, Update: `true`},
 	}
 
@@ -82,6 +83,7 @@ func TestDatabaseAutonomousDatabaseInstanceWalletManagementResource_basic(t *tes
 			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
 				resource.TestCheckResourceAttrSet(resourceName, "autonomous_database_id"),
 				resource.TestCheckResourceAttr(resourceName, "grace_period", "10"),
+				resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "1"),
 				resource.TestCheckResourceAttr(resourceName, "should_rotate", "false"),
 				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
 				func(s *terraform.State) (err error) {
@@ -102,6 +104,7 @@ func TestDatabaseAutonomousDatabaseInstanceWalletManagementResource_basic(t *tes
 			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
 				resource.TestCheckResourceAttrSet(resourceName, "autonomous_database_id"),
 				resource.TestCheckResourceAttr(resourceName, "grace_period", "11"),
+				resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "2"),
 				resource.TestCheckResourceAttr(resourceName, "should_rotate", "true"),
 				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
 				resource.TestCheckResourceAttrSet(resourceName, "time_rotated"),
@@ -0,0 +1,93 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	DatabaseAutonomousDatabaseResourcePoolRepresentation = map[string]interface{}{
+		"autonomous_database_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_autonomous_database.test_autonomous_database.id}`},
+		"pool_size":              acctest.Representation{RepType: acctest.Required, Create: `128`, Update: `256`},
+	}
+
+	DatabaseAutonomousDatabaseResourcePoolMemberRepresentation = map[string]interface{}{
+		"autonomous_database_id":  acctest.Representation{RepType: acctest.Required, Create: `${oci_database_autonomous_database.test_member_autonomous_database.id}`},
+		"resource_pool_leader_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_autonomous_database_resource_pool.test_autonomous_database_resource_pool.autonomous_database_id}`},
+	}
+
+	DatabaseAutonomousDatabaseResourcePoolShapeDataSourceRepresentation = map[string]interface{}{}
+
+	DatabaseAutonomousDatabaseResourcePoolResourceDependencies = DatabaseAutonomousDatabaseResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_database_autonomous_database", "test_autonomous_database", acctest.Required, acctest.Create, autonomousDatabaseRepresentationEcpu) +
+		acctest.GenerateResourceFromRepresentationMap("oci_database_autonomous_database", "test_member_autonomous_database", acctest.Required, acctest.Create,
+			acctest.GetUpdatedRepresentationCopy("db_name", acctest.Representation{RepType: acctest.Required, Create: adbMemberName}, autonomousDatabaseRepresentationEcpu))
+)
+
+// issue-routing-tag: database/dbaas-adb
+func TestDatabaseAutonomousDatabaseResourcePoolResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestDatabaseAutonomousDatabaseResourcePoolResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_autonomous_database_resource_pool.test_autonomous_database_resource_pool"
+	memberResourceName := "oci_database_autonomous_database_resource_pool_member.test_autonomous_database_resource_pool_member"
+	datasourceName := "data.oci_database_autonomous_database_resource_pool_shapes.test_autonomous_database_resource_pool_shapes"
+
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+DatabaseAutonomousDatabaseResourcePoolResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_database_autonomous_database_resource_pool", "test_autonomous_database_resource_pool", acctest.Required, acctest.Create, DatabaseAutonomousDatabaseResourcePoolRepresentation), "database", "autonomousDatabaseResourcePool", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create of the pool and its member
+		{
+			Config: config + compartmentIdVariableStr + DatabaseAutonomousDatabaseResourcePoolResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_autonomous_database_resource_pool", "test_autonomous_database_resource_pool", acctest.Required, acctest.Create, DatabaseAutonomousDatabaseResourcePoolRepresentation) +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_autonomous_database_resource_pool_member", "test_autonomous_database_resource_pool_member", acctest.Required, acctest.Create, DatabaseAutonomousDatabaseResourcePoolMemberRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "autonomous_database_id"),
+				resource.TestCheckResourceAttr(resourceName, "compute_model", "ECPU"),
+				resource.TestCheckResourceAttr(resourceName, "pool_size", "128"),
+
+				resource.TestCheckResourceAttrSet(memberResourceName, "autonomous_database_id"),
+				resource.TestCheckResourceAttrSet(memberResourceName, "resource_pool_leader_id"),
+				resource.TestCheckResourceAttrSet(memberResourceName, "time_of_joining_resource_pool"),
+			),
+		},
+		// verify Update of the pool size
+		{
+			Config: config + compartmentIdVariableStr + DatabaseAutonomousDatabaseResourcePoolResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_autonomous_database_resource_pool", "test_autonomous_database_resource_pool", acctest.Required, acctest.Update, DatabaseAutonomousDatabaseResourcePoolRepresentation) +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_autonomous_database_resource_pool_member", "test_autonomous_database_resource_pool_member", acctest.Required, acctest.Create, DatabaseAutonomousDatabaseResourcePoolMemberRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "pool_size", "256"),
+				resource.TestCheckResourceAttrSet(memberResourceName, "resource_pool_leader_id"),
+			),
+		},
+		// verify removal of the member and disabling of the pool
+		{
+			Config: config + compartmentIdVariableStr + DatabaseAutonomousDatabaseResourcePoolResourceDependencies,
+		},
+		// verify datasource
+		{
+			Config: config + compartmentIdVariableStr +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_database_autonomous_database_resource_pool_shapes", "test_autonomous_database_resource_pool_shapes", acctest.Required, acctest.Create, DatabaseAutonomousDatabaseResourcePoolShapeDataSourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(datasourceName, "resource_pool_shapes.#"),
+				resource.TestCheckResourceAttrSet(datasourceName, "resource_pool_shapes.0.shape"),
+			),
+		},
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+)
+
+var (
+	DatabaseDataGuardAssociationOperationRepresentation = map[string]interface{}{
+		"data_guard_association_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_data_guard_association.test_data_guard_association.id}`},
+		"database_admin_password":   acctest.Representation{RepType: acctest.Required, Create: `BEstrO0ng_#11`},
+		"database_id":               acctest.Representation{RepType: acctest.Required, Create: `${oci_database_data_guard_association.test_data_guard_association.database_id}`},
+		"operation":                 acctest.Representation{RepType: acctest.Required, Create: `switchover`},
+		"operation_trigger":         acctest.Representation{RepType: acctest.Optional, Create: `1`},
+	}
+
+	// the switchback is performed from the peer database, which is the primary database after the switchover
+	DatabaseDataGuardAssociationOperationSwitchbackRepresentation = acctest.RepresentationCopyWithNewProperties(DatabaseDataGuardAssociationOperationRepresentation, map[string]interface{}{
+		"data_guard_association_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_data_guard_association.test_data_guard_association.peer_data_guard_association_id}`},
+		"database_id":               acctest.Representation{RepType: acctest.Required, Create: `${oci_database_data_guard_association.test_data_guard_association.peer_database_id}`},
+	})
+
+	DatabaseDataGuardAssociationOperationResourceDependencies = ResourceDependenciesConfig +
+		acctest.GenerateResourceFromRepresentationMap("oci_database_data_guard_association", "test_data_guard_association", acctest.Optional, acctest.Create, DatabaseDataGuardAssociationRepresentation)
+)
+
+// issue-routing-tag: database/default
+func TestDatabaseDataGuardAssociationOperationResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestDatabaseDataGuardAssociationOperationResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_data_guard_association_operation.test_data_guard_association_operation"
+
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+DatabaseDataGuardAssociationOperationResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_database_data_guard_association_operation", "test_data_guard_association_operation", acctest.Optional, acctest.Create, DatabaseDataGuardAssociationOperationRepresentation), "database", "dataGuardAssociationOperation", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify switchover
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDataGuardAssociationOperationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_data_guard_association_operation", "test_data_guard_association_operation", acctest.Required, acctest.Create, DatabaseDataGuardAssociationOperationRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "data_guard_association_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "database_id"),
+				resource.TestCheckResourceAttr(resourceName, "operation", "switchover"),
+				resource.TestCheckResourceAttr(resourceName, "peer_role", "PRIMARY"),
+				resource.TestCheckResourceAttr(resourceName, "role", "STANDBY"),
+				resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+			),
+		},
+		// verify switchback
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDataGuardAssociationOperationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_data_guard_association_operation", "test_data_guard_association_operation", acctest.Optional, acctest.Create, DatabaseDataGuardAssociationOperationSwitchbackRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "operation_trigger", "1"),
+				resource.TestCheckResourceAttr(resourceName, "peer_role", "PRIMARY"),
+				resource.TestCheckResourceAttr(resourceName, "role", "STANDBY"),
+				resource.TestCheckResourceAttr(resourceName, "state", "AVAILABLE"),
+			),
+		},
+	})
+}
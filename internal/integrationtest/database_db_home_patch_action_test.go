@@ -0,0 +1,80 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+)
+
+var (
+	DatabaseDbHomePatchActionRepresentation = map[string]interface{}{
+		"db_home_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_db_system.test_db_system.db_home.0.id}`},
+		"patch_id":   acctest.Representation{RepType: acctest.Required, Create: `${data.oci_database_db_home_patches.test_db_home_patches.patches.0.id}`},
+		"action":     acctest.Representation{RepType: acctest.Optional, Create: `PRECHECK`},
+	}
+
+	DatabaseDbHomePatchActionPatchDataSourceRepresentation = map[string]interface{}{
+		"db_home_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_db_system.test_db_system.db_home.0.id}`},
+	}
+
+	DatabaseDbHomePatchActionResourceDependencies = DbSystemResourceConfig +
+		acctest.GenerateDataSourceFromRepresentationMap("oci_database_db_home_patches", "test_db_home_patches", acctest.Required, acctest.Create, DatabaseDbHomePatchActionPatchDataSourceRepresentation)
+)
+
+// issue-routing-tag: database/default
+func TestDatabaseDbHomePatchActionResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestDatabaseDbHomePatchActionResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_db_home_patch_action.test_db_home_patch_action"
+
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+DatabaseDbHomePatchActionResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_database_db_home_patch_action", "test_db_home_patch_action", acctest.Optional, acctest.Create, DatabaseDbHomePatchActionRepresentation), "database", "dbHomePatchAction", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify precheck
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDbHomePatchActionResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_db_home_patch_action", "test_db_home_patch_action", acctest.Optional, acctest.Create, DatabaseDbHomePatchActionRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "db_home_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "patch_id"),
+				resource.TestCheckResourceAttr(resourceName, "action", "PRECHECK"),
+				resource.TestCheckResourceAttrSet(resourceName, "patch_history_entry_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_started"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDbHomePatchActionResourceDependencies,
+		},
+		// verify apply
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDbHomePatchActionResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_db_home_patch_action", "test_db_home_patch_action", acctest.Required, acctest.Create, DatabaseDbHomePatchActionRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "action", "APPLY"),
+				resource.TestCheckResourceAttrSet(resourceName, "patch_history_entry_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_ended"),
+			),
+		},
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+)
+
+var (
+	DatabaseDbSystemPatchActionRepresentation = map[string]interface{}{
+		"db_system_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_db_system.test_db_system.id}`},
+		"patch_id":     acctest.Representation{RepType: acctest.Required, Create: `${data.oci_database_db_system_patches.test_db_system_patches.patches.0.id}`},
+		"action":       acctest.Representation{RepType: acctest.Optional, Create: `PRECHECK`},
+	}
+
+	DatabaseDbSystemPatchActionResourceDependencies = DbSystemResourceConfig +
+		acctest.GenerateDataSourceFromRepresentationMap("oci_database_db_system_patches", "test_db_system_patches", acctest.Required, acctest.Create, DatabaseDatabaseDbSystemPatchDataSourceRepresentation)
+)
+
+// issue-routing-tag: database/default
+func TestDatabaseDbSystemPatchActionResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestDatabaseDbSystemPatchActionResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_database_db_system_patch_action.test_db_system_patch_action"
+
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+DatabaseDbSystemPatchActionResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_database_db_system_patch_action", "test_db_system_patch_action", acctest.Optional, acctest.Create, DatabaseDbSystemPatchActionRepresentation), "database", "dbSystemPatchAction", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify precheck
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDbSystemPatchActionResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_db_system_patch_action", "test_db_system_patch_action", acctest.Optional, acctest.Create, DatabaseDbSystemPatchActionRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "db_system_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "patch_id"),
+				resource.TestCheckResourceAttr(resourceName, "action", "PRECHECK"),
+				resource.TestCheckResourceAttrSet(resourceName, "patch_history_entry_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_started"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDbSystemPatchActionResourceDependencies,
+		},
+		// verify apply
+		{
+			Config: config + compartmentIdVariableStr + DatabaseDbSystemPatchActionResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_database_db_system_patch_action", "test_db_system_patch_action", acctest.Required, acctest.Create, DatabaseDbSystemPatchActionRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "action", "APPLY"),
+				resource.TestCheckResourceAttrSet(resourceName, "patch_history_entry_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_ended"),
+			),
+		},
+	})
+}
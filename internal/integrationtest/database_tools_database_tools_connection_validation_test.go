@@ -0,0 +1,48 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+)
+
+var (
+	DatabaseToolsDatabaseToolsConnectionValidationRepresentation = map[string]interface{}{
+		"database_tools_connection_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_database_tools_database_tools_connection.test_database_tools_connection.id}`},
+		"validate_trigger":             acctest.Representation{RepType: acctest.Optional, Create: `1`},
+	}
+
+	DatabaseToolsDatabaseToolsConnectionValidationResourceDependencies = DatabaseToolsDatabaseToolsConnectionResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_database_tools_database_tools_connection", "test_database_tools_connection", acctest.Required, acctest.Create, DatabaseToolsDatabaseToolsConnectionRepresentation)
+)
+
+// issue-routing-tag: database_tools/default
+func TestDatabaseToolsDatabaseToolsConnectionValidationResource_basic(t *testing.T) {
+	config := acctest.ProviderTestConfig()
+	allVars := databaseToolsStandardVariables()
+	resourceName := "oci_database_tools_database_tools_connection_validation.test_database_tools_connection_validation"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acctest.PreCheck(t) },
+		Providers: databaseToolsOciProvider(),
+		Steps: []resource.TestStep{
+			// verify Create
+			{
+				Config: config + allVars + DatabaseToolsDatabaseToolsConnectionValidationResourceDependencies +
+					acctest.GenerateResourceFromRepresentationMap("oci_database_tools_database_tools_connection_validation", "test_database_tools_connection_validation", acctest.Optional, acctest.Create, DatabaseToolsDatabaseToolsConnectionValidationRepresentation),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "database_tools_connection_id"),
+					resource.TestCheckResourceAttr(resourceName, "code", "OK"),
+					resource.TestCheckResourceAttr(resourceName, "type", "ORACLE_DATABASE"),
+					resource.TestCheckResourceAttrSet(resourceName, "database_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "message"),
+				),
+			},
+		},
+	})
+}
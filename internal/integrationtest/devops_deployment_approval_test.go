@@ -0,0 +1,66 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+)
+
+var (
+	DevopsDeploymentApprovalRepresentation = map[string]interface{}{
+		"action":          acctest.Representation{RepType: acctest.Required, Create: `APPROVE`},
+		"deploy_stage_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_devops_deploy_stage.test_deploy_stage.id}`},
+		"deployment_id":   acctest.Representation{RepType: acctest.Required, Create: `${oci_devops_deployment.test_deployment.id}`},
+		"reason":          acctest.Representation{RepType: acctest.Optional, Create: `reason`},
+	}
+
+	DevopsDeploymentApprovalResourceDependencies = DevopsDeployStageResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_devops_deploy_stage", "test_deploy_stage", acctest.Required, acctest.Create, deployManualApprovalStageRepresentation) +
+		acctest.GenerateResourceFromRepresentationMap("oci_devops_deployment", "test_deployment", acctest.Required, acctest.Create, DevopsDeploymentRepresentation)
+)
+
+// issue-routing-tag: devops/default
+func TestDevopsDeploymentApprovalResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestDevopsDeploymentApprovalResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_devops_deployment_approval.test_deployment_approval"
+
+	// Save TF content to create resource with optional properties. This has to be exactly the same as the config part in the create step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+DevopsDeploymentApprovalResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_devops_deployment_approval", "test_deployment_approval", acctest.Optional, acctest.Create, DevopsDeploymentApprovalRepresentation), "devops", "deploymentApproval", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify create
+		{
+			Config: config + compartmentIdVariableStr + DevopsDeploymentApprovalResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_devops_deployment_approval", "test_deployment_approval", acctest.Optional, acctest.Create, DevopsDeploymentApprovalRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "action", "APPROVE"),
+				resource.TestCheckResourceAttr(resourceName, "approval_actions.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "approval_actions.0.action", "APPROVE"),
+				resource.TestCheckResourceAttr(resourceName, "approval_actions.0.reason", "reason"),
+				resource.TestCheckResourceAttrSet(resourceName, "approval_actions.0.subject_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "deploy_stage_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "deploy_stage_status"),
+				resource.TestCheckResourceAttrSet(resourceName, "deployment_id"),
+				resource.TestCheckResourceAttr(resourceName, "reason", "reason"),
+				resource.TestCheckResourceAttrSet(resourceName, "state"),
+			),
+		},
+	})
+}
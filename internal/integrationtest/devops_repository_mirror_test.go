@@ -20,7 +20,8 @@ import (
 
 var (
 	DevopsRepositoryMirrorRepresentation = map[string]interface{}{
-		"repository_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_devops_repository.test_repository.id}`},
+		"repository_id":  acctest.Representation{RepType: acctest.Required, Create: `${oci_devops_repository.test_repository.id}`},
+		"mirror_trigger": acctest.Representation{RepType: acctest.Optional, Create: `1`},
 	}
 
 	DevopsRepositoryMirrorOnlyRepositoryConfigTriggerScheduleRepresentation = map[string]interface{}{
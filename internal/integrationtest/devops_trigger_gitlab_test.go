@@ -102,6 +102,7 @@ func TestDevopsGitlabTriggerResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttrSet(resourceName, "actions.0.build_pipeline_id"),
 				resource.TestCheckResourceAttr(resourceName, "actions.0.type", "TRIGGER_BUILD_PIPELINE"),
 				resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "secret"),
 				resource.TestCheckResourceAttr(resourceName, "trigger_source", "GITLAB"),
 
 				func(s *terraform.State) (err error) {
@@ -135,6 +136,7 @@ func TestDevopsGitlabTriggerResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
 				resource.TestCheckResourceAttrSet(resourceName, "id"),
 				resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "secret"),
 				resource.TestCheckResourceAttr(resourceName, "trigger_source", "GITLAB"),
 				resource.TestCheckResourceAttrSet(resourceName, "trigger_url"),
 
@@ -52,14 +52,15 @@ var (
 		"endpoint_type":      acctest.Representation{RepType: acctest.Optional, Create: `VNIC`},
 		"forwarding_address": acctest.Representation{RepType: acctest.Optional, Create: `10.0.0.5`},
 		"scope":              acctest.Representation{RepType: acctest.Optional, Create: `PRIVATE`},
-		"nsg_ids":            acctest.Representation{RepType: acctest.Optional, Create: []string{`${oci_core_network_security_group.test_network_security_group.id}`}},
+		"nsg_ids":            acctest.Representation{RepType: acctest.Optional, Create: []string{`${oci_core_network_security_group.test_network_security_group.id}`}, Update: []string{`${oci_core_network_security_group.test_network_security_group2.id}`}},
 	}
 
 	DnsResolverEndpointRepresentationWithoutNsgId = acctest.RepresentationCopyWithRemovedProperties(DnsResolverEndpointRepresentation, []string{"nsg_ids"})
 
 	DnsResolverEndpointResourceDependencies = DnsResolverResourceDependencies +
 		acctest.GenerateResourceFromRepresentationMap("oci_core_subnet", "test_subnet", acctest.Required, acctest.Create, CoreSubnetRepresentation) +
-		acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group", "test_network_security_group", acctest.Required, acctest.Create, CoreNetworkSecurityGroupRepresentation)
+		acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group", "test_network_security_group", acctest.Required, acctest.Create, CoreNetworkSecurityGroupRepresentation) +
+		acctest.GenerateResourceFromRepresentationMap("oci_core_network_security_group", "test_network_security_group2", acctest.Required, acctest.Create, CoreNetworkSecurityGroupRepresentation)
 )
 
 // issue-routing-tag: dns/default
@@ -161,6 +162,7 @@ func TestDnsResolverEndpointResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(resourceName, "is_forwarding", "true"),
 				resource.TestCheckResourceAttr(resourceName, "is_listening", "false"),
 				resource.TestCheckResourceAttr(resourceName, "name", "endpointName"),
+				resource.TestCheckResourceAttr(resourceName, "nsg_ids.#", "1"),
 				resource.TestCheckResourceAttrSet(resourceName, "resolver_id"),
 				resource.TestCheckResourceAttr(resourceName, "scope", "PRIVATE"),
 				resource.TestCheckResourceAttrSet(resourceName, "self"),
@@ -77,7 +77,7 @@ var (
 		"name":        acctest.Representation{RepType: acctest.Required, Create: `name`},
 		"rdata":       acctest.Representation{RepType: acctest.Required, Create: `192.0.2.1`},
 		"rtype":       acctest.Representation{RepType: acctest.Required, Create: `A`},
-		"is_disabled": acctest.Representation{RepType: acctest.Optional, Create: `false`},
+		"is_disabled": acctest.Representation{RepType: acctest.Optional, Create: `false`, Update: `true`},
 		"pool":        acctest.Representation{RepType: acctest.Optional, Create: `pool`},
 	}
 	DnsSteeringPolicyRulesFilterRuleTypeRepresentation = map[string]interface{}{
@@ -109,7 +109,7 @@ var (
 	DnsSteeringPolicyRulesLimitRuleTypeRepresentation = map[string]interface{}{
 		"rule_type":     acctest.Representation{RepType: acctest.Required, Create: `LIMIT`},
 		"cases":         acctest.RepresentationGroup{RepType: acctest.Optional, Group: DnsSteeringPolicyRulesCasesLimitRuleTypeRepresentation},
-		"default_count": acctest.Representation{RepType: acctest.Optional, Create: `10`},
+		"default_count": acctest.Representation{RepType: acctest.Optional, Create: `10`, Update: `11`},
 		"description":   acctest.Representation{RepType: acctest.Optional, Create: `limit description`},
 	}
 	DnsSteeringPolicyRulesCasesLimitRuleTypeRepresentation = map[string]interface{}{
@@ -350,7 +350,7 @@ func TestDnsSteeringPolicyResource_basic(t *testing.T) {
 				acctest.GenerateResourceFromRepresentationMap("oci_dns_steering_policy", "test_steering_policy", acctest.Optional, acctest.Update, DnsSteeringPolicyRepresentation),
 			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
 				resource.TestCheckResourceAttr(resourceName, "answers.#", "1"),
-				resource.TestCheckResourceAttr(resourceName, "answers.0.is_disabled", "false"),
+				resource.TestCheckResourceAttr(resourceName, "answers.0.is_disabled", "true"),
 				resource.TestCheckResourceAttr(resourceName, "answers.0.name", "name"),
 				resource.TestCheckResourceAttr(resourceName, "answers.0.pool", "pool"),
 				resource.TestCheckResourceAttr(resourceName, "answers.0.rdata", "192.0.2.1"),
@@ -377,7 +377,7 @@ func TestDnsSteeringPolicyResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(resourceName, "rules.2.cases.#", "1"),
 				resource.TestCheckResourceAttr(resourceName, "rules.2.cases.0.case_condition", "query.client.address in (subnet '198.51.100.0/24')"),
 				resource.TestCheckResourceAttr(resourceName, "rules.2.cases.0.count", "10"),
-				resource.TestCheckResourceAttr(resourceName, "rules.2.default_count", "10"),
+				resource.TestCheckResourceAttr(resourceName, "rules.2.default_count", "11"),
 				resource.TestCheckResourceAttr(resourceName, "rules.2.description", "limit description"),
 				resource.TestCheckResourceAttr(resourceName, "rules.2.rule_type", "LIMIT"),
 				resource.TestCheckResourceAttr(resourceName, "rules.3.cases.#", "1"),
@@ -468,7 +468,7 @@ func TestDnsSteeringPolicyResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttrSet(singularDatasourceName, "steering_policy_id"),
 
 				resource.TestCheckResourceAttr(singularDatasourceName, "answers.#", "1"),
-				resource.TestCheckResourceAttr(singularDatasourceName, "answers.0.is_disabled", "false"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "answers.0.is_disabled", "true"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "answers.0.name", "name"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "answers.0.pool", "pool"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "answers.0.rdata", "192.0.2.1"),
@@ -495,7 +495,7 @@ func TestDnsSteeringPolicyResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(singularDatasourceName, "rules.2.cases.#", "1"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "rules.2.cases.0.case_condition", "query.client.address in (subnet '198.51.100.0/24')"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "rules.2.cases.0.count", "10"),
-				resource.TestCheckResourceAttr(singularDatasourceName, "rules.2.default_count", "10"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "rules.2.default_count", "11"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "rules.2.description", "limit description"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "rules.2.rule_type", "LIMIT"),
 				resource.TestCheckResourceAttr(singularDatasourceName, "rules.3.cases.#", "1"),
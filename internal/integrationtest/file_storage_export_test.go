@@ -6,6 +6,7 @@ package integrationtest
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 	"time"
@@ -262,6 +263,51 @@ func TestFileStorageExportResource_basic(t *testing.T) {
 	})
 }
 
+// issue-routing-tag: file_storage/default
+func TestFileStorageExportResource_exportOptionsValidation(t *testing.T) {
+	httpreplay.SetScenario("TestFileStorageExportResource_exportOptionsValidation")
+	defer httpreplay.SaveScenario()
+
+	provider := acctest.TestAccProvider
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Providers: map[string]*schema.Provider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// source that is neither an IP address nor a CIDR block
+			{
+				Config: config + compartmentIdVariableStr + FileStorageExportResourceDependencies +
+					acctest.GenerateResourceFromRepresentationMap("oci_file_storage_export", "test_export", acctest.Optional, acctest.Create,
+						acctest.RepresentationCopyWithNewProperties(FileStorageExportRepresentation, map[string]interface{}{
+							"export_options": acctest.RepresentationGroup{RepType: acctest.Optional, Group: acctest.RepresentationCopyWithNewProperties(FileStorageExportExportOptionsRepresentation, map[string]interface{}{
+								"source": acctest.Representation{RepType: acctest.Required, Create: `10.0.0.0/33`},
+							})},
+						})),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("export_options.0.source"),
+			},
+			// anonymous access without Kerberos authentication
+			{
+				Config: config + compartmentIdVariableStr + FileStorageExportResourceDependencies +
+					acctest.GenerateResourceFromRepresentationMap("oci_file_storage_export", "test_export", acctest.Optional, acctest.Create,
+						acctest.RepresentationCopyWithNewProperties(FileStorageExportRepresentation, map[string]interface{}{
+							"export_options": acctest.RepresentationGroup{RepType: acctest.Optional, Group: acctest.RepresentationCopyWithNewProperties(FileStorageExportExportOptionsRepresentation, map[string]interface{}{
+								"is_anonymous_access_allowed": acctest.Representation{RepType: acctest.Optional, Create: `true`},
+							})},
+						})),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is_anonymous_access_allowed requires allowed_auth to include KRB5"),
+			},
+		},
+	})
+}
+
 func testAccCheckFileStorageExportDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := acctest.TestAccProvider.Meta().(*tf_client.OracleClients).FileStorageClient()
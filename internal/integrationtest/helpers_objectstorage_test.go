@@ -74,3 +74,24 @@ func TestUnitSafe_splitSizeToOffsetsAndLimits(t *testing.T) {
 
 	return
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitSafe_splitSizeToOffsetsAndLimitsWithPartSize(t *testing.T) {
+	partSize := tf_objectstorage.MinPartSize
+
+	offsets, limits, _ := tf_objectstorage.SplitSizeToOffsetsAndLimitsWithPartSize(partSize*3+1, partSize)
+	if len(offsets) != 4 {
+		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), partSize*3+1)
+		return
+	}
+	if offsets[3] != partSize*3 || limits[3] != 1 {
+		t.Errorf("The reported last part at offset %v with limit %v is wrong for the size %v", offsets[3], limits[3], partSize*3+1)
+		return
+	}
+
+	offsets, _, _ = tf_objectstorage.SplitSizeToOffsetsAndLimitsWithPartSize(partSize*tf_objectstorage.MaxCount*2, partSize)
+	if len(offsets) != int(tf_objectstorage.MaxCount) {
+		t.Errorf("The reported %v number of parts is wrong for the size %v", len(offsets), partSize*tf_objectstorage.MaxCount*2)
+		return
+	}
+}
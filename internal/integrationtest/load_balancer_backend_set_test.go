@@ -1249,3 +1249,97 @@ func getBackendSetIds(compartment string) ([]string, error) {
 	}
 	return resourceIds, nil
 }
+
+var (
+	backendSetManagedBackendsRepresentation = acctest.RepresentationCopyWithNewProperties(backendSet3Representation, map[string]interface{}{
+		"load_balancer_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_load_balancer_load_balancer.test_load_balancer.id}`},
+		"manage_backends":  acctest.Representation{RepType: acctest.Required, Create: `true`},
+		"managed_backend":  []acctest.RepresentationGroup{{RepType: acctest.Required, Group: backendSetManagedBackendRepresentation}, {RepType: acctest.Required, Group: backendSetManagedBackend2Representation}},
+	})
+	backendSetManagedBackendRepresentation = map[string]interface{}{
+		"ip_address": acctest.Representation{RepType: acctest.Required, Create: `10.0.0.3`},
+		"port":       acctest.Representation{RepType: acctest.Required, Create: `10`},
+		"weight":     acctest.Representation{RepType: acctest.Required, Create: `1`, Update: `2`},
+	}
+	backendSetManagedBackend2Representation = map[string]interface{}{
+		"ip_address": acctest.Representation{RepType: acctest.Required, Create: `10.0.0.4`},
+		"port":       acctest.Representation{RepType: acctest.Required, Create: `10`},
+	}
+)
+
+// issue-routing-tag: load_balancer/default
+func TestLoadBalancerBackendSetResource_manageBackends(t *testing.T) {
+	httpreplay.SetScenario("TestLoadBalancerBackendSetResource_manageBackends")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_load_balancer_backend_set.test_backend_set"
+
+	var resId, resId2 string
+
+	acctest.ResourceTest(t, testAccCheckLoadBalancerBackendSetDestroy, []resource.TestStep{
+		// verify Create with the backends owned by the backend set
+		{
+			Config: config + compartmentIdVariableStr + BackendSetResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_backend_set", "test_backend_set", acctest.Required, acctest.Create, backendSetManagedBackendsRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "manage_backends", "true"),
+				resource.TestCheckResourceAttr(resourceName, "managed_backend.#", "2"),
+				resource.TestCheckResourceAttr(resourceName, "backend.#", "2"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "managed_backend", map[string]string{
+					"ip_address": "10.0.0.3",
+					"port":       "10",
+					"weight":     "1",
+				},
+					[]string{
+						"name",
+					}),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "managed_backend", map[string]string{
+					"ip_address": "10.0.0.4",
+					"port":       "10",
+				},
+					[]string{
+						"name",
+					}),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify backends are changed and removed in place
+		{
+			Config: config + compartmentIdVariableStr + BackendSetResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_backend_set", "test_backend_set", acctest.Required, acctest.Update,
+					acctest.RepresentationCopyWithNewProperties(backendSetManagedBackendsRepresentation, map[string]interface{}{
+						"managed_backend": acctest.RepresentationGroup{RepType: acctest.Required, Group: backendSetManagedBackendRepresentation},
+					})),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "managed_backend.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "backend.#", "1"),
+				acctest.CheckResourceSetContainsElementWithProperties(resourceName, "managed_backend", map[string]string{
+					"ip_address": "10.0.0.3",
+					"port":       "10",
+					"weight":     "2",
+				},
+					[]string{
+						"name",
+					}),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	LoadBalancerListenerRuleSetAssociationRepresentation = map[string]interface{}{
+		"listener_name":    acctest.Representation{RepType: acctest.Required, Create: `${oci_load_balancer_listener.test_listener.name}`},
+		"load_balancer_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_load_balancer_load_balancer.test_load_balancer.id}`},
+		"rule_set_names":   acctest.Representation{RepType: acctest.Required, Create: []string{`${oci_load_balancer_rule_set.test_rule_set.name}`}, Update: []string{`${oci_load_balancer_rule_set.test_rule_set.name}`, `${oci_load_balancer_rule_set.test_rule_set2.name}`}},
+	}
+
+	// The listener does not set rule_set_names, the association owns them
+	LoadBalancerListenerRuleSetAssociationResourceDependencies = ListenerResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_listener", "test_listener", acctest.Required, acctest.Create, listenerRepresentationLBCert) +
+		acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_rule_set", "test_rule_set2", acctest.Required, acctest.Create,
+			acctest.GetUpdatedRepresentationCopy("name", acctest.Representation{RepType: acctest.Required, Create: `example_rule_set2`}, ruleSetRepresentation))
+)
+
+// issue-routing-tag: load_balancer/default
+func TestLoadBalancerListenerRuleSetAssociationResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestLoadBalancerListenerRuleSetAssociationResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_load_balancer_listener_rule_set_association.test_listener_rule_set_association"
+	listenerName := "oci_load_balancer_listener.test_listener"
+
+	var resId, resId2 string
+
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+LoadBalancerListenerRuleSetAssociationResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_listener_rule_set_association", "test_listener_rule_set_association", acctest.Required, acctest.Create, LoadBalancerListenerRuleSetAssociationRepresentation), "loadbalancer", "listenerRuleSetAssociation", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerListenerRuleSetAssociationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_listener_rule_set_association", "test_listener_rule_set_association", acctest.Required, acctest.Create, LoadBalancerListenerRuleSetAssociationRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "listener_name", "myListener1"),
+				resource.TestCheckResourceAttrSet(resourceName, "load_balancer_id"),
+				resource.TestCheckResourceAttr(resourceName, "rule_set_names.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "rule_set_names.0", "example_rule_set"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify attaching a second rule set
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerListenerRuleSetAssociationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_listener_rule_set_association", "test_listener_rule_set_association", acctest.Required, acctest.Update, LoadBalancerListenerRuleSetAssociationRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "rule_set_names.#", "2"),
+				resource.TestCheckResourceAttr(resourceName, "rule_set_names.1", "example_rule_set2"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+		// verify the listener picks up the rule sets on refresh without a diff
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerListenerRuleSetAssociationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_listener_rule_set_association", "test_listener_rule_set_association", acctest.Required, acctest.Update, LoadBalancerListenerRuleSetAssociationRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(listenerName, "rule_set_names.#", "2"),
+			),
+		},
+
+		// verify the rule sets are detached when the association is deleted
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerListenerRuleSetAssociationResourceDependencies,
+		},
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerListenerRuleSetAssociationResourceDependencies,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(listenerName, "rule_set_names.#", "0"),
+			),
+		},
+
+		// verify resource import
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerListenerRuleSetAssociationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_listener_rule_set_association", "test_listener_rule_set_association", acctest.Required, acctest.Update, LoadBalancerListenerRuleSetAssociationRepresentation),
+		},
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerListenerRuleSetAssociationResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_listener_rule_set_association", "test_listener_rule_set_association", acctest.Required, acctest.Update, LoadBalancerListenerRuleSetAssociationRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ResourceName:      resourceName,
+		},
+	})
+}
@@ -50,6 +50,7 @@ var (
 		"has_session_resumption":            acctest.Representation{RepType: acctest.Optional, Create: `false`, Update: `true`},
 		"cipher_suite_name":                 acctest.Representation{RepType: acctest.Optional, Create: `oci-default-ssl-cipher-suite-v1`, Update: `oci-default-ssl-cipher-suite-v1`},
 		"protocols":                         acctest.Representation{RepType: acctest.Optional, Create: []string{`TLSv1.2`}, Update: []string{`TLSv1.2`}},
+		"refresh_on_certificate_rotation":   acctest.Representation{RepType: acctest.Optional, Create: `false`, Update: `true`},
 		"server_order_preference":           acctest.Representation{RepType: acctest.Optional, Create: `ENABLED`, Update: `DISABLED`},
 		"trusted_certificate_authority_ids": acctest.Representation{RepType: acctest.Optional, Create: []string{trustedCertificateAuthorityIds}, Update: []string{trustedCertificateAuthorityIds2}},
 		"verify_depth":                      acctest.Representation{RepType: acctest.Optional, Create: `10`, Update: `11`},
@@ -190,10 +191,12 @@ func TestLoadBalancerListenerResourceOciCerts_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.cipher_suite_name", "oci-default-ssl-cipher-suite-v1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.protocols.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.refresh_on_certificate_rotation", "false"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.server_order_preference", "ENABLED"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.trusted_certificate_authority_ids.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.verify_depth", "10"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.certificate_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_version_numbers.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.verify_peer_certificate", "false"),
 
 					func(s *terraform.State) (err error) {
@@ -229,10 +232,12 @@ func TestLoadBalancerListenerResourceOciCerts_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.cipher_suite_name", "oci-default-ssl-cipher-suite-v1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.protocols.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.refresh_on_certificate_rotation", "true"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.server_order_preference", "DISABLED"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.trusted_certificate_authority_ids.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.verify_depth", "11"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.certificate_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_version_numbers.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "ssl_configuration.0.verify_peer_certificate", "true"),
 
 					func(s *terraform.State) (err error) {
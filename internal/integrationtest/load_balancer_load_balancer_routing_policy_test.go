@@ -6,6 +6,7 @@ package integrationtest
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -182,6 +183,44 @@ func TestLoadBalancerLoadBalancerRoutingPolicyResource_basic(t *testing.T) {
 	})
 }
 
+// issue-routing-tag: load_balancer/default
+func TestLoadBalancerLoadBalancerRoutingPolicyResource_conditionValidation(t *testing.T) {
+	httpreplay.SetScenario("TestLoadBalancerLoadBalancerRoutingPolicyResource_conditionValidation")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// misspelled attribute
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerRoutingPolicyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_load_balancer_routing_policy", "test_load_balancer_routing_policy", acctest.Required, acctest.Create,
+					acctest.GetUpdatedRepresentationCopy("rules.condition", acctest.Representation{RepType: acctest.Required, Create: `all(http.request.url.pth eq (i '/example'))`}, loadBalancerRoutingPolicyRepresentation)),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`unknown attribute "http.request.url.pth"`),
+		},
+		// unknown operator
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerRoutingPolicyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_load_balancer_routing_policy", "test_load_balancer_routing_policy", acctest.Required, acctest.Create,
+					acctest.GetUpdatedRepresentationCopy("rules.condition", acctest.Representation{RepType: acctest.Required, Create: `all(http.request.url.path equals (i '/example'))`}, loadBalancerRoutingPolicyRepresentation)),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`unknown operator "equals"`),
+		},
+		// unbalanced parentheses
+		{
+			Config: config + compartmentIdVariableStr + LoadBalancerRoutingPolicyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_load_balancer_routing_policy", "test_load_balancer_routing_policy", acctest.Required, acctest.Create,
+					acctest.GetUpdatedRepresentationCopy("rules.condition", acctest.Representation{RepType: acctest.Required, Create: `any(http.request.headers[(i 'user-agent')] eq (i 'chrome')`}, loadBalancerRoutingPolicyRepresentation)),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`expected "\)" at end of condition`),
+		},
+	})
+}
+
 func testAccCheckLoadBalancerLoadBalancerRoutingPolicyDestroy(s *terraform.State) error {
 	noResourceFound := true
 	client := acctest.TestAccProvider.Meta().(*tf_client.OracleClients).LoadBalancerClient()
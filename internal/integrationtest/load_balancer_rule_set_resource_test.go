@@ -5,6 +5,7 @@ package integrationtest
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -265,3 +266,78 @@ func TestLoadBalancerRuleSetResource_allowAction(t *testing.T) {
 		},
 	})
 }
+
+// issue-routing-tag: load_balancer/default
+func TestLoadBalancerRuleSetResource_itemValidation(t *testing.T) {
+	httpreplay.SetScenario("TestLoadBalancerRuleSetResource_itemValidation")
+	defer httpreplay.SaveScenario()
+
+	provider := acctest.TestAccProvider
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		Providers: map[string]*schema.Provider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// ALLOW rule carrying the redirect of another rule
+			{
+				Config: config + compartmentIdVariableStr + RuleSetResourceDependencies + `
+resource "oci_load_balancer_rule_set" "test_rule_set" {
+	load_balancer_id = "${oci_load_balancer_load_balancer.test_load_balancer.id}"
+	name = "example_rule_set"
+	items {
+		action = "ALLOW"
+		conditions {
+			attribute_name = "SOURCE_IP_ADDRESS"
+			attribute_value = "129.0.0.0/8"
+		}
+		redirect_uri {
+			protocol = "HTTPS"
+		}
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("redirect_uri cannot be set on ALLOW rules"),
+			},
+			// REDIRECT rule matching on the source address
+			{
+				Config: config + compartmentIdVariableStr + RuleSetResourceDependencies + `
+resource "oci_load_balancer_rule_set" "test_rule_set" {
+	load_balancer_id = "${oci_load_balancer_load_balancer.test_load_balancer.id}"
+	name = "example_rule_set"
+	items {
+		action = "REDIRECT"
+		conditions {
+			attribute_name = "SOURCE_IP_ADDRESS"
+			attribute_value = "129.0.0.0/8"
+		}
+		redirect_uri {
+			protocol = "HTTPS"
+		}
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("REDIRECT rules only accept conditions on PATH"),
+			},
+			// EXTEND_HTTP_REQUEST_HEADER_VALUE rule without prefix or suffix
+			{
+				Config: config + compartmentIdVariableStr + RuleSetResourceDependencies + `
+resource "oci_load_balancer_rule_set" "test_rule_set" {
+	load_balancer_id = "${oci_load_balancer_load_balancer.test_load_balancer.id}"
+	name = "example_rule_set"
+	items {
+		action = "EXTEND_HTTP_REQUEST_HEADER_VALUE"
+		header = "example_header_name"
+	}
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("EXTEND_HTTP_REQUEST_HEADER_VALUE rules require prefix or suffix"),
+			},
+		},
+	})
+}
@@ -41,6 +41,15 @@ var (
 		"name":   acctest.Representation{RepType: acctest.Required, Create: `name`},
 		"values": acctest.Representation{RepType: acctest.Required, Create: []string{`${oci_load_balancer_ssl_cipher_suite.test_ssl_cipher_suite.name}`}},
 	}
+	LoadBalancerLoadBalancerSslPredefinedCipherSuiteDataSourceRepresentation = map[string]interface{}{
+		"load_balancer_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_load_balancer_load_balancer.test_load_balancer.id}`},
+		"is_predefined":    acctest.Representation{RepType: acctest.Required, Create: `true`},
+		"filter":           acctest.RepresentationGroup{RepType: acctest.Required, Group: LoadBalancerSslPredefinedCipherSuiteDataSourceFilterRepresentation},
+	}
+	LoadBalancerSslPredefinedCipherSuiteDataSourceFilterRepresentation = map[string]interface{}{
+		"name":   acctest.Representation{RepType: acctest.Required, Create: `name`},
+		"values": acctest.Representation{RepType: acctest.Required, Create: []string{`oci-modern-ssl-cipher-suite-v1`, `example_cipher_suite`}},
+	}
 
 	LoadBalancerSslCipherSuiteRepresentation = map[string]interface{}{
 		"ciphers":          acctest.Representation{RepType: acctest.Required, Create: []string{`AES128-SHA`, `AES256-SHA`}},
@@ -119,6 +128,20 @@ func TestLoadBalancerSslCipherSuiteResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(datasourceName, "ssl_cipher_suites.0.name", "example_cipher_suite"),
 			),
 		},
+		// verify datasource only returns predefined cipher suites
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_load_balancer_ssl_cipher_suites", "test_ssl_cipher_suites", acctest.Required, acctest.Create, LoadBalancerLoadBalancerSslPredefinedCipherSuiteDataSourceRepresentation) +
+				compartmentIdVariableStr + SslCipherSuiteResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_ssl_cipher_suite", "test_ssl_cipher_suite", acctest.Optional, acctest.Update, LoadBalancerSslCipherSuiteRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(datasourceName, "is_predefined", "true"),
+
+				resource.TestCheckResourceAttr(datasourceName, "ssl_cipher_suites.#", "1"),
+				resource.TestCheckResourceAttr(datasourceName, "ssl_cipher_suites.0.name", "oci-modern-ssl-cipher-suite-v1"),
+				resource.TestCheckResourceAttrSet(datasourceName, "ssl_cipher_suites.0.ciphers.#"),
+			),
+		},
 		// verify singular datasource
 		{
 			Config: config +
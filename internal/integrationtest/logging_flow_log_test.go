@@ -0,0 +1,130 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	LoggingFlowLogRepresentation = map[string]interface{}{
+		"compartment_id":     acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"display_name":       acctest.Representation{RepType: acctest.Required, Create: `subnetFlowLog`, Update: `subnetFlowLog2`},
+		"resource_id":        acctest.Representation{RepType: acctest.Required, Create: `${oci_core_subnet.test_subnet.id}`},
+		"flow_log_type":      acctest.Representation{RepType: acctest.Optional, Create: `ALL`, Update: `REJECT`},
+		"is_enabled":         acctest.Representation{RepType: acctest.Optional, Create: `true`, Update: `false`},
+		"log_group_id":       acctest.Representation{RepType: acctest.Optional, Create: `${oci_logging_log_group.test_log_group.id}`},
+		"retention_duration": acctest.Representation{RepType: acctest.Optional, Create: `30`, Update: `60`},
+		"sampling_rate":      acctest.Representation{RepType: acctest.Optional, Create: `10`, Update: `20`},
+	}
+
+	LoggingFlowLogResourceDependencies = CoreSubnetResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_core_subnet", "test_subnet", acctest.Required, acctest.Create, CoreSubnetRepresentation) +
+		acctest.GenerateResourceFromRepresentationMap("oci_logging_log_group", "test_log_group", acctest.Required, acctest.Create, LoggingLogGroupRepresentation)
+)
+
+// issue-routing-tag: logging/default
+func TestLoggingFlowLogResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestLoggingFlowLogResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_logging_flow_log.test_flow_log"
+
+	var resId, resId2 string
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+LoggingFlowLogResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_logging_flow_log", "test_flow_log", acctest.Optional, acctest.Create, LoggingFlowLogRepresentation), "logging", "flowLog", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create, the capture filter and the log group are created with the log
+		{
+			Config: config + compartmentIdVariableStr + LoggingFlowLogResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_logging_flow_log", "test_flow_log", acctest.Required, acctest.Create, LoggingFlowLogRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "subnetFlowLog"),
+				resource.TestCheckResourceAttr(resourceName, "flow_log_type", "ALL"),
+				resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+				resource.TestCheckResourceAttr(resourceName, "is_capture_filter_managed", "true"),
+				resource.TestCheckResourceAttr(resourceName, "is_log_group_managed", "true"),
+				resource.TestCheckResourceAttr(resourceName, "sampling_rate", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "capture_filter_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "log_group_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "log_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "resource_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + LoggingFlowLogResourceDependencies,
+		},
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + LoggingFlowLogResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_logging_flow_log", "test_flow_log", acctest.Optional, acctest.Create, LoggingFlowLogRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "display_name", "subnetFlowLog"),
+				resource.TestCheckResourceAttr(resourceName, "flow_log_type", "ALL"),
+				resource.TestCheckResourceAttr(resourceName, "is_enabled", "true"),
+				resource.TestCheckResourceAttr(resourceName, "is_log_group_managed", "false"),
+				resource.TestCheckResourceAttrSet(resourceName, "log_group_id"),
+				resource.TestCheckResourceAttr(resourceName, "retention_duration", "30"),
+				resource.TestCheckResourceAttr(resourceName, "sampling_rate", "10"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify updates to updatable parameters
+		{
+			Config: config + compartmentIdVariableStr + LoggingFlowLogResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_logging_flow_log", "test_flow_log", acctest.Optional, acctest.Update, LoggingFlowLogRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "display_name", "subnetFlowLog2"),
+				resource.TestCheckResourceAttr(resourceName, "flow_log_type", "REJECT"),
+				resource.TestCheckResourceAttr(resourceName, "is_enabled", "false"),
+				resource.TestCheckResourceAttr(resourceName, "retention_duration", "60"),
+				resource.TestCheckResourceAttr(resourceName, "sampling_rate", "20"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+
+		// verify resource import
+		{
+			Config:            config + compartmentIdVariableStr + LoggingFlowLogResourceDependencies + acctest.GenerateResourceFromRepresentationMap("oci_logging_flow_log", "test_flow_log", acctest.Optional, acctest.Update, LoggingFlowLogRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateVerifyIgnore: []string{
+				"is_capture_filter_managed",
+				"is_log_group_managed",
+			},
+			ResourceName: resourceName,
+		},
+	})
+}
@@ -53,6 +53,7 @@ var (
 		"display_name":   acctest.Representation{RepType: acctest.Optional, Create: `displayName`, Update: `displayName2`},
 		"freeform_tags":  acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"bar-key": "value"}, Update: map[string]string{"Department": "Accounting"}},
 		"is_enabled":     acctest.Representation{RepType: acctest.Optional, Create: `true`, Update: `false`},
+		"reset_trigger":  acctest.Representation{RepType: acctest.Optional, Create: `0`, Update: `1`},
 		"lifecycle":      acctest.RepresentationGroup{RepType: acctest.Required, Group: ignoreDefinedTagsChangesForMysqlChannel},
 	}
 
@@ -220,6 +221,7 @@ func TestMysqlChannelResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
 				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
 				resource.TestCheckResourceAttr(resourceName, "is_enabled", "false"),
+				resource.TestCheckResourceAttr(resourceName, "reset_trigger", "1"),
 				resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
 				resource.TestCheckResourceAttr(resourceName, "source.0.anonymous_transactions_handling.#", "1"),
 				resource.TestCheckResourceAttr(resourceName, "source.0.anonymous_transactions_handling.0.policy", "ERROR_ON_ANONYMOUS"),
@@ -337,6 +339,7 @@ func TestMysqlChannelResource_basic(t *testing.T) {
 			ImportStateVerify: true,
 			ImportStateVerifyIgnore: []string{
 				"lifecycle_details",
+				"reset_trigger",
 				"source.0.password",
 			},
 			ResourceName: resourceName,
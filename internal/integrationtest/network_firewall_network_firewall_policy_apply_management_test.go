@@ -0,0 +1,72 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	networkFirewallPolicyApplyManagementRepresentation = map[string]interface{}{
+		"network_firewall_policy_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_network_firewall_network_firewall_policy.test_network_firewall_policy.id}`},
+		"depends_on":                 acctest.Representation{RepType: acctest.Required, Create: []string{`oci_network_firewall_network_firewall_policy_address_list.test_network_firewall_policy_address_list`}},
+	}
+
+	// replace_triggered_by applies the policy again in the same run as the component changes
+	networkFirewallPolicyApplyManagementReplaceTriggeredByConfig = `
+resource "oci_network_firewall_network_firewall_policy_apply_management" "test_network_firewall_policy_apply_management" {
+	network_firewall_policy_id = oci_network_firewall_network_firewall_policy.test_network_firewall_policy.id
+
+	lifecycle {
+		replace_triggered_by = [oci_network_firewall_network_firewall_policy_address_list.test_network_firewall_policy_address_list]
+	}
+}
+`
+)
+
+// issue-routing-tag: network_firewall/default
+func TestNetworkFirewallNetworkFirewallPolicyApplyManagementResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestNetworkFirewallNetworkFirewallPolicyApplyManagementResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_network_firewall_network_firewall_policy_apply_management.test_network_firewall_policy_apply_management"
+
+	// Save TF content to Create resource with only required properties. This has to be exactly the same as the config part in the create step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+createAddressListResourceConfig+
+		acctest.GenerateResourceFromRepresentationMap("oci_network_firewall_network_firewall_policy_apply_management", "test_network_firewall_policy_apply_management", acctest.Required, acctest.Create, networkFirewallPolicyApplyManagementRepresentation), "networkfirewall", "networkFirewallPolicyApplyManagement", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify apply after the components have been created
+		{
+			Config: config + compartmentIdVariableStr + createAddressListResourceConfig +
+				acctest.GenerateResourceFromRepresentationMap("oci_network_firewall_network_firewall_policy_apply_management", "test_network_firewall_policy_apply_management", acctest.Required, acctest.Create, networkFirewallPolicyApplyManagementRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "network_firewall_policy_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "policy_etag"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+			),
+		},
+		// verify apply again after the components have been changed
+		{
+			Config: config + compartmentIdVariableStr + addressListResourceConfig + networkFirewallPolicyApplyManagementReplaceTriggeredByConfig,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "policy_etag"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_updated"),
+			),
+		},
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+)
+
+var (
+	NetworkLoadBalancerTargetHealthDataSourceRepresentation = map[string]interface{}{
+		"network_load_balancer_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_network_load_balancer_network_load_balancer.test_network_load_balancer.id}`},
+		"target_id":                acctest.Representation{RepType: acctest.Required, Create: `${oci_network_load_balancer_backend.test_backend.target_id}`},
+	}
+
+	NetworkLoadBalancerTargetHealthResourceConfig = NlbBackendTargetRequiredOnlyResource
+)
+
+// issue-routing-tag: network_load_balancer/default
+func TestNetworkLoadBalancerTargetHealthResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestNetworkLoadBalancerTargetHealthResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	datasourceName := "data.oci_network_load_balancer_target_health.test_target_health"
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify datasource
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_network_load_balancer_target_health", "test_target_health", acctest.Required, acctest.Create, NetworkLoadBalancerTargetHealthDataSourceRepresentation) +
+				compartmentIdVariableStr + NetworkLoadBalancerTargetHealthResourceConfig,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(datasourceName, "network_load_balancer_id"),
+				resource.TestCheckResourceAttrSet(datasourceName, "target_id"),
+
+				resource.TestCheckResourceAttr(datasourceName, "backend_healths.#", "1"),
+				resource.TestCheckResourceAttrSet(datasourceName, "backend_healths.0.backend_name"),
+				resource.TestCheckResourceAttrSet(datasourceName, "backend_healths.0.backend_set_name"),
+				resource.TestCheckResourceAttrSet(datasourceName, "backend_healths.0.health_check_results.#"),
+				resource.TestCheckResourceAttr(datasourceName, "backend_healths.0.port", "10"),
+				resource.TestCheckResourceAttrSet(datasourceName, "backend_healths.0.status"),
+				resource.TestCheckResourceAttr(datasourceName, "backend_healths.0.weight", "10"),
+			),
+		},
+	})
+}
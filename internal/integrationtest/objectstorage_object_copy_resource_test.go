@@ -0,0 +1,124 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	objectCopyDestinationBucketName = testBucketName + "_copy"
+
+	ObjectStorageObjectCopyRepresentation = map[string]interface{}{
+		"destination_bucket":          acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_bucket.test_bucket_copy.name}`},
+		"destination_namespace":       acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_bucket.test_bucket_copy.namespace}`},
+		"destination_object":          acctest.Representation{RepType: acctest.Required, Create: `my-test-object-copy`},
+		"source_bucket":               acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_object.test_object.bucket}`},
+		"source_namespace":            acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_object.test_object.namespace}`},
+		"source_object":               acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_object.test_object.object}`},
+		"destination_object_metadata": acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"content-type": "text/plain"}},
+		"destination_region":          acctest.Representation{RepType: acctest.Optional, Create: `${var.region}`},
+		"source_region":               acctest.Representation{RepType: acctest.Optional, Create: `${var.region}`},
+	}
+
+	ObjectStorageObjectCopyResourceDependencies = ObjectStorageObjectResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_bucket", "test_bucket_copy", acctest.Required, acctest.Create,
+			acctest.RepresentationCopyWithNewProperties(ObjectStorageBucketRepresentation, map[string]interface{}{
+				"name": acctest.Representation{RepType: acctest.Required, Create: objectCopyDestinationBucketName},
+			})) +
+		acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_object", "test_object", acctest.Optional, acctest.Create, ObjectStorageObjectRepresentation)
+)
+
+// issue-routing-tag: object_storage/default
+func TestObjectStorageObjectCopyResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestObjectStorageObjectCopyResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_objectstorage_object_copy.test_object_copy"
+
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+ObjectStorageObjectCopyResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_object_copy", "test_object_copy", acctest.Optional, acctest.Create, ObjectStorageObjectCopyRepresentation), "objectstorage", "objectCopy", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageObjectCopyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_object_copy", "test_object_copy", acctest.Required, acctest.Create, ObjectStorageObjectCopyRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "destination_bucket", objectCopyDestinationBucketName),
+				resource.TestCheckResourceAttr(resourceName, "destination_object", "my-test-object-copy"),
+				resource.TestCheckResourceAttr(resourceName, "source_bucket", testBucketName),
+				resource.TestCheckResourceAttr(resourceName, "content_length", "7"),
+				resource.TestCheckResourceAttrSet(resourceName, "destination_region"),
+				resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				resource.TestCheckResourceAttrSet(resourceName, "source_region"),
+				resource.TestCheckResourceAttrSet(resourceName, "work_request_id"),
+			),
+		},
+
+		// verify the copied object is deleted with the resource
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageObjectCopyResourceDependencies,
+			Check:  testAccCheckObjectStorageObjectCopyDeleted("oci_objectstorage_bucket.test_bucket_copy", "my-test-object-copy"),
+		},
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageObjectCopyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_object_copy", "test_object_copy", acctest.Optional, acctest.Create, ObjectStorageObjectCopyRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "destination_object_metadata.%", "1"),
+				resource.TestCheckResourceAttr(resourceName, "content_length", "7"),
+				resource.TestCheckResourceAttrSet(resourceName, "content_md5"),
+				resource.TestCheckResourceAttrSet(resourceName, "etag"),
+			),
+		},
+	})
+}
+
+func testAccCheckObjectStorageObjectCopyDeleted(bucketResourceName string, objectName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[bucketResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", bucketResourceName)
+		}
+
+		client := acctest.TestAccProvider.Meta().(*tf_client.OracleClients).ObjectStorageClient()
+		bucket := rs.Primary.Attributes["name"]
+		namespace := rs.Primary.Attributes["namespace"]
+		request := oci_object_storage.HeadObjectRequest{
+			BucketName:    &bucket,
+			NamespaceName: &namespace,
+			ObjectName:    &objectName,
+		}
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
+
+		_, err := client.HeadObject(context.Background(), request)
+		if err == nil {
+			return fmt.Errorf("copied object %s still exists", objectName)
+		}
+		if failure, isServiceError := common.IsServiceError(err); !isServiceError || failure.GetHTTPStatusCode() != 404 {
+			return err
+		}
+		return nil
+	}
+}
@@ -2,6 +2,7 @@ package integrationtest
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -24,6 +25,29 @@ var (
 		"time_unit":   acctest.Representation{RepType: acctest.Required, Create: `DAYS`, Update: `YEARS`},
 		"target":      acctest.Representation{RepType: acctest.Optional, Update: `multipart-uploads`},
 	}
+
+	objectLifecyclePolicyDuplicateRuleNamesConfig = `
+resource "oci_objectstorage_object_lifecycle_policy" "test_object_lifecycle_policy" {
+	bucket    = "${oci_objectstorage_bucket.test_bucket.name}"
+	namespace = "${data.oci_objectstorage_namespace.test_namespace.namespace}"
+
+	rules {
+		action      = "ARCHIVE"
+		is_enabled  = "false"
+		name        = "sampleRule"
+		time_amount = "10"
+		time_unit   = "DAYS"
+	}
+
+	rules {
+		action      = "DELETE"
+		is_enabled  = "false"
+		name        = "sampleRule"
+		time_amount = "20"
+		time_unit   = "DAYS"
+	}
+}
+`
 )
 
 // issue-routing-tag: object_storage/default
@@ -156,6 +180,39 @@ func TestResourceObjectLifecyclePolicy_validations(t *testing.T) {
 			PlanOnly:           true,
 			ExpectNonEmptyPlan: true,
 		},
+		// actions are case insensitive
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageObjectLifecyclePolicyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_object_lifecycle_policy", "test_object_lifecycle_policy", acctest.Optional, acctest.Create,
+					acctest.GetUpdatedRepresentationCopy("rules.object_name_filter", acctest.RepresentationGroup{RepType: acctest.Optional, Group: objectLifecyclePolicyRulesObjectNameFilterOneValueIncludeRepresentation},
+						acctest.GetUpdatedRepresentationCopy("rules.action", acctest.Representation{RepType: acctest.Required, Create: `archive`}, ObjectStorageObjectLifecyclePolicyRepresentation))),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: false,
+		},
+		// rule names must be unique
+		{
+			Config:      config + compartmentIdVariableStr + ObjectStorageObjectLifecyclePolicyResourceDependencies + objectLifecyclePolicyDuplicateRuleNamesConfig,
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`more than one rule named "sampleRule"`),
+		},
+		// ABORT rules must target multipart uploads
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageObjectLifecyclePolicyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_object_lifecycle_policy", "test_object_lifecycle_policy", acctest.Optional, acctest.Create,
+					acctest.GetUpdatedRepresentationCopy("rules.action", acctest.Representation{RepType: acctest.Required, Create: `abort`}, ObjectStorageObjectLifecyclePolicyRepresentation)),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`which requires the target "multipart-uploads"`),
+		},
+		// multipart upload rules only support the ABORT action
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageObjectLifecyclePolicyResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_object_lifecycle_policy", "test_object_lifecycle_policy", acctest.Optional, acctest.Update,
+					acctest.RepresentationCopyWithNewProperties(ObjectStorageObjectLifecyclePolicyRepresentation, map[string]interface{}{
+						"rules": acctest.RepresentationGroup{RepType: acctest.Optional, Group: acctest.GetUpdatedRepresentationCopy("action", acctest.Representation{RepType: acctest.Required, Create: `DELETE`, Update: `DELETE`}, objectLifecyclePolicyRulesRepresentation_ForMultiPartUploads)},
+					})),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(`which only supports the action ABORT`),
+		},
 	})
 }
 
@@ -72,6 +72,11 @@ func TestResourcePrivateEndpoint(t *testing.T) {
 					resource.TestCheckResourceAttrSet(singularDatasourceName, "namespace"),
 					resource.TestCheckResourceAttr(singularDatasourceName, "compartment_id", compartmentId),
 					resource.TestCheckResourceAttr(singularDatasourceName, "prefix", "testPrefix"),
+					resource.TestCheckResourceAttr(singularDatasourceName, "fqdns.#", "1"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "fqdns.0.prefix_fqdns.0.object_storage_api_fqdn"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "fqdns.0.prefix_fqdns.0.s3compatibility_api_fqdn"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "fqdns.0.prefix_fqdns.0.swift_api_fqdn"),
+					resource.TestCheckResourceAttrSet(singularDatasourceName, "private_endpoint_ip"),
 					acctest.CheckResourceSetContainsElementWithProperties(singularDatasourceName, "access_targets",
 						map[string]string{
 							"namespace":      "*",
@@ -298,6 +298,7 @@ func TestObjectStoragePrivateEndpointResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttrSet(datasourceName, "private_endpoint_summaries.0.namespace"),
 				resource.TestCheckResourceAttrSet(datasourceName, "private_endpoint_summaries.0.time_created"),
 				resource.TestCheckResourceAttr(datasourceName, "private_endpoint_summaries.0.prefix", testPrefix),
+				resource.TestCheckResourceAttrSet(datasourceName, "private_endpoint_summaries.0.fqdns.0.prefix_fqdns.0.object_storage_api_fqdn"),
 			),
 		},
 	})
@@ -0,0 +1,118 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	ObjectStorageRetentionRuleRepresentation = map[string]interface{}{
+		"bucket":       acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_bucket.test_bucket.name}`},
+		"namespace":    acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_bucket.test_bucket.namespace}`},
+		"display_name": acctest.Representation{RepType: acctest.Optional, Create: `sampleRetentionRule`, Update: `sampleRetentionRule2`},
+		"duration":     acctest.RepresentationGroup{RepType: acctest.Optional, Group: ObjectStorageRetentionRuleDurationRepresentation},
+	}
+	ObjectStorageRetentionRuleDurationRepresentation = map[string]interface{}{
+		"time_amount": acctest.Representation{RepType: acctest.Required, Create: `10`, Update: `15`},
+		"time_unit":   acctest.Representation{RepType: acctest.Required, Create: `DAYS`, Update: `DAYS`},
+	}
+
+	ObjectStorageRetentionRuleResourceDependencies = acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_bucket", "test_bucket", acctest.Required, acctest.Create, ObjectStorageBucketRepresentation) +
+		acctest.GenerateDataSourceFromRepresentationMap("oci_objectstorage_namespace", "test_namespace", acctest.Required, acctest.Create, ObjectStorageObjectStorageNamespaceSingularDataSourceRepresentation)
+)
+
+// issue-routing-tag: object_storage/default
+func TestObjectStorageRetentionRuleResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestObjectStorageRetentionRuleResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_objectstorage_retention_rule.test_retention_rule"
+
+	var resId, resId2 string
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+ObjectStorageRetentionRuleResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_retention_rule", "test_retention_rule", acctest.Optional, acctest.Create, ObjectStorageRetentionRuleRepresentation), "objectstorage", "retentionRule", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageRetentionRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_retention_rule", "test_retention_rule", acctest.Required, acctest.Create, ObjectStorageRetentionRuleRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "bucket", testBucketName),
+				resource.TestCheckResourceAttrSet(resourceName, "namespace"),
+				resource.TestCheckResourceAttr(resourceName, "duration.#", "0"),
+				resource.TestCheckResourceAttrSet(resourceName, "retention_rule_id"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageRetentionRuleResourceDependencies,
+		},
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageRetentionRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_retention_rule", "test_retention_rule", acctest.Optional, acctest.Create, ObjectStorageRetentionRuleRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "bucket", testBucketName),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "sampleRetentionRule"),
+				resource.TestCheckResourceAttr(resourceName, "duration.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "duration.0.time_amount", "10"),
+				resource.TestCheckResourceAttr(resourceName, "duration.0.time_unit", "DAYS"),
+				resource.TestCheckResourceAttrSet(resourceName, "etag"),
+				resource.TestCheckResourceAttrSet(resourceName, "id"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_modified"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify updates to updatable parameters
+		{
+			Config: config + compartmentIdVariableStr + ObjectStorageRetentionRuleResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_retention_rule", "test_retention_rule", acctest.Optional, acctest.Update, ObjectStorageRetentionRuleRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "display_name", "sampleRetentionRule2"),
+				resource.TestCheckResourceAttr(resourceName, "duration.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "duration.0.time_amount", "15"),
+				resource.TestCheckResourceAttr(resourceName, "duration.0.time_unit", "DAYS"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+
+		// verify resource import
+		{
+			Config:            config + compartmentIdVariableStr + ObjectStorageRetentionRuleResourceDependencies + acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_retention_rule", "test_retention_rule", acctest.Optional, acctest.Update, ObjectStorageRetentionRuleRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ResourceName:      resourceName,
+		},
+	})
+}
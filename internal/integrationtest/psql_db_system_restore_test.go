@@ -0,0 +1,76 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	PsqlDbSystemRestoreRepresentation = map[string]interface{}{
+		"backup_id":       acctest.Representation{RepType: acctest.Required, Create: `${oci_psql_backup.test_backup.id}`},
+		"db_system_id":    acctest.Representation{RepType: acctest.Required, Create: `${oci_psql_db_system.test_db_system.id}`},
+		"restore_trigger": acctest.Representation{RepType: acctest.Optional, Create: `1`},
+	}
+
+	PsqlDbSystemRestoreResourceDependencies = PsqlDbSystemResourceDependencies +
+		acctest.GenerateResourceFromRepresentationMap("oci_psql_db_system", "test_db_system", acctest.Required, acctest.Create, PsqlDbSystemRepresentation) +
+		acctest.GenerateResourceFromRepresentationMap("oci_psql_backup", "test_backup", acctest.Required, acctest.Create,
+			acctest.RepresentationCopyWithNewProperties(PsqlBackupRepresentation, map[string]interface{}{
+				"db_system_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_psql_db_system.test_db_system.id}`},
+			}))
+)
+
+// issue-routing-tag: psql/default
+func TestPsqlDbSystemRestoreResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestPsqlDbSystemRestoreResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+	subnetId := utils.GetEnvSettingWithBlankDefault("subnet_ocid")
+	subnetIdVariableStr := fmt.Sprintf("variable \"subnet_id\" { default = \"%s\" }\n", subnetId)
+
+	resourceName := "oci_psql_db_system_restore.test_db_system_restore"
+
+	// Save TF content to Create resource with optional properties. This has to be exactly the same as the config part in the "Create with optionals" step in the test.
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+subnetIdVariableStr+PsqlDbSystemRestoreResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_psql_db_system_restore", "test_db_system_restore", acctest.Optional, acctest.Create, PsqlDbSystemRestoreRepresentation), "psql", "dbSystemRestore", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create
+		{
+			Config: config + compartmentIdVariableStr + subnetIdVariableStr + PsqlDbSystemRestoreResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_psql_db_system_restore", "test_db_system_restore", acctest.Required, acctest.Create, PsqlDbSystemRestoreRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(resourceName, "backup_id"),
+				resource.TestCheckResourceAttrSet(resourceName, "db_system_id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+			),
+		},
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + subnetIdVariableStr + PsqlDbSystemRestoreResourceDependencies,
+		},
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + subnetIdVariableStr + PsqlDbSystemRestoreResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_psql_db_system_restore", "test_db_system_restore", acctest.Optional, acctest.Create, PsqlDbSystemRestoreRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "restore_trigger", "1"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_updated"),
+			),
+		},
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	ResourcemanagerConfigurationSourceProviderRepresentation = map[string]interface{}{
+		"access_token":                acctest.Representation{RepType: acctest.Required, Create: `${var.github_access_token}`},
+		"api_endpoint":                acctest.Representation{RepType: acctest.Required, Create: `https://github.com/`},
+		"compartment_id":              acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"config_source_provider_type": acctest.Representation{RepType: acctest.Required, Create: `GITHUB_ACCESS_TOKEN`},
+		"description":                 acctest.Representation{RepType: acctest.Optional, Create: `description`, Update: `description2`},
+		"display_name":                acctest.Representation{RepType: acctest.Optional, Create: `displayName`, Update: `displayName2`},
+		"freeform_tags":               acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"Department": "Finance"}, Update: map[string]string{"Department": "Accounting"}},
+	}
+
+	ResourcemanagerStackGitConfigSourceRepresentation = map[string]interface{}{
+		"config_source_type":               acctest.Representation{RepType: acctest.Required, Create: `GIT_CONFIG_SOURCE`},
+		"configuration_source_provider_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_resourcemanager_configuration_source_provider.test_configuration_source_provider.id}`},
+		"branch_name":                      acctest.Representation{RepType: acctest.Required, Create: `main`},
+		"repository_url":                   acctest.Representation{RepType: acctest.Required, Create: `${var.github_repository_url}`},
+	}
+)
+
+// issue-routing-tag: resourcemanager/default
+func TestResourcemanagerConfigurationSourceProviderResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestResourcemanagerConfigurationSourceProviderResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	githubAccessToken := utils.GetEnvSettingWithBlankDefault("github_access_token")
+	githubAccessTokenVariableStr := fmt.Sprintf("variable \"github_access_token\" { default = \"%s\" }\n", githubAccessToken)
+
+	githubRepositoryUrl := utils.GetEnvSettingWithBlankDefault("github_repository_url")
+	githubRepositoryUrlVariableStr := fmt.Sprintf("variable \"github_repository_url\" { default = \"%s\" }\n", githubRepositoryUrl)
+
+	variableStr := compartmentIdVariableStr + githubAccessTokenVariableStr + githubRepositoryUrlVariableStr
+
+	resourceName := "oci_resourcemanager_configuration_source_provider.test_configuration_source_provider"
+	stackResourceName := "oci_resourcemanager_stack.test_stack"
+
+	var resId, resId2 string
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create with optionals
+		{
+			Config: config + variableStr +
+				acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_configuration_source_provider", "test_configuration_source_provider", acctest.Optional, acctest.Create, ResourcemanagerConfigurationSourceProviderRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "api_endpoint", "https://github.com/"),
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttr(resourceName, "config_source_provider_type", "GITHUB_ACCESS_TOKEN"),
+				resource.TestCheckResourceAttr(resourceName, "description", "description"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify updates to updatable parameters and a stack reading its configuration from the repository
+		{
+			Config: config + variableStr +
+				acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_configuration_source_provider", "test_configuration_source_provider", acctest.Optional, acctest.Update, ResourcemanagerConfigurationSourceProviderRepresentation) +
+				acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_stack", "test_stack", acctest.Required, acctest.Create,
+					acctest.RepresentationCopyWithNewProperties(ResourcemanagerStackRepresentation, map[string]interface{}{
+						"config_source": acctest.RepresentationGroup{RepType: acctest.Required, Group: ResourcemanagerStackGitConfigSourceRepresentation},
+					})),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+
+				resource.TestCheckResourceAttr(stackResourceName, "config_source.#", "1"),
+				resource.TestCheckResourceAttr(stackResourceName, "config_source.0.branch_name", "main"),
+				resource.TestCheckResourceAttr(stackResourceName, "config_source.0.config_source_type", "GIT_CONFIG_SOURCE"),
+				resource.TestCheckResourceAttrPair(stackResourceName, "config_source.0.configuration_source_provider_id", resourceName, "id"),
+				resource.TestCheckResourceAttr(stackResourceName, "config_source.0.repository_url", githubRepositoryUrl),
+				resource.TestCheckResourceAttr(stackResourceName, "state", "ACTIVE"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+		// verify resource import
+		{
+			Config:                  config + variableStr + acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_configuration_source_provider", "test_configuration_source_provider", acctest.Optional, acctest.Update, ResourcemanagerConfigurationSourceProviderRepresentation),
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"access_token"},
+			ResourceName:            resourceName,
+		},
+	})
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/service/resourcemanager"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	ResourcemanagerJobRepresentation = map[string]interface{}{
+		"operation":      acctest.Representation{RepType: acctest.Required, Create: `APPLY`},
+		"stack_id":       acctest.Representation{RepType: acctest.Required, Create: `${var.resource_manager_stack_id}`},
+		"display_name":   acctest.Representation{RepType: acctest.Optional, Create: `TestResourcemanagerJobResource_basic`},
+		"log_tail_lines": acctest.Representation{RepType: acctest.Optional, Create: `10`},
+	}
+)
+
+// issue-routing-tag: resourcemanager/default
+func TestResourcemanagerJobResource_basic(t *testing.T) {
+	if strings.Contains(utils.GetEnvSettingWithBlankDefault("suppressed_tests"), "TestResourcemanagerJobResource_basic") {
+		t.Skip("Skipping suppressed TestResourcemanagerJobResource_basic")
+	}
+
+	httpreplay.SetScenario("TestResourcemanagerJobResource_basic")
+	defer httpreplay.SaveScenario()
+
+	provider := acctest.TestAccProvider
+	config := acctest.ProviderTestConfig()
+
+	client := acctest.GetTestClients(&schema.ResourceData{}).ResourceManagerClient()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceManagerStackId, err := resourcemanager.CreateResourceManagerStack(*client, "TestResourcemanagerJobResource_basic", compartmentId)
+	if err != nil {
+		t.Errorf("cannot Create resource manager stack for the test run: %v", err)
+	}
+	resourceManagerStackIdVariableStr := fmt.Sprintf("variable \"resource_manager_stack_id\" { default = \"%s\" }\n", resourceManagerStackId)
+
+	resourceName := "oci_resourcemanager_job.test_job"
+
+	acctest.SaveConfigContent("", "", "", t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: func(s *terraform.State) error {
+			return resourcemanager.DestroyResourceManagerStack(*client, resourceManagerStackId)
+		},
+		PreventPostDestroyRefresh: true,
+		Providers: map[string]*schema.Provider{
+			"oci": provider,
+		},
+		Steps: []resource.TestStep{
+			// verify create
+			{
+				Config: config + compartmentIdVariableStr + resourceManagerStackIdVariableStr +
+					acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_job", "test_job", acctest.Optional, acctest.Create, ResourcemanagerJobRepresentation),
+				Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+					resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "TestResourcemanagerJobResource_basic"),
+					resource.TestCheckResourceAttr(resourceName, "execution_plan_strategy", "AUTO_APPROVED"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_tail"),
+					resource.TestCheckResourceAttr(resourceName, "log_tail_lines", "10"),
+					resource.TestCheckResourceAttr(resourceName, "operation", "APPLY"),
+					resource.TestCheckResourceAttrSet(resourceName, "outputs.#"),
+					resource.TestCheckResourceAttr(resourceName, "stack_id", resourceManagerStackId),
+					resource.TestCheckResourceAttr(resourceName, "state", "SUCCEEDED"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+					resource.TestCheckResourceAttrSet(resourceName, "time_finished"),
+				),
+			},
+		},
+	})
+}
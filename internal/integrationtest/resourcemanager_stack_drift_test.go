@@ -0,0 +1,60 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	ResourcemanagerStackDriftSingularDataSourceRepresentation = map[string]interface{}{
+		"stack_id":              acctest.Representation{RepType: acctest.Required, Create: `${oci_resourcemanager_job.test_job.stack_id}`},
+		"detect_drift":          acctest.Representation{RepType: acctest.Optional, Create: `true`},
+		"resource_drift_status": acctest.Representation{RepType: acctest.Optional, Create: []string{`IN_SYNC`}},
+	}
+
+	ResourcemanagerStackDriftResourceConfig = acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_stack", "test_stack", acctest.Required, acctest.Create, ResourcemanagerStackRepresentation) +
+		acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_job", "test_job", acctest.Required, acctest.Create,
+			acctest.RepresentationCopyWithNewProperties(ResourcemanagerJobRepresentation, map[string]interface{}{
+				"stack_id": acctest.Representation{RepType: acctest.Required, Create: `${oci_resourcemanager_stack.test_stack.id}`},
+			}))
+)
+
+// issue-routing-tag: resourcemanager/default
+func TestResourcemanagerStackDriftResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestResourcemanagerStackDriftResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	singularDatasourceName := "data.oci_resourcemanager_stack_drift.test_stack_drift"
+
+	acctest.SaveConfigContent("", "", "", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify singular datasource
+		{
+			Config: config + compartmentIdVariableStr + ResourcemanagerStackDriftResourceConfig +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_resourcemanager_stack_drift", "test_stack_drift", acctest.Optional, acctest.Create, ResourcemanagerStackDriftSingularDataSourceRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "stack_id"),
+
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "resource_drift_details.#"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "stack_drift_status", "IN_SYNC"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "time_drift_last_checked"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "work_request_id"),
+			),
+		},
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	// zip archive with a single main.tf that declares a "greeting" output
+	resourcemanagerStackZipFileBase64Encoded = `UEsDBBQAAAAAAKYTUV2qhT3OKAAAACgAAAAHAAAAbWFpbi50Zm91dHB1dCAiZ3JlZXRpbmciIHsKICB2YWx1ZSA9ICJoZWxsbyIKfQpQSwECFAMUAAAAAACmE1FdqoU9zigAAAAoAAAABwAAAAAAAAAAAAAAgAEAAAAAbWFpbi50ZlBLBQYAAAAAAQABADUAAABNAAAAAAA=`
+
+	ResourcemanagerStackRepresentation = map[string]interface{}{
+		"compartment_id":    acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"config_source":     acctest.RepresentationGroup{RepType: acctest.Required, Group: ResourcemanagerStackZipUploadConfigSourceRepresentation},
+		"description":       acctest.Representation{RepType: acctest.Optional, Create: `description`, Update: `description2`},
+		"display_name":      acctest.Representation{RepType: acctest.Optional, Create: `displayName`, Update: `displayName2`},
+		"freeform_tags":     acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"Department": "Finance"}, Update: map[string]string{"Department": "Accounting"}},
+		"terraform_version": acctest.Representation{RepType: acctest.Optional, Create: `1.2.x`, Update: `1.5.x`},
+		"variables":         acctest.Representation{RepType: acctest.Optional, Create: map[string]string{"var1": "value1"}, Update: map[string]string{"var1": "value2"}},
+	}
+	ResourcemanagerStackZipUploadConfigSourceRepresentation = map[string]interface{}{
+		"config_source_type":     acctest.Representation{RepType: acctest.Required, Create: `ZIP_UPLOAD`},
+		"zip_file_base64encoded": acctest.Representation{RepType: acctest.Required, Create: resourcemanagerStackZipFileBase64Encoded},
+	}
+	ResourcemanagerStackObjectStorageConfigSourceRepresentation = map[string]interface{}{
+		"bucket_name":        acctest.Representation{RepType: acctest.Required, Create: `${oci_objectstorage_bucket.test_bucket.name}`},
+		"config_source_type": acctest.Representation{RepType: acctest.Required, Create: `OBJECT_STORAGE_CONFIG_SOURCE`},
+		"namespace":          acctest.Representation{RepType: acctest.Required, Create: `${data.oci_objectstorage_namespace.test_namespace.namespace}`},
+		"region":             acctest.Representation{RepType: acctest.Required, Create: `${var.region}`},
+	}
+
+	ResourcemanagerStackResourceDependencies = acctest.GenerateDataSourceFromRepresentationMap("oci_objectstorage_namespace", "test_namespace", acctest.Required, acctest.Create, ObjectStorageObjectStorageNamespaceSingularDataSourceRepresentation) +
+		acctest.GenerateResourceFromRepresentationMap("oci_objectstorage_bucket", "test_bucket", acctest.Required, acctest.Create, ObjectStorageBucketRepresentation)
+)
+
+// issue-routing-tag: resourcemanager/default
+func TestResourcemanagerStackResource_configSource(t *testing.T) {
+	httpreplay.SetScenario("TestResourcemanagerStackResource_configSource")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_resourcemanager_stack.test_stack"
+
+	var resId, resId2 string
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + ResourcemanagerStackResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_stack", "test_stack", acctest.Optional, acctest.Create, ResourcemanagerStackRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttr(resourceName, "config_source.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "config_source.0.config_source_type", "ZIP_UPLOAD"),
+				resource.TestCheckResourceAttr(resourceName, "config_source.0.zip_file_base64encoded", resourcemanagerStackZipFileBase64Encoded),
+				resource.TestCheckResourceAttr(resourceName, "description", "description"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+				resource.TestCheckResourceAttr(resourceName, "terraform_version", "1.2.x"),
+				resource.TestCheckResourceAttrSet(resourceName, "time_created"),
+				resource.TestCheckResourceAttr(resourceName, "variables.%", "1"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					return err
+				},
+			),
+		},
+
+		// verify updates to updatable parameters and switching to an Object Storage config source
+		{
+			Config: config + compartmentIdVariableStr + ResourcemanagerStackResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_stack", "test_stack", acctest.Optional, acctest.Update,
+					acctest.RepresentationCopyWithNewProperties(ResourcemanagerStackRepresentation, map[string]interface{}{
+						"config_source": acctest.RepresentationGroup{RepType: acctest.Required, Group: ResourcemanagerStackObjectStorageConfigSourceRepresentation},
+					})),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttr(resourceName, "config_source.#", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "config_source.0.bucket_name"),
+				resource.TestCheckResourceAttr(resourceName, "config_source.0.config_source_type", "OBJECT_STORAGE_CONFIG_SOURCE"),
+				resource.TestCheckResourceAttrSet(resourceName, "config_source.0.namespace"),
+				resource.TestCheckResourceAttrSet(resourceName, "config_source.0.region"),
+				resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+				resource.TestCheckResourceAttr(resourceName, "freeform_tags.%", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "id"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+				resource.TestCheckResourceAttr(resourceName, "terraform_version", "1.5.x"),
+				resource.TestCheckResourceAttr(resourceName, "variables.var1", "value2"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					return err
+				},
+			),
+		},
+		// verify resource import
+		{
+			Config: config + ResourcemanagerStackResourceDependencies + compartmentIdVariableStr + acctest.GenerateResourceFromRepresentationMap("oci_resourcemanager_stack", "test_stack", acctest.Optional, acctest.Update,
+				acctest.RepresentationCopyWithNewProperties(ResourcemanagerStackRepresentation, map[string]interface{}{
+					"config_source": acctest.RepresentationGroup{RepType: acctest.Required, Group: ResourcemanagerStackObjectStorageConfigSourceRepresentation},
+				})),
+			ImportState:       true,
+			ImportStateVerify: true,
+			ResourceName:      resourceName,
+		},
+	})
+}
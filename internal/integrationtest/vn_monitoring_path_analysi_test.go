@@ -139,6 +139,9 @@ func TestVnMonitoringPathAnalysiResource_basic(t *testing.T) {
 				resource.TestCheckResourceAttrSet(resourceName, "source_endpoint.0.address"),
 				resource.TestCheckResourceAttr(resourceName, "source_endpoint.0.type", "IP_ADDRESS"),
 				resource.TestCheckResourceAttr(resourceName, "type", "ADHOC_QUERY"),
+				resource.TestCheckResourceAttrSet(resourceName, "paths.#"),
+				resource.TestCheckResourceAttr(resourceName, "paths.0.forward_route.#", "1"),
+				resource.TestCheckResourceAttrSet(resourceName, "paths.0.forward_route.0.reachability_status"),
 			),
 		},
 
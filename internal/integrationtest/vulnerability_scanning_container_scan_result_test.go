@@ -0,0 +1,66 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	VulnerabilityScanningContainerScanResultSingularDataSourceRepresentation = map[string]interface{}{
+		"compartment_id": acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"image":          acctest.Representation{RepType: acctest.Required, Create: `${var.container_scan_image}`},
+		"repository":     acctest.Representation{RepType: acctest.Required, Create: `${var.container_scan_repository}`},
+	}
+)
+
+// issue-routing-tag: vulnerability_scanning/default
+func TestVulnerabilityScanningContainerScanResultResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestVulnerabilityScanningContainerScanResultResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	image := utils.GetEnvSettingWithBlankDefault("container_scan_image")
+	imageVariableStr := fmt.Sprintf("variable \"container_scan_image\" { default = \"%s\" }\n", image)
+
+	repository := utils.GetEnvSettingWithBlankDefault("container_scan_repository")
+	repositoryVariableStr := fmt.Sprintf("variable \"container_scan_repository\" { default = \"%s\" }\n", repository)
+
+	singularDatasourceName := "data.oci_vulnerability_scanning_container_scan_result.test_container_scan_result"
+
+	acctest.SaveConfigContent("", "", "", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify singular datasource
+		{
+			Config: config +
+				acctest.GenerateDataSourceFromRepresentationMap("oci_vulnerability_scanning_container_scan_result", "test_container_scan_result", acctest.Required, acctest.Create, VulnerabilityScanningContainerScanResultSingularDataSourceRepresentation) +
+				compartmentIdVariableStr + imageVariableStr + repositoryVariableStr,
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "compartment_id"),
+				resource.TestCheckResourceAttr(singularDatasourceName, "image", image),
+				resource.TestCheckResourceAttr(singularDatasourceName, "repository", repository),
+
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "highest_problem_severity"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "id"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "problem_count"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "problems.#"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "time_finished"),
+				resource.TestCheckResourceAttrSet(singularDatasourceName, "time_started"),
+			),
+		},
+	})
+}
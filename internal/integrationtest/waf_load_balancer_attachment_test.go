@@ -0,0 +1,133 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/acctest"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+var (
+	WafLoadBalancerAttachmentRepresentation = map[string]interface{}{
+		"compartment_id":             acctest.Representation{RepType: acctest.Required, Create: `${var.compartment_id}`},
+		"load_balancer_id":           acctest.Representation{RepType: acctest.Required, Create: `${oci_load_balancer_load_balancer.test_load_balancer.id}`},
+		"block_response_code":        acctest.Representation{RepType: acctest.Optional, Create: `401`, Update: `403`},
+		"display_name":               acctest.Representation{RepType: acctest.Optional, Create: `displayName`, Update: `displayName2`},
+		"is_body_inspection_enabled": acctest.Representation{RepType: acctest.Optional, Create: `false`, Update: `true`},
+		"protection_capabilities":    acctest.RepresentationGroup{RepType: acctest.Optional, Group: WafLoadBalancerAttachmentProtectionCapabilitiesRepresentation},
+	}
+	WafLoadBalancerAttachmentProtectionCapabilitiesRepresentation = map[string]interface{}{
+		"key":     acctest.Representation{RepType: acctest.Required, Create: `920360`, Update: `920350`},
+		"version": acctest.Representation{RepType: acctest.Optional, Create: `1`},
+	}
+
+	WafLoadBalancerAttachmentResourceDependencies = acctest.GenerateResourceFromRepresentationMap("oci_load_balancer_load_balancer", "test_load_balancer", acctest.Required, acctest.Create, loadBalancerRepresentation) +
+		LoadBalancerSubnetDependencies
+)
+
+// issue-routing-tag: waf/default
+func TestWafLoadBalancerAttachmentResource_basic(t *testing.T) {
+	httpreplay.SetScenario("TestWafLoadBalancerAttachmentResource_basic")
+	defer httpreplay.SaveScenario()
+
+	config := acctest.ProviderTestConfig()
+
+	compartmentId := utils.GetEnvSettingWithBlankDefault("compartment_ocid")
+	compartmentIdVariableStr := fmt.Sprintf("variable \"compartment_id\" { default = \"%s\" }\n", compartmentId)
+
+	resourceName := "oci_waf_load_balancer_attachment.test_load_balancer_attachment"
+
+	var resId, resId2, policyId, policyId2 string
+
+	acctest.SaveConfigContent(config+compartmentIdVariableStr+WafLoadBalancerAttachmentResourceDependencies+
+		acctest.GenerateResourceFromRepresentationMap("oci_waf_load_balancer_attachment", "test_load_balancer_attachment", acctest.Optional, acctest.Create, WafLoadBalancerAttachmentRepresentation), "waf", "loadBalancerAttachment", t)
+
+	acctest.ResourceTest(t, nil, []resource.TestStep{
+		// verify Create with defaults
+		{
+			Config: config + compartmentIdVariableStr + WafLoadBalancerAttachmentResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_waf_load_balancer_attachment", "test_load_balancer_attachment", acctest.Required, acctest.Create, WafLoadBalancerAttachmentRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "compartment_id", compartmentId),
+				resource.TestCheckResourceAttrSet(resourceName, "load_balancer_id"),
+				resource.TestCheckResourceAttr(resourceName, "block_response_code", "401"),
+				resource.TestCheckResourceAttr(resourceName, "is_policy_managed", "true"),
+				resource.TestCheckResourceAttr(resourceName, "protection_capabilities.#", "0"),
+				resource.TestCheckResourceAttrSet(resourceName, "web_app_firewall_policy_id"),
+			),
+		},
+
+		// delete before next Create
+		{
+			Config: config + compartmentIdVariableStr + WafLoadBalancerAttachmentResourceDependencies,
+		},
+		// verify Create with optionals
+		{
+			Config: config + compartmentIdVariableStr + WafLoadBalancerAttachmentResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_waf_load_balancer_attachment", "test_load_balancer_attachment", acctest.Optional, acctest.Create, WafLoadBalancerAttachmentRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "block_response_code", "401"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName"),
+				resource.TestCheckResourceAttr(resourceName, "is_body_inspection_enabled", "false"),
+				resource.TestCheckResourceAttr(resourceName, "protection_capabilities.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "protection_capabilities.0.key", "920360"),
+				resource.TestCheckResourceAttr(resourceName, "protection_capabilities.0.version", "1"),
+				resource.TestCheckResourceAttr(resourceName, "state", "ACTIVE"),
+
+				func(s *terraform.State) (err error) {
+					resId, err = acctest.FromInstanceState(s, resourceName, "id")
+					if err != nil {
+						return err
+					}
+					policyId, err = acctest.FromInstanceState(s, resourceName, "web_app_firewall_policy_id")
+					return err
+				},
+			),
+		},
+
+		// verify the policy is replaced in place of the firewall
+		{
+			Config: config + compartmentIdVariableStr + WafLoadBalancerAttachmentResourceDependencies +
+				acctest.GenerateResourceFromRepresentationMap("oci_waf_load_balancer_attachment", "test_load_balancer_attachment", acctest.Optional, acctest.Update, WafLoadBalancerAttachmentRepresentation),
+			Check: acctest.ComposeAggregateTestCheckFuncWrapper(
+				resource.TestCheckResourceAttr(resourceName, "block_response_code", "403"),
+				resource.TestCheckResourceAttr(resourceName, "display_name", "displayName2"),
+				resource.TestCheckResourceAttr(resourceName, "is_body_inspection_enabled", "true"),
+				resource.TestCheckResourceAttr(resourceName, "protection_capabilities.#", "1"),
+				resource.TestCheckResourceAttr(resourceName, "protection_capabilities.0.key", "920350"),
+
+				func(s *terraform.State) (err error) {
+					resId2, err = acctest.FromInstanceState(s, resourceName, "id")
+					if resId != resId2 {
+						return fmt.Errorf("Resource recreated when it was supposed to be updated.")
+					}
+					policyId2, err = acctest.FromInstanceState(s, resourceName, "web_app_firewall_policy_id")
+					if policyId == policyId2 {
+						return fmt.Errorf("expected the web app firewall policy to be replaced")
+					}
+					return err
+				},
+			),
+		},
+
+		// verify resource import
+		{
+			Config:            config + compartmentIdVariableStr + WafLoadBalancerAttachmentResourceDependencies + acctest.GenerateResourceFromRepresentationMap("oci_waf_load_balancer_attachment", "test_load_balancer_attachment", acctest.Optional, acctest.Update, WafLoadBalancerAttachmentRepresentation),
+			ImportState:       true,
+			ImportStateVerify: true,
+			// An imported firewall does not own its policy
+			ImportStateVerifyIgnore: []string{
+				"is_policy_managed",
+			},
+			ResourceName: resourceName,
+		},
+	})
+}
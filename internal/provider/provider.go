@@ -107,6 +107,7 @@ func init() {
 			"The actual retry duration may be longer due to jittering of retry operations. This value is ignored if the `disable_auto_retries` field is set to true.",
 		globalvar.ConfigFileProfileAttrName:                   "(Optional) The profile name to be used from config file, if not set it will be DEFAULT.",
 		globalvar.DefinedTagsToIgnore:                         "(Optional) List of defined tags keys that Terraform should ignore when planning creates and updates to the associated remote object",
+		globalvar.ComputedDefinedTagNamespaces:                "(Optional) List of defined tag namespaces populated by the service, e.g. through tag defaults. Tags from these namespaces that are not in the configuration are kept instead of being planned for removal",
 		globalvar.RealmSpecificServiceEndpointTemplateEnabled: "(Optional) flags to enable realm specific service endpoint.",
 	}
 }
@@ -201,6 +202,12 @@ func SchemaMap() map[string]*schema.Schema {
 			Description: descriptions[globalvar.DefinedTagsToIgnore],
 			MaxItems:    100,
 		},
+		globalvar.ComputedDefinedTagNamespaces: {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: descriptions[globalvar.ComputedDefinedTagNamespaces],
+		},
 		globalvar.RealmSpecificServiceEndpointTemplateEnabled: {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -214,14 +221,14 @@ func SchemaMap() map[string]*schema.Schema {
 func DataSourcesMap() map[string]*schema.Resource {
 	// Register some aliases of registered datasources. These are registered for convenience and legacy reasons.
 	if oci_common.CheckForEnabledServices(globalvar.CoreService) {
-		tf_resource.RegisterDatasource("oci_core_listing_resource_version", tf_core.CoreAppCatalogListingResourceVersionDataSource())
-		tf_resource.RegisterDatasource("oci_core_listing_resource_versions", tf_core.CoreAppCatalogListingResourceVersionsDataSource())
-		tf_resource.RegisterDatasource("oci_core_shape", tf_core.CoreShapesDataSource())
-		tf_resource.RegisterDatasource("oci_core_virtual_networks", tf_core.CoreVcnsDataSource())
+		tf_resource.RegisterDeprecatedDatasourceAlias("oci_core_listing_resource_version", "oci_core_app_catalog_listing_resource_version", tf_core.CoreAppCatalogListingResourceVersionDataSource())
+		tf_resource.RegisterDeprecatedDatasourceAlias("oci_core_listing_resource_versions", "oci_core_app_catalog_listing_resource_versions", tf_core.CoreAppCatalogListingResourceVersionsDataSource())
+		tf_resource.RegisterDeprecatedDatasourceAlias("oci_core_shape", "oci_core_shapes", tf_core.CoreShapesDataSource())
+		tf_resource.RegisterDeprecatedDatasourceAlias("oci_core_virtual_networks", "oci_core_vcns", tf_core.CoreVcnsDataSource())
 	}
 	if oci_common.CheckForEnabledServices(globalvar.LoadBalancerService) {
-		tf_resource.RegisterDatasource("oci_load_balancers", tf_load_balancer.LoadBalancerLoadBalancersDataSource())
-		tf_resource.RegisterDatasource("oci_load_balancer_backendsets", tf_load_balancer.LoadBalancerBackendSetsDataSource())
+		tf_resource.RegisterDeprecatedDatasourceAlias("oci_load_balancers", "oci_load_balancer_load_balancers", tf_load_balancer.LoadBalancerLoadBalancersDataSource())
+		tf_resource.RegisterDeprecatedDatasourceAlias("oci_load_balancer_backendsets", "oci_load_balancer_backend_sets", tf_load_balancer.LoadBalancerBackendSetsDataSource())
 	}
 	return globalvar.OciDatasources
 }
@@ -231,17 +238,18 @@ func DataSourcesMap() map[string]*schema.Resource {
 func ResourcesMap() map[string]*schema.Resource {
 	// Register some aliases of registered resources. These are registered for convenience and legacy reasons.
 	if oci_common.CheckForEnabledServices(globalvar.CoreService) {
-		tf_resource.RegisterResource("oci_core_virtual_network", tf_core.CoreVcnResource())
+		tf_resource.RegisterDeprecatedResourceAlias("oci_core_virtual_network", "oci_core_vcn", tf_core.CoreVcnResource())
 	}
 	if oci_common.CheckForEnabledServices(globalvar.LoadBalancerService) {
-		tf_resource.RegisterResource("oci_load_balancer", tf_load_balancer.LoadBalancerLoadBalancerResource())
-		tf_resource.RegisterResource("oci_load_balancer_backendset", tf_load_balancer.LoadBalancerBackendSetResource())
+		tf_resource.RegisterDeprecatedResourceAlias("oci_load_balancer", "oci_load_balancer_load_balancer", tf_load_balancer.LoadBalancerLoadBalancerResource())
+		tf_resource.RegisterDeprecatedResourceAlias("oci_load_balancer_backendset", "oci_load_balancer_backend_set", tf_load_balancer.LoadBalancerBackendSetResource())
 	}
 	return globalvar.OciResources
 }
 
 func ProviderConfig(d *schema.ResourceData) (interface{}, error) {
 	tf_resource.DefinedTagsToSuppress = IgnoreDefinedTags(d)
+	tf_resource.ComputedDefinedTagNamespaces = computedDefinedTagNamespaces(d)
 	tf_resource.RealmSpecificServiceEndpointTemplateEnabled = realmSpecificServiceEndpointTemplateEnabled(d)
 	clients := &tf_client.OracleClients{
 		SdkClientMap:  make(map[string]interface{}, len(tf_client.OracleClientRegistrationsVar.RegisteredClients)),
@@ -519,6 +527,18 @@ func IgnoreDefinedTags(d schemaResourceData) []string {
 	}
 	return nil
 }
+
+func computedDefinedTagNamespaces(d schemaResourceData) []string {
+	if namespaces, ok := d.GetOkExists(globalvar.ComputedDefinedTagNamespaces); ok {
+		var result []string
+		for _, item := range namespaces.([]interface{}) {
+			result = append(result, item.(string))
+		}
+		return result
+	}
+	return nil
+}
+
 func realmSpecificServiceEndpointTemplateEnabled(d schemaResourceData) string {
 	if flag, ok := d.GetOkExists(globalvar.RealmSpecificServiceEndpointTemplateEnabled); ok {
 		return strconv.FormatBool(flag.(bool))
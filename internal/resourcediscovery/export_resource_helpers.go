@@ -21,6 +21,7 @@ import (
 
 	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
@@ -474,7 +475,8 @@ func (r *resourceDiscoveryWithTargetIds) discover() error {
 			continue
 		}
 
-		resourceClass := subMatchAll[1]
+		// legacy names are resolved so that the resource hints and the generated configuration use the current name
+		resourceClass := tfresource.ResolveResourceAlias(subMatchAll[1])
 		resourceId, _ := url.PathUnescape(subMatchAll[2])
 
 		utils.Logf("===> Finding resource with ID '%s' and type '%s'", resourceId, resourceClass)
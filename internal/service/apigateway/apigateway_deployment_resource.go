@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -29,11 +30,12 @@ func ApigatewayDeploymentResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createApigatewayDeployment,
-		Read:     readApigatewayDeployment,
-		Update:   updateApigatewayDeployment,
-		Delete:   deleteApigatewayDeployment,
+		Timeouts:      tfresource.DefaultTimeout,
+		Create:        createApigatewayDeployment,
+		Read:          readApigatewayDeployment,
+		Update:        updateApigatewayDeployment,
+		Delete:        deleteApigatewayDeployment,
+		CustomizeDiff: validateApiSpecificationRoutes,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -3415,7 +3417,11 @@ func (s *ApigatewayDeploymentResourceCrud) SetData() error {
 	}
 
 	if s.Res.Specification != nil {
-		s.D.Set("specification", []interface{}{ApiSpecificationToMap(s.Res.Specification, false)})
+		specification := ApiSpecificationToMap(s.Res.Specification, false)
+		if routes, ok := s.D.GetOk("specification.0.routes"); ok {
+			specification["routes"] = normalizeApiSpecificationRoutes(specification["routes"].([]interface{}), routes.([]interface{}))
+		}
+		s.D.Set("specification", []interface{}{specification})
 	} else {
 		s.D.Set("specification", nil)
 	}
@@ -6768,3 +6774,143 @@ func mediaTypeHashCodeForBodyValidationContentSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
+
+// apiSpecificationRouteKey identifies a route by its path and the sorted methods it serves
+func apiSpecificationRouteKey(path interface{}, methods []string) string {
+	sorted := make([]string, len(methods))
+	for i, method := range methods {
+		sorted[i] = strings.ToUpper(method)
+	}
+	sort.Strings(sorted)
+
+	return fmt.Sprintf("%v %s", path, strings.Join(sorted, ","))
+}
+
+func interfaceListToStrings(values interface{}) []string {
+	result := []string{}
+	switch v := values.(type) {
+	case []string:
+		result = append(result, v...)
+	case []interface{}:
+		for _, value := range v {
+			if value != nil {
+				result = append(result, value.(string))
+			}
+		}
+	}
+
+	return result
+}
+
+// normalizeApiSpecificationRoutes brings the routes returned by the service into the order of the previous routes. The
+// service may reorder the routes and their methods, which would otherwise show up as changes of the whole specification.
+// Routes are matched by path and methods first and by path only second, unmatched routes are kept at the end.
+func normalizeApiSpecificationRoutes(routes []interface{}, previousRoutes []interface{}) []interface{} {
+	result := []interface{}{}
+	used := make([]bool, len(routes))
+
+	matched := make([]interface{}, len(previousRoutes))
+	for _, byMethods := range []bool{true, false} {
+		for i, previousRoute := range previousRoutes {
+			previous, ok := previousRoute.(map[string]interface{})
+			if !ok || matched[i] != nil {
+				continue
+			}
+
+			previousMethods := interfaceListToStrings(previous["methods"])
+			for j, route := range routes {
+				current := route.(map[string]interface{})
+				if used[j] || current["path"] != previous["path"] {
+					continue
+				}
+
+				currentMethods := interfaceListToStrings(current["methods"])
+				if byMethods && apiSpecificationRouteKey(current["path"], currentMethods) != apiSpecificationRouteKey(previous["path"], previousMethods) {
+					continue
+				}
+
+				// the methods are the same set, keep them in the previous order
+				if apiSpecificationRouteKey(current["path"], currentMethods) == apiSpecificationRouteKey(previous["path"], previousMethods) {
+					current["methods"] = previousMethods
+				}
+
+				used[j] = true
+				matched[i] = current
+				break
+			}
+		}
+	}
+
+	for _, route := range matched {
+		if route != nil {
+			result = append(result, route)
+		}
+	}
+
+	for j, route := range routes {
+		if !used[j] {
+			result = append(result, route)
+		}
+	}
+
+	return result
+}
+
+// validateApiSpecificationRoutes rejects route combinations the service would refuse when the deployment is applied
+func validateApiSpecificationRoutes(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	routes, ok := d.Get("specification.0.routes").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	routeKeys := map[string]int{}
+	for i := range routes {
+		fieldKeyFormat := fmt.Sprintf("specification.0.routes.%d.%%s", i)
+
+		if d.NewValueKnown(fmt.Sprintf(fieldKeyFormat, "path")) {
+			path := d.Get(fmt.Sprintf(fieldKeyFormat, "path")).(string)
+			if !strings.HasPrefix(path, "/") {
+				return fmt.Errorf("specification.0.routes.%d: path %q must start with /", i, path)
+			}
+
+			if d.NewValueKnown(fmt.Sprintf(fieldKeyFormat, "methods")) {
+				methods := interfaceListToStrings(d.Get(fmt.Sprintf(fieldKeyFormat, "methods")))
+				// routes without methods serve the default methods of the service
+				if len(methods) == 0 {
+					methods = []string{"default"}
+				}
+
+				for _, method := range methods {
+					key := apiSpecificationRouteKey(path, []string{method})
+					if other, ok := routeKeys[key]; ok {
+						return fmt.Errorf("specification.0.routes.%d: method %s of path %s is already served by specification.0.routes.%d", i, strings.ToUpper(method), path, other)
+					}
+					routeKeys[key] = i
+				}
+			}
+		}
+
+		backendKeyFormat := fmt.Sprintf(fieldKeyFormat, "backend.0.%s")
+		if !d.NewValueKnown(fmt.Sprintf(backendKeyFormat, "type")) {
+			continue
+		}
+
+		var required string
+		switch strings.ToUpper(d.Get(fmt.Sprintf(backendKeyFormat, "type")).(string)) {
+		case "HTTP_BACKEND":
+			required = "url"
+		case "ORACLE_FUNCTIONS_BACKEND":
+			required = "function_id"
+		case "STOCK_RESPONSE_BACKEND":
+			required = "status"
+		case "DYNAMIC_ROUTING_BACKEND":
+			required = "routing_backends"
+		}
+
+		if required != "" && !tfresource.IsAttributeConfigured(d, fmt.Sprintf(backendKeyFormat, required)) {
+			return fmt.Errorf("specification.0.routes.%d: backend.0.%s is required for backends of type %s", i, required, d.Get(fmt.Sprintf(backendKeyFormat, "type")))
+		}
+	}
+
+	return nil
+}
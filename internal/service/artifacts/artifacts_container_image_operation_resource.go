@@ -0,0 +1,235 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
+)
+
+func ArtifactsContainerImageOperationResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createArtifactsContainerImageOperation,
+		Read:     readArtifactsContainerImageOperation,
+		Delete:   deleteArtifactsContainerImageOperation,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"operation": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"delete",
+					"remove_version",
+					"restore",
+				}, true),
+			},
+
+			// Optional
+			"operation_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createArtifactsContainerImageOperation(d *schema.ResourceData, m interface{}) error {
+	sync := &ArtifactsContainerImageOperationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ArtifactsClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+// The image is kept as recorded after the operation, a deleted image eventually cannot be read anymore
+func readArtifactsContainerImageOperation(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+// An operation cannot be undone by destroying the resource, run the opposite operation instead
+func deleteArtifactsContainerImageOperation(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type ArtifactsContainerImageOperationResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_artifacts.ArtifactsClient
+	Res                    *oci_artifacts.ContainerImage
+	DisableNotFoundRetries bool
+}
+
+func (s *ArtifactsContainerImageOperationResourceCrud) ID() string {
+	return tfresource.GenerateDataSourceHashID("ArtifactsContainerImageOperationResource-", ArtifactsContainerImageOperationResource(), s.D)
+}
+
+func (s *ArtifactsContainerImageOperationResourceCrud) Create() error {
+	imageId := s.D.Get("image_id").(string)
+	retryPolicy := tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+
+	var version *string
+	if tmp, ok := s.D.GetOkExists("version"); ok {
+		tmpVersion := tmp.(string)
+		version = &tmpVersion
+	}
+
+	switch operation := strings.ToLower(s.D.Get("operation").(string)); operation {
+	case "delete":
+		request := oci_artifacts.DeleteContainerImageRequest{
+			ImageId: &imageId,
+		}
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		_, err := s.Client.DeleteContainerImage(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		return tfresource.WaitForResourceCondition(s, func() bool {
+			return s.Res.LifecycleState == oci_artifacts.ContainerImageLifecycleStateDeleted
+		}, s.D.Timeout(schema.TimeoutCreate))
+	case "remove_version":
+		if version == nil {
+			return fmt.Errorf("version is required to remove a version of container image %s", imageId)
+		}
+
+		request := oci_artifacts.RemoveContainerVersionRequest{
+			ImageId: &imageId,
+			RemoveContainerVersionDetails: oci_artifacts.RemoveContainerVersionDetails{
+				Version: version,
+			},
+		}
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := s.Client.RemoveContainerVersion(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res = &response.ContainerImage
+		return nil
+	case "restore":
+		request := oci_artifacts.RestoreContainerImageRequest{
+			ImageId: &imageId,
+			RestoreContainerImageDetails: oci_artifacts.RestoreContainerImageDetails{
+				Version: version,
+			},
+		}
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := s.Client.RestoreContainerImage(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res = &response.ContainerImage
+		return nil
+	default:
+		return fmt.Errorf("unknown operation '%v' was specified", operation)
+	}
+}
+
+func (s *ArtifactsContainerImageOperationResourceCrud) Get() error {
+	request := oci_artifacts.GetContainerImageRequest{}
+
+	if imageId, ok := s.D.GetOkExists("image_id"); ok {
+		tmp := imageId.(string)
+		request.ImageId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+
+	response, err := s.Client.GetContainerImage(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ContainerImage
+	return nil
+}
+
+func (s *ArtifactsContainerImageOperationResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	if s.Res.Digest != nil {
+		s.D.Set("digest", *s.Res.Digest)
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	versions := []interface{}{}
+	for _, item := range s.Res.Versions {
+		versions = append(versions, ContainerVersionToMap(item))
+	}
+	s.D.Set("versions", versions)
+
+	return nil
+}
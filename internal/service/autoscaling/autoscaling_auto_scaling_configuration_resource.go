@@ -66,14 +66,12 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 			"policies": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
 						"policy_type": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 							ValidateFunc: validation.StringInSlice([]string{
 								"scheduled",
@@ -86,7 +84,6 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 1,
 							MinItems: 1,
 							Elem: &schema.Resource{
@@ -98,19 +95,16 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 										Type:     schema.TypeInt,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"max": {
 										Type:     schema.TypeInt,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"min": {
 										Type:     schema.TypeInt,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 
 									// Computed
@@ -121,13 +115,11 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 						"execution_schedule": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 1,
 							MinItems: 1,
 							Elem: &schema.Resource{
@@ -136,17 +128,14 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 									"expression": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 									"timezone": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 									"type": {
 										Type:             schema.TypeString,
 										Required:         true,
-										ForceNew:         true,
 										DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 										ValidateFunc: validation.StringInSlice([]string{
 											"cron",
@@ -163,13 +152,11 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 						"resource_action": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 1,
 							MinItems: 1,
 							Elem: &schema.Resource{
@@ -178,14 +165,12 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 									"action": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 
 									// Required
 									"action_type": {
 										Type:             schema.TypeString,
 										Required:         true,
-										ForceNew:         true,
 										DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 										ValidateFunc: validation.StringInSlice([]string{
 											"power",
@@ -200,7 +185,6 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 							Type:     schema.TypeSet,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							Set:      autoScalingConfigurationPolicyRulesHashCodeForSets,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -208,7 +192,6 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 									"display_name": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 
 									// Optional
@@ -216,7 +199,6 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 										Type:     schema.TypeList,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 										MaxItems: 1,
 										MinItems: 1,
 										Elem: &schema.Resource{
@@ -228,13 +210,11 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 													Type:     schema.TypeString,
 													Optional: true,
 													Computed: true,
-													ForceNew: true,
 												},
 												"value": {
 													Type:     schema.TypeInt,
 													Optional: true,
 													Computed: true,
-													ForceNew: true,
 												},
 
 												// Computed
@@ -245,7 +225,6 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 										Type:     schema.TypeList,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 										MaxItems: 1,
 										MinItems: 1,
 										Elem: &schema.Resource{
@@ -254,16 +233,15 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 
 												// Optional
 												"metric_type": {
-													Type:     schema.TypeString,
-													Optional: true,
-													Computed: true,
-													ForceNew: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: validation.StringInSlice(oci_auto_scaling.GetMetricMetricTypeEnumStringValues(), false),
 												},
 												"threshold": {
 													Type:     schema.TypeList,
 													Optional: true,
 													Computed: true,
-													ForceNew: true,
 													MaxItems: 1,
 													MinItems: 1,
 													Elem: &schema.Resource{
@@ -275,13 +253,11 @@ func AutoScalingAutoScalingConfigurationResource() *schema.Resource {
 																Type:     schema.TypeString,
 																Optional: true,
 																Computed: true,
-																ForceNew: true,
 															},
 															"value": {
 																Type:     schema.TypeInt,
 																Optional: true,
 																Computed: true,
-																ForceNew: true,
 															},
 
 															// Computed
@@ -508,6 +484,13 @@ func (s *AutoScalingAutoScalingConfigurationResourceCrud) Update() error {
 			}
 		}
 	}
+	if s.D.HasChange("policies") {
+		err := s.updatePolicies()
+		if err != nil {
+			return err
+		}
+	}
+
 	request := oci_auto_scaling.UpdateAutoScalingConfigurationRequest{}
 
 	updateFlag := false
@@ -1150,6 +1133,131 @@ func autoScalingConfigurationPolicyRulesHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
+
+// updatePolicies reconciles the policies of the configuration in place. Policies are matched by position, a policy that
+// keeps its type is updated, otherwise the new policy is created before the old one is deleted so that the
+// configuration is never left without a policy.
+func (s *AutoScalingAutoScalingConfigurationResourceCrud) updatePolicies() error {
+	oldRaw, newRaw := s.D.GetChange("policies")
+	oldPolicies := oldRaw.([]interface{})
+	newPolicies := newRaw.([]interface{})
+
+	keptPolicyIds := map[string]bool{}
+	policiesToCreate := []int{}
+	for i := range newPolicies {
+		if i >= len(oldPolicies) {
+			policiesToCreate = append(policiesToCreate, i)
+			continue
+		}
+		oldPolicy, _ := oldPolicies[i].(map[string]interface{})
+		newPolicy, _ := newPolicies[i].(map[string]interface{})
+		policyId, _ := oldPolicy["id"].(string)
+		oldPolicyType, _ := oldPolicy["policy_type"].(string)
+		newPolicyType, _ := newPolicy["policy_type"].(string)
+		if policyId == "" || !strings.EqualFold(oldPolicyType, newPolicyType) {
+			policiesToCreate = append(policiesToCreate, i)
+			continue
+		}
+
+		keptPolicyIds[policyId] = true
+		if !s.D.HasChange(fmt.Sprintf("policies.%d", i)) {
+			continue
+		}
+
+		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "policies", i)
+		details, err := s.mapToUpdateAutoScalingPolicyDetails(fieldKeyFormat)
+		if err != nil {
+			return err
+		}
+
+		request := oci_auto_scaling.UpdateAutoScalingPolicyRequest{}
+		configurationId := s.D.Id()
+		request.AutoScalingConfigurationId = &configurationId
+		request.AutoScalingPolicyId = &policyId
+		request.UpdateAutoScalingPolicyDetails = details
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+
+		if _, err := s.Client.UpdateAutoScalingPolicy(context.Background(), request); err != nil {
+			return fmt.Errorf("failed to update auto scaling policy %s: %v", policyId, err)
+		}
+	}
+
+	for _, i := range policiesToCreate {
+		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "policies", i)
+		details, err := s.mapToCreateAutoScalingPolicyDetails(fieldKeyFormat)
+		if err != nil {
+			return err
+		}
+
+		request := oci_auto_scaling.CreateAutoScalingPolicyRequest{}
+		configurationId := s.D.Id()
+		request.AutoScalingConfigurationId = &configurationId
+		request.CreateAutoScalingPolicyDetails = details
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+
+		if _, err := s.Client.CreateAutoScalingPolicy(context.Background(), request); err != nil {
+			return fmt.Errorf("failed to create auto scaling policy at index %d: %v", i, err)
+		}
+	}
+
+	for _, item := range oldPolicies {
+		oldPolicy, _ := item.(map[string]interface{})
+		policyId, _ := oldPolicy["id"].(string)
+		if policyId == "" || keptPolicyIds[policyId] {
+			continue
+		}
+
+		request := oci_auto_scaling.DeleteAutoScalingPolicyRequest{}
+		configurationId := s.D.Id()
+		request.AutoScalingConfigurationId = &configurationId
+		request.AutoScalingPolicyId = &policyId
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "auto_scaling")
+
+		if _, err := s.Client.DeleteAutoScalingPolicy(context.Background(), request); err != nil {
+			return fmt.Errorf("failed to delete auto scaling policy %s: %v", policyId, err)
+		}
+	}
+
+	return nil
+}
+
+// mapToUpdateAutoScalingPolicyDetails builds the update details from the same attributes used on create, the rules of a
+// threshold policy are always sent as a whole since they replace the existing rules
+func (s *AutoScalingAutoScalingConfigurationResourceCrud) mapToUpdateAutoScalingPolicyDetails(fieldKeyFormat string) (oci_auto_scaling.UpdateAutoScalingPolicyDetails, error) {
+	createDetails, err := s.mapToCreateAutoScalingPolicyDetails(fieldKeyFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := createDetails.(type) {
+	case oci_auto_scaling.CreateScheduledPolicyDetails:
+		return oci_auto_scaling.UpdateScheduledPolicyDetails{
+			DisplayName:       v.DisplayName,
+			Capacity:          v.Capacity,
+			IsEnabled:         v.IsEnabled,
+			ExecutionSchedule: v.ExecutionSchedule,
+			ResourceAction:    v.ResourceAction,
+		}, nil
+	case oci_auto_scaling.CreateThresholdPolicyDetails:
+		rules := make([]oci_auto_scaling.UpdateConditionDetails, len(v.Rules))
+		for i, rule := range v.Rules {
+			rules[i] = oci_auto_scaling.UpdateConditionDetails{
+				Action:      rule.Action,
+				Metric:      rule.Metric,
+				DisplayName: rule.DisplayName,
+			}
+		}
+		return oci_auto_scaling.UpdateThresholdPolicyDetails{
+			DisplayName: v.DisplayName,
+			Capacity:    v.Capacity,
+			IsEnabled:   v.IsEnabled,
+			Rules:       rules,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported policy details type %T", createDetails)
+	}
+}
+
 func (s *AutoScalingAutoScalingConfigurationResourceCrud) updateCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_auto_scaling.ChangeAutoScalingConfigurationCompartmentRequest{}
 
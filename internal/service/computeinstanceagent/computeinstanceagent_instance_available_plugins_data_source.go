@@ -5,12 +5,14 @@ package computeinstanceagent
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_computeinstanceagent "github.com/oracle/oci-go-sdk/v65/computeinstanceagent"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
 func ComputeinstanceagentInstanceAvailablePluginsDataSource() *schema.Resource {
@@ -26,13 +28,23 @@ func ComputeinstanceagentInstanceAvailablePluginsDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"image_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"os_name", "os_version"},
+			},
 			"os_name": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"os_version"},
+				ExactlyOneOf: []string{"image_id", "os_name"},
 			},
 			"os_version": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"os_name"},
 			},
 			"available_plugins": {
 				Type:     schema.TypeList,
@@ -71,14 +83,16 @@ func readComputeinstanceagentInstanceAvailablePlugins(d *schema.ResourceData, m
 	sync := &ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).PluginconfigClient()
+	sync.ComputeClient = m.(*client.OracleClients).ComputeClient()
 
 	return tfresource.ReadResource(sync)
 }
 
 type ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud struct {
-	D      *schema.ResourceData
-	Client *oci_computeinstanceagent.PluginconfigClient
-	Res    *oci_computeinstanceagent.ListInstanceagentAvailablePluginsResponse
+	D             *schema.ResourceData
+	Client        *oci_computeinstanceagent.PluginconfigClient
+	ComputeClient *oci_core.ComputeClient
+	Res           *oci_computeinstanceagent.ListInstanceagentAvailablePluginsResponse
 }
 
 func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) VoidState() {
@@ -108,6 +122,15 @@ func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) Get() error
 		request.OsVersion = &tmp
 	}
 
+	if imageId, ok := s.D.GetOkExists("image_id"); ok {
+		image, err := s.getImage(imageId.(string))
+		if err != nil {
+			return err
+		}
+		request.OsName = image.OperatingSystem
+		request.OsVersion = image.OperatingSystemVersion
+	}
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "computeinstanceagent")
 
 	response, err := s.Client.ListInstanceagentAvailablePlugins(context.Background(), request)
@@ -116,9 +139,33 @@ func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) Get() error
 	}
 
 	s.Res = &response
+	if request.OsName != nil {
+		s.D.Set("os_name", *request.OsName)
+	}
+	if request.OsVersion != nil {
+		s.D.Set("os_version", *request.OsVersion)
+	}
 	return nil
 }
 
+// getImage looks up the image so the plugins can be listed for its operating system and version
+func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) getImage(imageId string) (*oci_core.Image, error) {
+	request := oci_core.GetImageRequest{
+		ImageId: &imageId,
+	}
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+
+	response, err := s.ComputeClient.GetImage(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	if response.OperatingSystem == nil || response.OperatingSystemVersion == nil {
+		return nil, fmt.Errorf("image %s does not report an operating system and version", imageId)
+	}
+
+	return &response.Image, nil
+}
+
 func (s *ComputeinstanceagentInstanceAvailablePluginsDataSourceCrud) SetData() error {
 	if s.Res == nil {
 		return nil
@@ -0,0 +1,507 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package containerengine
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+const (
+	clusterAutoscalerAddonName = "ClusterAutoscaler"
+
+	// configuration keys of the cluster autoscaler add-on rendered from the resource arguments
+	clusterAutoscalerNodesKey                    = "nodes"
+	clusterAutoscalerBalanceSimilarNodeGroupsKey = "balanceSimilarNodeGroups"
+)
+
+func ContainerengineClusterAutoscalerResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts:      tfresource.DefaultTimeout,
+		Create:        createContainerengineClusterAutoscaler,
+		Read:          readContainerengineClusterAutoscaler,
+		Update:        updateContainerengineClusterAutoscaler,
+		Delete:        deleteContainerengineClusterAutoscaler,
+		CustomizeDiff: validateClusterAutoscalerNodePoolBounds,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_pools": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"node_pool_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+
+						// Computed
+					},
+				},
+			},
+
+			// Optional
+			"additional_configurations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"balance_similar_node_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"remove_addon_resources_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			// Computed
+			"addon_error": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"configurations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"current_installed_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createContainerengineClusterAutoscaler(d *schema.ResourceData, m interface{}) error {
+	sync := &ContainerengineClusterAutoscalerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readContainerengineClusterAutoscaler(d *schema.ResourceData, m interface{}) error {
+	sync := &ContainerengineClusterAutoscalerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateContainerengineClusterAutoscaler(d *schema.ResourceData, m interface{}) error {
+	sync := &ContainerengineClusterAutoscalerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteContainerengineClusterAutoscaler(d *schema.ResourceData, m interface{}) error {
+	sync := &ContainerengineClusterAutoscalerResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+// validateClusterAutoscalerNodePoolBounds rejects node pools which could never be scaled between their bounds
+func validateClusterAutoscalerNodePoolBounds(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	nodePools, ok := d.Get("node_pools").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for i, nodePool := range nodePools {
+		bounds, ok := nodePool.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if bounds["min_size"].(int) > bounds["max_size"].(int) {
+			return fmt.Errorf("node_pools.%d: min_size %d must not be greater than max_size %d", i, bounds["min_size"].(int), bounds["max_size"].(int))
+		}
+	}
+
+	return nil
+}
+
+type ContainerengineClusterAutoscalerResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_containerengine.ContainerEngineClient
+	Res                    *oci_containerengine.Addon
+	DisableNotFoundRetries bool
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) ID() string {
+	return GetAddonCompositeId(clusterAutoscalerAddonName, s.D.Get("cluster_id").(string))
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_containerengine.AddonLifecycleStateCreating),
+	}
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_containerengine.AddonLifecycleStateActive),
+		string(oci_containerengine.AddonLifecycleStateNeedsAttention),
+	}
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_containerengine.AddonLifecycleStateDeleting),
+	}
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_containerengine.AddonLifecycleStateDeleted),
+	}
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) Create() error {
+	request := oci_containerengine.InstallAddonRequest{}
+
+	addonName := clusterAutoscalerAddonName
+	request.AddonName = &addonName
+
+	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
+		tmp := clusterId.(string)
+		request.ClusterId = &tmp
+	}
+
+	request.Configurations = s.renderConfigurations()
+
+	if version, ok := s.D.GetOkExists("version"); ok {
+		tmp := version.(string)
+		request.Version = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+
+	response, err := s.Client.InstallAddon(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.D.SetId(s.ID())
+	err = addonWaitForWorkRequest(response.OpcWorkRequestId, "addon", s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	if err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) Get() error {
+	request := oci_containerengine.GetAddonRequest{}
+
+	addonName := clusterAutoscalerAddonName
+	request.AddonName = &addonName
+
+	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
+		tmp := clusterId.(string)
+		request.ClusterId = &tmp
+	}
+
+	_, clusterId, err := parseAddonCompositeId(s.D.Id())
+	if err == nil {
+		request.ClusterId = &clusterId
+	} else {
+		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+
+	response, err := s.Client.GetAddon(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Addon
+	return nil
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) Update() error {
+	request := oci_containerengine.UpdateAddonRequest{}
+
+	addonName := clusterAutoscalerAddonName
+	request.AddonName = &addonName
+
+	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
+		tmp := clusterId.(string)
+		request.ClusterId = &tmp
+	}
+
+	request.Configurations = s.renderConfigurations()
+
+	if version, ok := s.D.GetOkExists("version"); ok {
+		tmp := version.(string)
+		request.Version = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+
+	response, err := s.Client.UpdateAddon(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	err = addonWaitForWorkRequest(response.OpcWorkRequestId, "addon", s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
+	if err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) Delete() error {
+	request := oci_containerengine.DisableAddonRequest{}
+
+	addonName := clusterAutoscalerAddonName
+	request.AddonName = &addonName
+
+	if clusterId, ok := s.D.GetOkExists("cluster_id"); ok {
+		tmp := clusterId.(string)
+		request.ClusterId = &tmp
+	}
+
+	if isRemoveExistingAddOn, ok := s.D.GetOkExists("remove_addon_resources_on_delete"); ok {
+		tmp := isRemoveExistingAddOn.(bool)
+		request.IsRemoveExistingAddOn = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "containerengine")
+
+	response, err := s.Client.DisableAddon(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	return addonWaitForWorkRequest(response.OpcWorkRequestId, "addon", s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
+}
+
+func (s *ContainerengineClusterAutoscalerResourceCrud) SetData() error {
+	_, clusterId, err := parseAddonCompositeId(s.D.Id())
+	if err == nil {
+		s.D.Set("cluster_id", clusterId)
+	} else {
+		log.Printf("[WARN] SetData() unable to parse current ID: %s", s.D.Id())
+	}
+
+	if s.Res.AddonError != nil {
+		s.D.Set("addon_error", []interface{}{AddonErrorToMap(s.Res.AddonError)})
+	} else {
+		s.D.Set("addon_error", nil)
+	}
+
+	configurations := []interface{}{}
+	additionalConfigurations := map[string]interface{}{}
+	for _, item := range s.Res.Configurations {
+		configurations = append(configurations, AddonConfigurationToMap(item))
+
+		if item.Key == nil || item.Value == nil {
+			continue
+		}
+
+		switch *item.Key {
+		case clusterAutoscalerNodesKey:
+			nodePools, err := parseClusterAutoscalerNodes(*item.Value)
+			if err != nil {
+				log.Printf("[WARN] SetData() unable to parse the node pools of the cluster autoscaler: %v", err)
+				continue
+			}
+			s.D.Set("node_pools", nodePools)
+		case clusterAutoscalerBalanceSimilarNodeGroupsKey:
+			s.D.Set("balance_similar_node_groups", strings.EqualFold(*item.Value, "true"))
+		default:
+			additionalConfigurations[*item.Key] = *item.Value
+		}
+	}
+	s.D.Set("configurations", configurations)
+	s.D.Set("additional_configurations", additionalConfigurations)
+
+	if s.Res.CurrentInstalledVersion != nil {
+		s.D.Set("current_installed_version", *s.Res.CurrentInstalledVersion)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.Version != nil {
+		s.D.Set("version", *s.Res.Version)
+	}
+
+	return nil
+}
+
+// renderConfigurations renders the add-on configurations, node pools are passed to the autoscaler as
+// comma separated <min>:<max>:<node pool OCID> entries
+func (s *ContainerengineClusterAutoscalerResourceCrud) renderConfigurations() []oci_containerengine.AddonConfiguration {
+	nodes := []string{}
+	if nodePools, ok := s.D.GetOkExists("node_pools"); ok {
+		for i := range nodePools.([]interface{}) {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "node_pools", i)
+			nodes = append(nodes, fmt.Sprintf("%d:%d:%s",
+				s.D.Get(fmt.Sprintf(fieldKeyFormat, "min_size")).(int),
+				s.D.Get(fmt.Sprintf(fieldKeyFormat, "max_size")).(int),
+				s.D.Get(fmt.Sprintf(fieldKeyFormat, "node_pool_id")).(string)))
+		}
+	}
+
+	result := []oci_containerengine.AddonConfiguration{
+		newAddonConfiguration(clusterAutoscalerNodesKey, strings.Join(nodes, ",")),
+		newAddonConfiguration(clusterAutoscalerBalanceSimilarNodeGroupsKey, strconv.FormatBool(s.D.Get("balance_similar_node_groups").(bool))),
+	}
+
+	if additionalConfigurations, ok := s.D.GetOkExists("additional_configurations"); ok {
+		configurations := tfresource.ObjectMapToStringMap(additionalConfigurations.(map[string]interface{}))
+
+		keys := make([]string, 0, len(configurations))
+		for key := range configurations {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			result = append(result, newAddonConfiguration(key, configurations[key]))
+		}
+	}
+
+	return result
+}
+
+func newAddonConfiguration(key string, value string) oci_containerengine.AddonConfiguration {
+	return oci_containerengine.AddonConfiguration{
+		Key:   &key,
+		Value: &value,
+	}
+}
+
+func parseClusterAutoscalerNodes(nodes string) ([]interface{}, error) {
+	result := []interface{}{}
+	for _, node := range strings.Split(nodes, ",") {
+		node = strings.TrimSpace(node)
+		if node == "" {
+			continue
+		}
+
+		parts := strings.SplitN(node, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("illegal node pool entry %s encountered", node)
+		}
+
+		minSize, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("illegal minimum size in node pool entry %s: %v", node, err)
+		}
+
+		maxSize, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("illegal maximum size in node pool entry %s: %v", node, err)
+		}
+
+		result = append(result, map[string]interface{}{
+			"max_size":     maxSize,
+			"min_size":     minSize,
+			"node_pool_id": parts[2],
+		})
+	}
+
+	return result, nil
+}
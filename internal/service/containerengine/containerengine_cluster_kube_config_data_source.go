@@ -5,12 +5,16 @@ package containerengine
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
+	yaml "gopkg.in/yaml.v2"
 
 	"io/ioutil"
 )
@@ -27,6 +31,22 @@ func ContainerengineClusterKubeConfigDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"exec_auth": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"api_key",
+					"instance_obo_user",
+					"instance_principal",
+					"oke_workload_identity",
+					"resource_principal",
+					"security_token",
+				}, false),
+			},
+			"exec_profile": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"expiration": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -36,10 +56,46 @@ func ContainerengineClusterKubeConfigDataSource() *schema.Resource {
 				Optional: true,
 			},
 			// Computed
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"content": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"exec": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"api_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -58,6 +114,25 @@ type ContainerengineClusterKubeConfigDataSourceCrud struct {
 	Res    *[]byte
 }
 
+// kubeconfig holds the parts of a kubeconfig file which are exported as attributes
+type kubeconfig struct {
+	Clusters []struct {
+		Cluster struct {
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			Server                   string `yaml:"server"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Users []struct {
+		User struct {
+			Exec *struct {
+				APIVersion string   `yaml:"apiVersion"`
+				Args       []string `yaml:"args"`
+				Command    string   `yaml:"command"`
+			} `yaml:"exec"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
 func (s *ContainerengineClusterKubeConfigDataSourceCrud) VoidState() {
 	s.D.SetId("")
 }
@@ -100,6 +175,27 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) Get() error {
 		}
 	}
 
+	if s.Res == nil {
+		return nil
+	}
+
+	execArgs := []interface{}{}
+	if auth, ok := s.D.GetOkExists("exec_auth"); ok {
+		execArgs = append(execArgs, "--auth", auth.(string))
+	}
+
+	if profile, ok := s.D.GetOkExists("exec_profile"); ok {
+		execArgs = append(execArgs, "--profile", profile.(string))
+	}
+
+	if len(execArgs) > 0 {
+		content, err := appendKubeconfigExecArgs(*s.Res, execArgs)
+		if err != nil {
+			return err
+		}
+		s.Res = &content
+	}
+
 	return nil
 }
 
@@ -112,5 +208,68 @@ func (s *ContainerengineClusterKubeConfigDataSourceCrud) SetData() error {
 
 	s.D.Set("content", string(*s.Res))
 
+	config := kubeconfig{}
+	if err := yaml.Unmarshal(*s.Res, &config); err != nil {
+		return fmt.Errorf("unable to parse the kubeconfig of the cluster: %v", err)
+	}
+
+	if len(config.Clusters) > 0 {
+		s.D.Set("server", config.Clusters[0].Cluster.Server)
+
+		certificate, err := base64.StdEncoding.DecodeString(config.Clusters[0].Cluster.CertificateAuthorityData)
+		if err != nil {
+			return fmt.Errorf("unable to decode the certificate authority data of the cluster: %v", err)
+		}
+		s.D.Set("cluster_ca_certificate", string(certificate))
+	}
+
+	exec := []interface{}{}
+	if len(config.Users) > 0 && config.Users[0].User.Exec != nil {
+		exec = append(exec, map[string]interface{}{
+			"api_version": config.Users[0].User.Exec.APIVersion,
+			"args":        config.Users[0].User.Exec.Args,
+			"command":     config.Users[0].User.Exec.Command,
+		})
+	}
+	s.D.Set("exec", exec)
+
+	return nil
+}
+
+// appendKubeconfigExecArgs adds the given arguments to the exec plugin of every user of the kubeconfig,
+// the order of all other entries is kept as returned by the service
+func appendKubeconfigExecArgs(content []byte, args []interface{}) ([]byte, error) {
+	config := yaml.MapSlice{}
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse the kubeconfig of the cluster: %v", err)
+	}
+
+	users, _ := getMapSliceValue(config, "users").([]interface{})
+	for _, user := range users {
+		userEntry, _ := user.(yaml.MapSlice)
+		userDetails, _ := getMapSliceValue(userEntry, "user").(yaml.MapSlice)
+		exec, _ := getMapSliceValue(userDetails, "exec").(yaml.MapSlice)
+		if exec == nil {
+			return nil, fmt.Errorf("the kubeconfig of the cluster does not use an exec plugin, set token_version to 2.0.0 to configure exec_auth or exec_profile")
+		}
+
+		for i := range exec {
+			if exec[i].Key == "args" {
+				execArgs, _ := exec[i].Value.([]interface{})
+				exec[i].Value = append(execArgs, args...)
+			}
+		}
+	}
+
+	return yaml.Marshal(config)
+}
+
+func getMapSliceValue(slice yaml.MapSlice, key string) interface{} {
+	for _, item := range slice {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,93 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package containerengine
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func ContainerengineNodePoolNodesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readContainerengineNodePoolNodes,
+		Schema: map[string]*schema.Schema{
+			"filter": tfresource.DataSourceFiltersSchema(),
+			"node_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				// the nodes are reported the same way as by the node pool
+				Elem: ContainerengineNodePoolResource().Schema["nodes"].Elem,
+			},
+		},
+	}
+}
+
+func readContainerengineNodePoolNodes(d *schema.ResourceData, m interface{}) error {
+	sync := &ContainerengineNodePoolNodesDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type ContainerengineNodePoolNodesDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_containerengine.ContainerEngineClient
+	Res    *oci_containerengine.GetNodePoolResponse
+}
+
+func (s *ContainerengineNodePoolNodesDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *ContainerengineNodePoolNodesDataSourceCrud) Get() error {
+	request := oci_containerengine.GetNodePoolRequest{}
+
+	if nodePoolId, ok := s.D.GetOkExists("node_pool_id"); ok {
+		tmp := nodePoolId.(string)
+		request.NodePoolId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+
+	response, err := s.Client.GetNodePool(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	return nil
+}
+
+func (s *ContainerengineNodePoolNodesDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(tfresource.GenerateDataSourceHashID("ContainerengineNodePoolNodesDataSource-", ContainerengineNodePoolNodesDataSource(), s.D))
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Nodes {
+		resources = append(resources, NodeToMap(r))
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = tfresource.ApplyFilters(f.(*schema.Set), resources, ContainerengineNodePoolNodesDataSource().Schema["nodes"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("nodes", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
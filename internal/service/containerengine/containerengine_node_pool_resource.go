@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,6 +28,9 @@ import (
 	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
 )
 
+// nodePoolCyclingCountRegexp matches the number of nodes or the percentage of the node pool that can be cycled at once
+var nodePoolCyclingCountRegexp = regexp.MustCompile(`^([0-9]+|(100|[1-9]?[0-9])%)$`)
+
 func ContainerengineNodePoolResource() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
@@ -40,6 +45,16 @@ func ContainerengineNodePoolResource() *schema.Resource {
 		Read:   readContainerengineNodePool,
 		Update: updateContainerengineNodePool,
 		Delete: deleteContainerengineNodePool,
+		// switching between flannel and VCN-native pod networking replaces the node pool through the ForceNew cni_type,
+		// the pod placement settings of VCN-native networking would be silently dropped for flannel
+		CustomizeDiff: customdiff.All(
+			tfresource.ConflictsWhen(tfresource.AttributeIn("node_config_details.0.node_pool_pod_network_option_details.0.cni_type", "FLANNEL_OVERLAY"), "cni_type is FLANNEL_OVERLAY",
+				"node_config_details.0.node_pool_pod_network_option_details.0.max_pods_per_node",
+				"node_config_details.0.node_pool_pod_network_option_details.0.pod_nsg_ids",
+				"node_config_details.0.node_pool_pod_network_option_details.0.pod_subnet_ids"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("node_config_details.0.node_pool_pod_network_option_details.0.cni_type", "OCI_VCN_IP_NATIVE"), "cni_type is OCI_VCN_IP_NATIVE",
+				"node_config_details.0.node_pool_pod_network_option_details.0.pod_subnet_ids"),
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"cluster_id": {
@@ -349,14 +364,16 @@ func ContainerengineNodePoolResource() *schema.Resource {
 							Computed: true,
 						},
 						"maximum_surge": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(nodePoolCyclingCountRegexp, "must be a number of nodes or a percentage from 0% to 100%"),
 						},
 						"maximum_unavailable": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringMatch(nodePoolCyclingCountRegexp, "must be a number of nodes or a percentage from 0% to 100%"),
 						},
 
 						// Computed
@@ -514,6 +531,10 @@ func ContainerengineNodePoolResource() *schema.Resource {
 										Type:     schema.TypeString,
 										Computed: true,
 									},
+									"opc_request_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 									"status": {
 										Type:     schema.TypeString,
 										Computed: true,
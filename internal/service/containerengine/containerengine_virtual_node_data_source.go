@@ -0,0 +1,195 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package containerengine
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func ContainerengineVirtualNodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readSingularContainerengineVirtualNode,
+		Schema: map[string]*schema.Schema{
+			"virtual_node_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"virtual_node_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// Computed
+			"availability_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"defined_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fault_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"kubernetes_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"nsg_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"private_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"system_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"virtual_node_error": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readSingularContainerengineVirtualNode(d *schema.ResourceData, m interface{}) error {
+	sync := &ContainerengineVirtualNodeDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type ContainerengineVirtualNodeDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_containerengine.ContainerEngineClient
+	Res    *oci_containerengine.GetVirtualNodeResponse
+}
+
+func (s *ContainerengineVirtualNodeDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *ContainerengineVirtualNodeDataSourceCrud) Get() error {
+	request := oci_containerengine.GetVirtualNodeRequest{}
+
+	if virtualNodeId, ok := s.D.GetOkExists("virtual_node_id"); ok {
+		tmp := virtualNodeId.(string)
+		request.VirtualNodeId = &tmp
+	}
+
+	if virtualNodePoolId, ok := s.D.GetOkExists("virtual_node_pool_id"); ok {
+		tmp := virtualNodePoolId.(string)
+		request.VirtualNodePoolId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+
+	response, err := s.Client.GetVirtualNode(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	return nil
+}
+
+func (s *ContainerengineVirtualNodeDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(*s.Res.Id)
+
+	if s.Res.AvailabilityDomain != nil {
+		s.D.Set("availability_domain", *s.Res.AvailabilityDomain)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", tfresource.DefinedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	if s.Res.FaultDomain != nil {
+		s.D.Set("fault_domain", *s.Res.FaultDomain)
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.KubernetesVersion != nil {
+		s.D.Set("kubernetes_version", *s.Res.KubernetesVersion)
+	}
+
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	s.D.Set("nsg_ids", s.Res.NsgIds)
+
+	if s.Res.PrivateIp != nil {
+		s.D.Set("private_ip", *s.Res.PrivateIp)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.SubnetId != nil {
+		s.D.Set("subnet_id", *s.Res.SubnetId)
+	}
+
+	if s.Res.SystemTags != nil {
+		s.D.Set("system_tags", tfresource.SystemTagsToMap(s.Res.SystemTags))
+	}
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.VirtualNodeError != nil {
+		s.D.Set("virtual_node_error", *s.Res.VirtualNodeError)
+	}
+
+	return nil
+}
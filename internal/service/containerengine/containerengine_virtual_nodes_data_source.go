@@ -0,0 +1,255 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package containerengine
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_containerengine "github.com/oracle/oci-go-sdk/v65/containerengine"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func ContainerengineVirtualNodesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readContainerengineVirtualNodes,
+		Schema: map[string]*schema.Schema{
+			"filter": tfresource.DataSourceFiltersSchema(),
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"virtual_node_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"virtual_nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"availability_domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"defined_tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fault_domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"freeform_tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nsg_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"system_tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"time_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_node_error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"virtual_node_pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readContainerengineVirtualNodes(d *schema.ResourceData, m interface{}) error {
+	sync := &ContainerengineVirtualNodesDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ContainerEngineClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type ContainerengineVirtualNodesDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_containerengine.ContainerEngineClient
+	Res    *oci_containerengine.ListVirtualNodesResponse
+}
+
+func (s *ContainerengineVirtualNodesDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *ContainerengineVirtualNodesDataSourceCrud) Get() error {
+	request := oci_containerengine.ListVirtualNodesRequest{}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.Name = &tmp
+	}
+
+	if virtualNodePoolId, ok := s.D.GetOkExists("virtual_node_pool_id"); ok {
+		tmp := virtualNodePoolId.(string)
+		request.VirtualNodePoolId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "containerengine")
+
+	response, err := s.Client.ListVirtualNodes(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListVirtualNodes(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *ContainerengineVirtualNodesDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(tfresource.GenerateDataSourceHashID("ContainerengineVirtualNodesDataSource-", ContainerengineVirtualNodesDataSource(), s.D))
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		resources = append(resources, VirtualNodeSummaryToMap(r))
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = tfresource.ApplyFilters(f.(*schema.Set), resources, ContainerengineVirtualNodesDataSource().Schema["virtual_nodes"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("virtual_nodes", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func VirtualNodeSummaryToMap(obj oci_containerengine.VirtualNodeSummary) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.AvailabilityDomain != nil {
+		result["availability_domain"] = string(*obj.AvailabilityDomain)
+	}
+
+	if obj.DefinedTags != nil {
+		result["defined_tags"] = tfresource.DefinedTagsToMap(obj.DefinedTags)
+	}
+
+	if obj.DisplayName != nil {
+		result["display_name"] = string(*obj.DisplayName)
+	}
+
+	if obj.FaultDomain != nil {
+		result["fault_domain"] = string(*obj.FaultDomain)
+	}
+
+	result["freeform_tags"] = obj.FreeformTags
+
+	if obj.Id != nil {
+		result["id"] = string(*obj.Id)
+	}
+
+	if obj.KubernetesVersion != nil {
+		result["kubernetes_version"] = string(*obj.KubernetesVersion)
+	}
+
+	if obj.LifecycleDetails != nil {
+		result["lifecycle_details"] = string(*obj.LifecycleDetails)
+	}
+
+	result["nsg_ids"] = obj.NsgIds
+
+	if obj.PrivateIp != nil {
+		result["private_ip"] = string(*obj.PrivateIp)
+	}
+
+	result["state"] = string(obj.LifecycleState)
+
+	if obj.SubnetId != nil {
+		result["subnet_id"] = string(*obj.SubnetId)
+	}
+
+	if obj.SystemTags != nil {
+		result["system_tags"] = tfresource.SystemTagsToMap(obj.SystemTags)
+	}
+
+	if obj.TimeCreated != nil {
+		result["time_created"] = obj.TimeCreated.String()
+	}
+
+	if obj.VirtualNodeError != nil {
+		result["virtual_node_error"] = string(*obj.VirtualNodeError)
+	}
+
+	if obj.VirtualNodePoolId != nil {
+		result["virtual_node_pool_id"] = string(*obj.VirtualNodePoolId)
+	}
+
+	return result
+}
@@ -18,11 +18,14 @@ func RegisterDatasource() {
 	tfresource.RegisterDatasource("oci_containerengine_clusters", ContainerengineClustersDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_migrate_to_native_vcn_status", ContainerengineMigrateToNativeVcnStatusDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_node_pool", ContainerengineNodePoolDataSource())
+	tfresource.RegisterDatasource("oci_containerengine_node_pool_nodes", ContainerengineNodePoolNodesDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_node_pool_option", ContainerengineNodePoolOptionDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_node_pools", ContainerengineNodePoolsDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_pod_shapes", ContainerenginePodShapesDataSource())
+	tfresource.RegisterDatasource("oci_containerengine_virtual_node", ContainerengineVirtualNodeDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_virtual_node_pool", ContainerengineVirtualNodePoolDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_virtual_node_pools", ContainerengineVirtualNodePoolsDataSource())
+	tfresource.RegisterDatasource("oci_containerengine_virtual_nodes", ContainerengineVirtualNodesDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_work_request_errors", ContainerengineWorkRequestErrorsDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_work_request_log_entries", ContainerengineWorkRequestLogEntriesDataSource())
 	tfresource.RegisterDatasource("oci_containerengine_work_requests", ContainerengineWorkRequestsDataSource())
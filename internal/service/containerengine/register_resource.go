@@ -8,6 +8,7 @@ import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 func RegisterResource() {
 	tfresource.RegisterResource("oci_containerengine_addon", ContainerengineAddonResource())
 	tfresource.RegisterResource("oci_containerengine_cluster", ContainerengineClusterResource())
+	tfresource.RegisterResource("oci_containerengine_cluster_autoscaler", ContainerengineClusterAutoscalerResource())
 	tfresource.RegisterResource("oci_containerengine_cluster_workload_mapping", ContainerengineClusterWorkloadMappingResource())
 	tfresource.RegisterResource("oci_containerengine_cluster_complete_credential_rotation_management", ContainerengineClusterCompleteCredentialRotationManagementResource())
 	tfresource.RegisterResource("oci_containerengine_cluster_start_credential_rotation_management", ContainerengineClusterStartCredentialRotationManagementResource())
@@ -33,9 +33,10 @@ func CoreAppCatalogSubscriptionResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"listing_id": {
 				Type:     schema.TypeString,
@@ -70,9 +70,10 @@ func CoreBootVolumeBackupResource() *schema.Resource {
 
 			// Optional
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"defined_tags": {
 				Type:             schema.TypeMap,
@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -29,6 +30,18 @@ func CoreBootVolumeResource() *schema.Resource {
 		Read:     readCoreBootVolume,
 		Update:   updateCoreBootVolume,
 		Delete:   deleteCoreBootVolume,
+		CustomizeDiff: customdiff.All(
+			tfresource.RequiredWhen(
+				tfresource.AttributeIn("source_details.0.type", "bootVolume", "bootVolumeBackup", "bootVolumeReplica"),
+				"source_details.0.type is bootVolume, bootVolumeBackup or bootVolumeReplica", "source_details.0.id"),
+			tfresource.RequiredWhen(
+				tfresource.AttributeIn("source_details.0.type", "bootVolumeBackupDelta"),
+				"source_details.0.type is bootVolumeBackupDelta", "source_details.0.first_backup_id"),
+			tfresource.RequiredWhen(
+				tfresource.AttributeIn("source_details.0.type", "bootVolumeBackupDelta"),
+				"source_details.0.type is bootVolumeBackupDelta", "source_details.0.second_backup_id"),
+			validateAutotunePolicies,
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -38,8 +51,9 @@ func CoreBootVolumeResource() *schema.Resource {
 				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"source_details": {
 				Type:     schema.TypeList,
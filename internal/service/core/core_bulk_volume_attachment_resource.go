@@ -0,0 +1,665 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+func CoreBulkVolumeAttachmentResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createCoreBulkVolumeAttachment,
+		Read:     readCoreBulkVolumeAttachment,
+		Update:   updateCoreBulkVolumeAttachment,
+		Delete:   deleteCoreBulkVolumeAttachment,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"attachment_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"emulated",
+					"iscsi",
+					"paravirtualized",
+				}, true),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
+			},
+			"volumes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"volume_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+						"device": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"is_read_only": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"is_shareable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
+						// Computed
+						"ipv4": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iqn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"volume_attachment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// Optional
+			"is_pv_encryption_in_transit_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func createCoreBulkVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreBulkVolumeAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ComputeClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readCoreBulkVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreBulkVolumeAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ComputeClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateCoreBulkVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreBulkVolumeAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ComputeClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteCoreBulkVolumeAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreBulkVolumeAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ComputeClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+// CoreBulkVolumeAttachmentResourceCrud attaches a list of volumes to an instance as a single unit. Devices that are not
+// configured are assigned from the available devices of the instance in the order of the list before any attachment
+// is requested, so the device of a volume does not depend on the order in which the parallel attachments complete.
+type CoreBulkVolumeAttachmentResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_core.ComputeClient
+	Res                    []oci_core.VolumeAttachment
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) ID() string {
+	return tfresource.GenerateDataSourceHashID("CoreBulkVolumeAttachmentResource-", CoreBulkVolumeAttachmentResource(), s.D)
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) Create() error {
+	volumes := s.D.Get("volumes").([]interface{})
+	indexes := make([]int, len(volumes))
+	for i := range volumes {
+		indexes[i] = i
+	}
+
+	s.Res = make([]oci_core.VolumeAttachment, len(volumes))
+	err := s.attachVolumes("volumes", indexes, s.Res, s.D.Timeout(schema.TimeoutCreate))
+	if err != nil && s.hasAttachments() {
+		// Keep the attachments that succeeded in the state so that they are detached when the resource is replaced
+		s.D.SetId(s.ID())
+		if setDataErr := s.SetData(); setDataErr != nil {
+			log.Printf("[ERROR] error setting data after bulk volume attachment error: %v", setDataErr)
+		}
+	}
+	return err
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) Get() error {
+	volumes := s.D.Get("volumes").([]interface{})
+	res := []oci_core.VolumeAttachment{}
+	for i := range volumes {
+		volumeAttachmentId, ok := s.D.GetOk(fmt.Sprintf("volumes.%d.volume_attachment_id", i))
+		if !ok {
+			continue
+		}
+
+		request := oci_core.GetVolumeAttachmentRequest{}
+		tmp := volumeAttachmentId.(string)
+		request.VolumeAttachmentId = &tmp
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		response, err := s.Client.GetVolumeAttachment(context.Background(), request)
+		if err != nil {
+			if failure, ok := oci_common.IsServiceError(err); ok && failure.GetHTTPStatusCode() == 404 {
+				continue
+			}
+			return err
+		}
+
+		// Volumes detached outside of Terraform are dropped so that they are attached again on the next apply
+		if response.VolumeAttachment.GetLifecycleState() == oci_core.VolumeAttachmentLifecycleStateDetached {
+			continue
+		}
+		res = append(res, response.VolumeAttachment)
+	}
+
+	s.Res = res
+	return nil
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) Update() error {
+	oldRaw, newRaw := s.D.GetChange("volumes")
+	oldVolumes := oldRaw.([]interface{})
+	newVolumes := newRaw.([]interface{})
+
+	// Attachments of volumes whose attachment details did not change are kept, everything else is detached first
+	// so that devices released by removed volumes can be assigned to added ones
+	kept := map[int]bool{}
+	result := make([]oci_core.VolumeAttachment, len(newVolumes))
+	toAttach := []int{}
+	for i := range newVolumes {
+		newVolume := s.configuredVolume("volumes", i)
+		matched := false
+		for j, oldVolume := range oldVolumes {
+			if kept[j] || !isSameBulkVolumeAttachment(oldVolume.(map[string]interface{}), newVolume) {
+				continue
+			}
+			attachmentId, _ := oldVolume.(map[string]interface{})["volume_attachment_id"].(string)
+			if attachmentId == "" {
+				continue
+			}
+			request := oci_core.GetVolumeAttachmentRequest{}
+			request.VolumeAttachmentId = &attachmentId
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+			response, err := s.Client.GetVolumeAttachment(context.Background(), request)
+			if err != nil {
+				return err
+			}
+			kept[j] = true
+			result[i] = response.VolumeAttachment
+			matched = true
+			break
+		}
+		if !matched {
+			toAttach = append(toAttach, i)
+		}
+	}
+
+	toDetach := []string{}
+	for j, oldVolume := range oldVolumes {
+		if kept[j] {
+			continue
+		}
+		if attachmentId, _ := oldVolume.(map[string]interface{})["volume_attachment_id"].(string); attachmentId != "" {
+			toDetach = append(toDetach, attachmentId)
+		}
+	}
+
+	if err := s.detachVolumes(toDetach, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	err := s.attachVolumes("volumes", toAttach, result, s.D.Timeout(schema.TimeoutUpdate))
+	s.Res = result
+	return err
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) Delete() error {
+	volumes := s.D.Get("volumes").([]interface{})
+	attachmentIds := []string{}
+	for _, volume := range volumes {
+		if attachmentId, _ := volume.(map[string]interface{})["volume_attachment_id"].(string); attachmentId != "" {
+			attachmentIds = append(attachmentIds, attachmentId)
+		}
+	}
+
+	return s.detachVolumes(attachmentIds, s.D.Timeout(schema.TimeoutDelete))
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) SetData() error {
+	volumes := []interface{}{}
+	for _, attachment := range s.Res {
+		if attachment == nil {
+			continue
+		}
+		volumes = append(volumes, BulkVolumeAttachmentToMap(attachment))
+
+		if attachment.GetInstanceId() != nil {
+			s.D.Set("instance_id", *attachment.GetInstanceId())
+		}
+
+		if attachment.GetIsPvEncryptionInTransitEnabled() != nil {
+			s.D.Set("is_pv_encryption_in_transit_enabled", *attachment.GetIsPvEncryptionInTransitEnabled())
+		}
+	}
+
+	if err := s.D.Set("volumes", volumes); err != nil {
+		log.Printf("[WARN] volumes could not be set: %q", err)
+	}
+
+	return nil
+}
+
+func BulkVolumeAttachmentToMap(obj oci_core.VolumeAttachment) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.GetDevice() != nil {
+		result["device"] = *obj.GetDevice()
+	}
+
+	if obj.GetDisplayName() != nil {
+		result["display_name"] = *obj.GetDisplayName()
+	}
+
+	if obj.GetIsReadOnly() != nil {
+		result["is_read_only"] = *obj.GetIsReadOnly()
+	}
+
+	if obj.GetIsShareable() != nil {
+		result["is_shareable"] = *obj.GetIsShareable()
+	}
+
+	result["state"] = string(obj.GetLifecycleState())
+
+	if obj.GetId() != nil {
+		result["volume_attachment_id"] = *obj.GetId()
+	}
+
+	if obj.GetVolumeId() != nil {
+		result["volume_id"] = *obj.GetVolumeId()
+	}
+
+	if v, ok := obj.(oci_core.IScsiVolumeAttachment); ok {
+		if v.Ipv4 != nil {
+			result["ipv4"] = *v.Ipv4
+		}
+
+		if v.Iqn != nil {
+			result["iqn"] = *v.Iqn
+		}
+
+		if v.Port != nil {
+			result["port"] = *v.Port
+		}
+	}
+
+	return result
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) hasAttachments() bool {
+	for _, attachment := range s.Res {
+		if attachment != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// attachVolumes attaches the volumes at the given indexes of the list in parallel and stores each attachment at the
+// same index of the result
+func (s *CoreBulkVolumeAttachmentResourceCrud) attachVolumes(listKey string, indexes []int, result []oci_core.VolumeAttachment, timeout time.Duration) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	devices, err := s.assignDevices(listKey, indexes)
+	if err != nil {
+		return err
+	}
+
+	requests := make([]oci_core.AttachVolumeRequest, len(indexes))
+	for i, index := range indexes {
+		details, err := s.mapToAttachVolumeDetails(listKey, index, devices[index])
+		if err != nil {
+			return err
+		}
+		requests[i].AttachVolumeDetails = details
+		requests[i].RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+	}
+
+	errs := make([]error, len(indexes))
+	wg := &sync.WaitGroup{}
+	for i := range indexes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			response, err := s.Client.AttachVolume(context.Background(), requests[i])
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			attachment := response.VolumeAttachment
+			result[indexes[i]] = attachment
+			attachment, err = s.waitForVolumeAttachment(*attachment.GetId(),
+				[]string{string(oci_core.VolumeAttachmentLifecycleStateAttaching)},
+				[]string{string(oci_core.VolumeAttachmentLifecycleStateAttached)}, timeout)
+			if attachment != nil {
+				result[indexes[i]] = attachment
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	return bulkVolumeAttachmentErrors("attach", indexes, errs)
+}
+
+// detachVolumes detaches the volume attachments in parallel and waits for all of them to be detached
+func (s *CoreBulkVolumeAttachmentResourceCrud) detachVolumes(attachmentIds []string, timeout time.Duration) error {
+	errs := make([]error, len(attachmentIds))
+	wg := &sync.WaitGroup{}
+	for i := range attachmentIds {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			request := oci_core.DetachVolumeRequest{}
+			request.VolumeAttachmentId = &attachmentIds[i]
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+			if _, err := s.Client.DetachVolume(context.Background(), request); err != nil {
+				if failure, ok := oci_common.IsServiceError(err); !ok || failure.GetHTTPStatusCode() != 404 {
+					errs[i] = err
+				}
+				return
+			}
+			_, errs[i] = s.waitForVolumeAttachment(attachmentIds[i],
+				[]string{string(oci_core.VolumeAttachmentLifecycleStateDetaching), string(oci_core.VolumeAttachmentLifecycleStateAttached)},
+				[]string{string(oci_core.VolumeAttachmentLifecycleStateDetached)}, timeout)
+		}(i)
+	}
+	wg.Wait()
+
+	indexes := make([]int, len(attachmentIds))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	return bulkVolumeAttachmentErrors("detach", indexes, errs)
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) waitForVolumeAttachment(attachmentId string, pending []string, target []string, timeout time.Duration) (oci_core.VolumeAttachment, error) {
+	var attachment oci_core.VolumeAttachment
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			request := oci_core.GetVolumeAttachmentRequest{}
+			request.VolumeAttachmentId = &attachmentId
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+			response, err := s.Client.GetVolumeAttachment(context.Background(), request)
+			if err != nil {
+				// Detached attachments are eventually purged
+				if failure, ok := oci_common.IsServiceError(err); ok && failure.GetHTTPStatusCode() == 404 {
+					return attachmentId, string(oci_core.VolumeAttachmentLifecycleStateDetached), nil
+				}
+				return nil, "", err
+			}
+			attachment = response.VolumeAttachment
+			return attachment, string(attachment.GetLifecycleState()), nil
+		},
+		Timeout: timeout,
+	}
+	_, err := stateConf.WaitForState()
+	return attachment, err
+}
+
+// assignDevices returns the device of each volume at the given indexes. Volumes without a configured device get the
+// next free device of the instance, devices are handed out in list order so that the assignment is deterministic.
+// No device is assigned if the instance does not support consistent device paths.
+func (s *CoreBulkVolumeAttachmentResourceCrud) assignDevices(listKey string, indexes []int) (map[int]string, error) {
+	devices := map[int]string{}
+	unassigned := []int{}
+	used := map[string]bool{}
+	for _, index := range indexes {
+		if device, ok := s.configuredVolume(listKey, index)["device"].(string); ok && device != "" {
+			devices[index] = device
+			used[device] = true
+			continue
+		}
+		unassigned = append(unassigned, index)
+	}
+	if len(unassigned) == 0 {
+		return devices, nil
+	}
+
+	available, err := s.listAvailableDevices()
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range unassigned {
+		for len(available) > 0 && used[available[0]] {
+			available = available[1:]
+		}
+		if len(available) == 0 {
+			break
+		}
+		devices[index] = available[0]
+		available = available[1:]
+	}
+
+	return devices, nil
+}
+
+// configuredVolume returns the attributes of the volume at the index of the list that are set in the config. The planned
+// values of attributes that are not configured are inherited from the volume at the same position in the state, which
+// is not necessarily the same volume once volumes are added or removed.
+func (s *CoreBulkVolumeAttachmentResourceCrud) configuredVolume(listKey string, index int) map[string]interface{} {
+	result := map[string]interface{}{}
+	rawConfig := s.D.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return result
+	}
+	volumes := rawConfig.GetAttr(listKey)
+	if volumes.IsNull() || !volumes.IsKnown() || index >= volumes.LengthInt() {
+		return result
+	}
+	volume := volumes.AsValueSlice()[index]
+	for _, key := range []string{"volume_id", "device", "display_name"} {
+		if value := volume.GetAttr(key); value.IsKnown() && !value.IsNull() {
+			result[key] = value.AsString()
+		}
+	}
+	for _, key := range []string{"is_read_only", "is_shareable"} {
+		if value := volume.GetAttr(key); value.IsKnown() && !value.IsNull() {
+			result[key] = value.True()
+		}
+	}
+	return result
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) listAvailableDevices() ([]string, error) {
+	request := oci_core.ListInstanceDevicesRequest{}
+	instanceId := s.D.Get("instance_id").(string)
+	request.InstanceId = &instanceId
+	isAvailable := true
+	request.IsAvailable = &isAvailable
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	devices := []string{}
+	for {
+		response, err := s.Client.ListInstanceDevices(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		for _, device := range response.Items {
+			if device.Name != nil {
+				devices = append(devices, *device.Name)
+			}
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	// Order devices the way they are enumerated on the instance, /dev/oracleoci/oraclevdz comes before /dev/oracleoci/oraclevdaa
+	sort.Slice(devices, func(i, j int) bool {
+		if len(devices[i]) != len(devices[j]) {
+			return len(devices[i]) < len(devices[j])
+		}
+		return devices[i] < devices[j]
+	})
+	return devices, nil
+}
+
+func (s *CoreBulkVolumeAttachmentResourceCrud) mapToAttachVolumeDetails(listKey string, index int, device string) (oci_core.AttachVolumeDetails, error) {
+	instanceId := s.D.Get("instance_id").(string)
+	volumeId := s.D.Get(fmt.Sprintf("%s.%d.volume_id", listKey, index)).(string)
+	volume := s.configuredVolume(listKey, index)
+
+	var devicePtr *string
+	if device != "" {
+		devicePtr = &device
+	}
+
+	var displayName *string
+	if value, ok := volume["display_name"].(string); ok {
+		displayName = &value
+	}
+
+	var isReadOnly *bool
+	if value, ok := volume["is_read_only"].(bool); ok {
+		isReadOnly = &value
+	}
+
+	var isShareable *bool
+	if value, ok := volume["is_shareable"].(bool); ok {
+		isShareable = &value
+	}
+
+	attachmentType := s.D.Get("attachment_type").(string)
+	switch strings.ToLower(attachmentType) {
+	case strings.ToLower("emulated"):
+		return oci_core.AttachEmulatedVolumeDetails{
+			Device:      devicePtr,
+			DisplayName: displayName,
+			InstanceId:  &instanceId,
+			IsReadOnly:  isReadOnly,
+			IsShareable: isShareable,
+			VolumeId:    &volumeId,
+		}, nil
+	case strings.ToLower("iscsi"):
+		return oci_core.AttachIScsiVolumeDetails{
+			Device:      devicePtr,
+			DisplayName: displayName,
+			InstanceId:  &instanceId,
+			IsReadOnly:  isReadOnly,
+			IsShareable: isShareable,
+			VolumeId:    &volumeId,
+		}, nil
+	case strings.ToLower("paravirtualized"):
+		details := oci_core.AttachParavirtualizedVolumeDetails{
+			Device:      devicePtr,
+			DisplayName: displayName,
+			InstanceId:  &instanceId,
+			IsReadOnly:  isReadOnly,
+			IsShareable: isShareable,
+			VolumeId:    &volumeId,
+		}
+		if isPvEncryptionInTransitEnabled, ok := s.D.GetOkExists("is_pv_encryption_in_transit_enabled"); ok {
+			tmp := isPvEncryptionInTransitEnabled.(bool)
+			details.IsPvEncryptionInTransitEnabled = &tmp
+		}
+		return details, nil
+	default:
+		return nil, fmt.Errorf("unknown attachment_type '%v' was specified", attachmentType)
+	}
+}
+
+// isSameBulkVolumeAttachment returns true if the existing attachment satisfies every attribute configured for the volume
+func isSameBulkVolumeAttachment(oldVolume map[string]interface{}, configuredVolume map[string]interface{}) bool {
+	if _, ok := configuredVolume["volume_id"]; !ok {
+		return false
+	}
+	for key, value := range configuredVolume {
+		if oldVolume[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func bulkVolumeAttachmentErrors(operation string, indexes []int, errs []error) error {
+	messages := []string{}
+	for i, err := range errs {
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("volume %d: %s", indexes[i], err.Error()))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("failed to %s %d of %d volumes:\n%s", operation, len(messages), len(errs), strings.Join(messages, "\n"))
+}
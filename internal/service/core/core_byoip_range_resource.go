@@ -0,0 +1,537 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+func CoreByoipRangeResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		CustomizeDiff: customdiff.All(
+			// Validation checks the validation token, which is only known once the range is created and has to be
+			// published in the RIR entry of the range first
+			tfresource.ConflictsWhen(tfresource.IsCreate(), "the byoip range is created, publish its validation_token first", "validate_trigger"),
+			tfresource.ErrorWhen(tfresource.AllOf(tfresource.IsCreate(), tfresource.AttributeIsTrue("is_advertised")), "is_advertised can only be set to true once the byoip range is validated"),
+			tfresource.ErrorWhen(tfresource.AllOf(tfresource.AttributeChanged("validate_trigger"), tfresource.AttributeChanged("is_advertised")), "is_advertised cannot be changed together with validate_trigger, advertise the byoip range once its lifecycle_details are PROVISIONED"),
+		),
+		Create: createCoreByoipRange,
+		Read:   readCoreByoipRange,
+		Update: updateCoreByoipRange,
+		Delete: deleteCoreByoipRange,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"compartment_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
+			},
+
+			// Optional
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"cidr_block", "ipv6cidr_block"},
+			},
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: tfresource.DefinedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"ipv6cidr_block": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"is_advertised": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"validate_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			// Computed
+			"byoip_range_vcn_ipv6allocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"byoip_range_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compartment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vcn_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_advertised": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_validated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_withdrawn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"validation_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createCoreByoipRange(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreByoipRangeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readCoreByoipRange(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreByoipRangeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateCoreByoipRange(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreByoipRangeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	// SetData overwrites is_advertised with the current state of the range, read the configured actions first
+	validate := sync.D.HasChange("validate_trigger")
+	oldValidateTrigger, newValidateTrigger := sync.D.GetChange("validate_trigger")
+	advertise := sync.D.HasChange("is_advertised")
+	isAdvertised := sync.D.Get("is_advertised").(bool)
+
+	if validate && oldValidateTrigger.(int) >= newValidateTrigger.(int) {
+		return fmt.Errorf("new value of trigger should be greater than the old value")
+	}
+
+	if err := tfresource.UpdateResource(d, sync); err != nil {
+		return err
+	}
+
+	if validate {
+		if err := sync.ValidateByoipRange(); err != nil {
+			return err
+		}
+	}
+
+	if advertise {
+		var err error
+		if isAdvertised {
+			err = sync.AdvertiseByoipRange(schema.TimeoutUpdate)
+		} else {
+			err = sync.WithdrawByoipRange(schema.TimeoutUpdate)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return tfresource.ReadResource(sync)
+}
+
+func deleteCoreByoipRange(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreByoipRangeResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type CoreByoipRangeResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    *oci_core.ByoipRange
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreByoipRangeResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *CoreByoipRangeResourceCrud) CreatedPending() []string {
+	return []string{}
+}
+
+// A new range stays INACTIVE until it has been validated and advertised
+func (s *CoreByoipRangeResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_core.ByoipRangeLifecycleStateInactive),
+		string(oci_core.ByoipRangeLifecycleStateActive),
+	}
+}
+
+func (s *CoreByoipRangeResourceCrud) UpdatedPending() []string {
+	return []string{
+		string(oci_core.ByoipRangeLifecycleStateUpdating),
+	}
+}
+
+func (s *CoreByoipRangeResourceCrud) UpdatedTarget() []string {
+	return []string{
+		string(oci_core.ByoipRangeLifecycleStateInactive),
+		string(oci_core.ByoipRangeLifecycleStateActive),
+	}
+}
+
+func (s *CoreByoipRangeResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_core.ByoipRangeLifecycleStateDeleting),
+	}
+}
+
+func (s *CoreByoipRangeResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_core.ByoipRangeLifecycleStateDeleted),
+	}
+}
+
+func (s *CoreByoipRangeResourceCrud) Create() error {
+	request := oci_core.CreateByoipRangeRequest{}
+
+	if cidrBlock, ok := s.D.GetOkExists("cidr_block"); ok {
+		tmp := cidrBlock.(string)
+		request.CidrBlock = &tmp
+	}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	if ipv6CidrBlock, ok := s.D.GetOkExists("ipv6cidr_block"); ok {
+		tmp := ipv6CidrBlock.(string)
+		request.Ipv6CidrBlock = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.CreateByoipRange(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ByoipRange
+	return nil
+}
+
+func (s *CoreByoipRangeResourceCrud) Get() error {
+	request := oci_core.GetByoipRangeRequest{}
+
+	tmp := s.D.Id()
+	request.ByoipRangeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetByoipRange(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ByoipRange
+	return nil
+}
+
+func (s *CoreByoipRangeResourceCrud) Update() error {
+	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
+		oldRaw, newRaw := s.D.GetChange("compartment_id")
+		if newRaw != "" && oldRaw != "" {
+			err := s.updateCompartment(compartment)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	request := oci_core.UpdateByoipRangeRequest{}
+
+	tmp := s.D.Id()
+	request.ByoipRangeId = &tmp
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.UpdateByoipRange(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ByoipRange
+	return nil
+}
+
+func (s *CoreByoipRangeResourceCrud) Delete() error {
+	request := oci_core.DeleteByoipRangeRequest{}
+
+	tmp := s.D.Id()
+	request.ByoipRangeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.DeleteByoipRange(context.Background(), request)
+	return err
+}
+
+func (s *CoreByoipRangeResourceCrud) SetData() error {
+	byoipRangeVcnIpv6Allocations := []interface{}{}
+	for _, item := range s.Res.ByoipRangeVcnIpv6Allocations {
+		byoipRangeVcnIpv6Allocations = append(byoipRangeVcnIpv6Allocations, ByoipRangeVcnIpv6AllocationSummaryToMap(item))
+	}
+	s.D.Set("byoip_range_vcn_ipv6allocations", byoipRangeVcnIpv6Allocations)
+
+	if s.Res.CidrBlock != nil {
+		s.D.Set("cidr_block", *s.Res.CidrBlock)
+	}
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", tfresource.DefinedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.Ipv6CidrBlock != nil {
+		s.D.Set("ipv6cidr_block", *s.Res.Ipv6CidrBlock)
+	}
+
+	s.D.Set("is_advertised", s.Res.LifecycleDetails == oci_core.ByoipRangeLifecycleDetailsActive ||
+		s.Res.LifecycleDetails == oci_core.ByoipRangeLifecycleDetailsAdvertising)
+
+	s.D.Set("lifecycle_details", s.Res.LifecycleDetails)
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeAdvertised != nil {
+		s.D.Set("time_advertised", s.Res.TimeAdvertised.String())
+	}
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.TimeValidated != nil {
+		s.D.Set("time_validated", s.Res.TimeValidated.String())
+	}
+
+	if s.Res.TimeWithdrawn != nil {
+		s.D.Set("time_withdrawn", s.Res.TimeWithdrawn.String())
+	}
+
+	if s.Res.ValidationToken != nil {
+		s.D.Set("validation_token", *s.Res.ValidationToken)
+	}
+
+	return nil
+}
+
+// ValidateByoipRange submits the range for validation against the validation token published in the RIR entry. It does
+// not wait for the result, validation can take several business days and its progress is reported in lifecycle_details.
+func (s *CoreByoipRangeResourceCrud) ValidateByoipRange() error {
+	request := oci_core.ValidateByoipRangeRequest{}
+
+	tmp := s.D.Id()
+	request.ByoipRangeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.ValidateByoipRange(context.Background(), request)
+	return err
+}
+
+func (s *CoreByoipRangeResourceCrud) AdvertiseByoipRange(timeoutKey string) error {
+	request := oci_core.AdvertiseByoipRangeRequest{}
+
+	tmp := s.D.Id()
+	request.ByoipRangeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	previous := s.lifecycleDetails()
+	_, err := s.Client.AdvertiseByoipRange(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	return s.waitForLifecycleDetails(previous, oci_core.ByoipRangeLifecycleDetailsActive, timeoutKey)
+}
+
+func (s *CoreByoipRangeResourceCrud) WithdrawByoipRange(timeoutKey string) error {
+	request := oci_core.WithdrawByoipRangeRequest{}
+
+	tmp := s.D.Id()
+	request.ByoipRangeId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	previous := s.lifecycleDetails()
+	_, err := s.Client.WithdrawByoipRange(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	return s.waitForLifecycleDetails(previous, oci_core.ByoipRangeLifecycleDetailsProvisioned, timeoutKey)
+}
+
+// waitForLifecycleDetails polls the range until its lifecycle details reach the target, a failed action is reported as
+// an error instead of waiting for the timeout. FAILED is only accepted once the lifecycle details have left the value
+// they had before the action, so the result of a previous action is not reported again.
+func (s *CoreByoipRangeResourceCrud) waitForLifecycleDetails(previous oci_core.ByoipRangeLifecycleDetailsEnum, target oci_core.ByoipRangeLifecycleDetailsEnum, timeoutKey string) error {
+	changed := false
+	lifecycleDetailsFunc := func() bool {
+		if s.Res.LifecycleDetails != previous {
+			changed = true
+		}
+		return s.Res.LifecycleDetails == target || (changed && s.Res.LifecycleDetails == oci_core.ByoipRangeLifecycleDetailsFailed)
+	}
+
+	if err := tfresource.WaitForResourceCondition(s, lifecycleDetailsFunc, s.D.Timeout(timeoutKey)); err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleDetails == oci_core.ByoipRangeLifecycleDetailsFailed {
+		return fmt.Errorf("byoip range %s failed to reach lifecycle details %s", s.D.Id(), target)
+	}
+
+	return nil
+}
+
+func (s *CoreByoipRangeResourceCrud) lifecycleDetails() oci_core.ByoipRangeLifecycleDetailsEnum {
+	if s.Res == nil {
+		return ""
+	}
+	return s.Res.LifecycleDetails
+}
+
+func (s *CoreByoipRangeResourceCrud) updateCompartment(compartment interface{}) error {
+	changeCompartmentRequest := oci_core.ChangeByoipRangeCompartmentRequest{}
+
+	idTmp := s.D.Id()
+	changeCompartmentRequest.ByoipRangeId = &idTmp
+
+	compartmentTmp := compartment.(string)
+	changeCompartmentRequest.CompartmentId = &compartmentTmp
+
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.Client.ChangeByoipRangeCompartment(context.Background(), changeCompartmentRequest)
+	if err != nil {
+		return err
+	}
+
+	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		return waitErr
+	}
+
+	return nil
+}
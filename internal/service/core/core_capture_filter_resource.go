@@ -29,8 +29,9 @@ func CoreCaptureFilterResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"filter_type": {
 				Type:     schema.TypeString,
@@ -27,11 +27,14 @@ func CoreClusterNetworkResource() *schema.Resource {
 		Read:     readCoreClusterNetwork,
 		Update:   updateCoreClusterNetwork,
 		Delete:   deleteCoreClusterNetwork,
+		CustomizeDiff: tfresource.ErrorWhen(tfresource.AttributeCountChanged("instance_pools"),
+			"instance pools cannot be added to or removed from an existing cluster network, only the size, instance configuration, names and tags of its instance pools can be updated"),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"instance_pools": {
 				Type:     schema.TypeList,
@@ -284,9 +287,10 @@ func CoreClusterNetworkResource() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									// Required
 									"subnet_id": {
-										Type:     schema.TypeString,
-										Required: true,
-										ForceNew: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: tfresource.ValidateOcid("subnet"),
 									},
 
 									// Optional
@@ -332,9 +336,10 @@ func CoreClusterNetworkResource() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									// Required
 									"subnet_id": {
-										Type:     schema.TypeString,
-										Required: true,
-										ForceNew: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: tfresource.ValidateOcid("subnet"),
 									},
 
 									// Optional
@@ -519,6 +524,22 @@ func (s *CoreClusterNetworkResourceCrud) CreatedTarget() []string {
 	}
 }
 
+func (s *CoreClusterNetworkResourceCrud) UpdatedPending() []string {
+	return []string{
+		string(oci_core.ClusterNetworkLifecycleStateProvisioning),
+		string(oci_core.ClusterNetworkLifecycleStateScaling),
+		string(oci_core.ClusterNetworkLifecycleStateStarting),
+		string(oci_core.ClusterNetworkLifecycleStateStopping),
+	}
+}
+
+func (s *CoreClusterNetworkResourceCrud) UpdatedTarget() []string {
+	return []string{
+		string(oci_core.ClusterNetworkLifecycleStateStopped),
+		string(oci_core.ClusterNetworkLifecycleStateRunning),
+	}
+}
+
 func (s *CoreClusterNetworkResourceCrud) DeletedPending() []string {
 	return []string{
 		string(oci_core.ClusterNetworkLifecycleStateTerminating),
@@ -56,9 +56,10 @@ func CoreComputeCapacityTopologyResource() *schema.Resource {
 
 						// Optional
 						"compartment_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 						},
 
 						// Computed
@@ -66,8 +67,9 @@ func CoreComputeCapacityTopologyResource() *schema.Resource {
 				},
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 
 			// Optional
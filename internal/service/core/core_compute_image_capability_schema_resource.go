@@ -29,8 +29,9 @@ func CoreComputeImageCapabilitySchemaResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"compute_global_image_capability_schema_version_name": {
 				Type:     schema.TypeString,
@@ -30,6 +30,10 @@ func CoreConsoleHistoryContentDataSource() *schema.Resource {
 				Optional: true,
 			},
 			// Computed
+			"bytes_remaining": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"data": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -64,7 +68,8 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
 		request.InstanceConsoleHistoryId = &tmp
 	}
 
-	if length, ok := s.D.GetOkExists("length"); ok {
+	length, lengthOk := s.D.GetOkExists("length")
+	if lengthOk {
 		tmp := length.(int)
 		request.Length = &tmp
 	}
@@ -82,6 +87,34 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) Get() error {
 	}
 
 	s.Res = &response
+
+	// Without an explicit length the whole captured history is read, the service returns it in chunks
+	if lengthOk {
+		return nil
+	}
+	content := ""
+	if response.Value != nil {
+		content = *response.Value
+	}
+	offset := 0
+	if request.Offset != nil {
+		offset = *request.Offset
+	}
+	for s.Res.OpcBytesRemaining != nil && *s.Res.OpcBytesRemaining > 0 && s.Res.Value != nil && len(*s.Res.Value) > 0 {
+		offset += len(*s.Res.Value)
+		request.Offset = &offset
+
+		response, err := s.Client.GetConsoleHistoryContent(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		s.Res = &response
+		if response.Value != nil {
+			content += *response.Value
+		}
+	}
+	s.Res.Value = &content
+
 	return nil
 }
 
@@ -92,6 +125,10 @@ func (s *CoreConsoleHistoryContentDataSourceCrud) SetData() error {
 
 	s.D.SetId(tfresource.GenerateDataSourceHashID("CoreConsoleHistoryContentDataSource-", CoreConsoleHistoryContentDataSource(), s.D))
 
+	if s.Res.OpcBytesRemaining != nil {
+		s.D.Set("bytes_remaining", *s.Res.OpcBytesRemaining)
+	}
+
 	if s.Res.Value != nil {
 		s.D.Set("data", *s.Res.Value)
 	}
@@ -27,9 +27,10 @@ func CoreConsoleHistoryResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
 			},
 
 			// Optional
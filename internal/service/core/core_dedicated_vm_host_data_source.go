@@ -19,7 +19,10 @@ func CoreDedicatedVmHostDataSource() *schema.Resource {
 		Type:     schema.TypeString,
 		Required: true,
 	}
-	return tfresource.GetSingularDataSourceItemSchema(CoreDedicatedVmHostResource(), fieldMap, readSingularCoreDedicatedVmHost)
+	dataSource := tfresource.GetSingularDataSourceItemSchema(CoreDedicatedVmHostResource(), fieldMap, readSingularCoreDedicatedVmHost)
+	// Instances placed on the host are listed by oci_core_dedicated_vm_hosts_instances
+	delete(dataSource.Schema, "placed_instances")
+	return dataSource
 }
 
 func readSingularCoreDedicatedVmHost(d *schema.ResourceData, m interface{}) error {
@@ -5,6 +5,7 @@ package core
 
 import (
 	"context"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -34,8 +35,9 @@ func CoreDedicatedVmHostResource() *schema.Resource {
 				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"dedicated_vm_host_shape": {
 				Type:     schema.TypeString,
@@ -70,6 +72,35 @@ func CoreDedicatedVmHostResource() *schema.Resource {
 			},
 
 			// Computed
+			"placed_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"compartment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"shape": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"remaining_memory_in_gbs": {
 				Type:     schema.TypeFloat,
 				Computed: true,
@@ -139,6 +170,7 @@ type CoreDedicatedVmHostResourceCrud struct {
 	Client                 *oci_core.ComputeClient
 	workRequestClient      *oci_work_requests.WorkRequestClient
 	Res                    *oci_core.DedicatedVmHost
+	PlacedInstances        []oci_core.DedicatedVmHostInstanceSummary
 	DisableNotFoundRetries bool
 	WorkRequestClient      *oci_work_requests.WorkRequestClient
 }
@@ -254,6 +286,17 @@ func (s *CoreDedicatedVmHostResourceCrud) Get() error {
 	}
 
 	s.Res = &response.DedicatedVmHost
+
+	// Placed instances are only listed once the host is usable, not while waiting for it to be created or deleted.
+	// Failing to list them, e.g. without permission to inspect instances, keeps the previous value instead of failing the read
+	if s.Res.LifecycleState == oci_core.DedicatedVmHostLifecycleStateActive {
+		placedInstances, err := listDedicatedVmHostPlacedInstances(s.Client, s.Res, s.DisableNotFoundRetries)
+		if err != nil {
+			log.Printf("[WARN] unable to list the instances placed on dedicated VM host %s: %v", s.D.Id(), err)
+		} else {
+			s.PlacedInstances = placedInstances
+		}
+	}
 	return nil
 }
 
@@ -351,6 +394,10 @@ func (s *CoreDedicatedVmHostResourceCrud) SetData() error {
 
 	s.D.Set("freeform_tags", s.Res.FreeformTags)
 
+	if s.PlacedInstances != nil {
+		s.D.Set("placed_instances", DedicatedVmHostPlacedInstancesToList(s.PlacedInstances))
+	}
+
 	if s.Res.RemainingMemoryInGBs != nil {
 		s.D.Set("remaining_memory_in_gbs", *s.Res.RemainingMemoryInGBs)
 	}
@@ -401,3 +448,54 @@ func (s *CoreDedicatedVmHostResourceCrud) updateCompartment(compartment interfac
 	}
 	return nil
 }
+
+// listDedicatedVmHostPlacedInstances lists the instances placed on the dedicated virtual machine host that belong to
+// the compartment of the host
+func listDedicatedVmHostPlacedInstances(client *oci_core.ComputeClient, host *oci_core.DedicatedVmHost, disableNotFoundRetries bool) ([]oci_core.DedicatedVmHostInstanceSummary, error) {
+	request := oci_core.ListDedicatedVmHostInstancesRequest{}
+	request.AvailabilityDomain = host.AvailabilityDomain
+	request.CompartmentId = host.CompartmentId
+	request.DedicatedVmHostId = host.Id
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(disableNotFoundRetries, "core")
+
+	items := []oci_core.DedicatedVmHostInstanceSummary{}
+	for {
+		response, err := client.ListDedicatedVmHostInstances(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return items, nil
+}
+
+func DedicatedVmHostPlacedInstancesToList(items []oci_core.DedicatedVmHostInstanceSummary) []interface{} {
+	result := []interface{}{}
+	for _, item := range items {
+		placedInstance := map[string]interface{}{}
+
+		if item.CompartmentId != nil {
+			placedInstance["compartment_id"] = *item.CompartmentId
+		}
+
+		if item.InstanceId != nil {
+			placedInstance["instance_id"] = *item.InstanceId
+		}
+
+		if item.Shape != nil {
+			placedInstance["shape"] = *item.Shape
+		}
+
+		if item.TimeCreated != nil {
+			placedInstance["time_created"] = item.TimeCreated.String()
+		}
+
+		result = append(result, placedInstance)
+	}
+	return result
+}
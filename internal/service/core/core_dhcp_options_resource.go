@@ -33,8 +33,9 @@ func CoreDhcpOptionsResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"options": {
 				Type:     schema.TypeSet,
@@ -88,9 +89,10 @@ func CoreDhcpOptionsResource() *schema.Resource {
 				},
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("vcn"),
 			},
 
 			// Optional
@@ -40,13 +40,15 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 				}, true),
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"drg_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("drg"),
 			},
 			// Optional
 			"network_id": {
@@ -66,9 +68,10 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 				Computed: true,
 			},
 			"drg_route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("drgroutetable"),
 			},
 			"freeform_tags": {
 				Type:     schema.TypeMap,
@@ -102,9 +105,10 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 
 						// Optional
 						"route_table_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: tfresource.ValidateOcid("routetable"),
 						},
 
 						// Computed
@@ -116,9 +120,10 @@ func CoreDrgAttachmentManagementResource() *schema.Resource {
 				},
 			},
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("routetable"),
 			},
 			"vcn_id": {
 				Type:     schema.TypeString,
@@ -31,9 +31,10 @@ func CoreDrgAttachmentResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("drg"),
 			},
 
 			// Optional
@@ -50,9 +51,10 @@ func CoreDrgAttachmentResource() *schema.Resource {
 				Computed: true,
 			},
 			"drg_route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("drgroutetable"),
 			},
 			"freeform_tags": {
 				Type:     schema.TypeMap,
@@ -89,9 +91,10 @@ func CoreDrgAttachmentResource() *schema.Resource {
 							ForceNew: true,
 						},
 						"route_table_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: tfresource.ValidateOcid("routetable"),
 						},
 						"vcn_route_type": {
 							Type:     schema.TypeString,
@@ -123,9 +126,10 @@ func CoreDrgAttachmentResource() *schema.Resource {
 				},
 			},
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("routetable"),
 			},
 			"vcn_id": {
 				Type:     schema.TypeString,
@@ -393,6 +397,16 @@ func (s *CoreDrgAttachmentResourceCrud) Update() error {
 	}
 
 	s.Res = &response.DrgAttachment
+
+	// Reassigning the route table is applied asynchronously, wait until the attachment reports the new table
+	if request.DrgRouteTableId != nil {
+		drgRouteTableFunc := func() bool {
+			return s.Res.DrgRouteTableId != nil && *s.Res.DrgRouteTableId == *request.DrgRouteTableId &&
+				s.Res.LifecycleState == oci_core.DrgAttachmentLifecycleStateAttached
+		}
+		return tfresource.WaitForResourceCondition(s, drgRouteTableFunc, s.D.Timeout(schema.TimeoutUpdate))
+	}
+
 	return nil
 }
 
@@ -23,9 +23,10 @@ func CoreDrgAttachmentsListResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("drg"),
 			},
 
 			// Optional
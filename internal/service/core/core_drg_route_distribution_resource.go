@@ -32,9 +32,10 @@ func CoreDrgRouteDistributionResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"drg_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("drg"),
 			},
 
 			// Optional
@@ -0,0 +1,480 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+func CoreDrgRouteDistributionStatementsResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts:      tfresource.DefaultTimeout,
+		Create:        createCoreDrgRouteDistributionStatementsResource,
+		Read:          readCoreDrgRouteDistributionStatementsResource,
+		Update:        updateCoreDrgRouteDistributionStatementsResource,
+		Delete:        deleteCoreDrgRouteDistributionStatementsResource,
+		CustomizeDiff: drgRouteDistributionStatementsCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"drg_route_distribution_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"statements": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      drgRouteDistributionStatementsHashCodeForSets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"action": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						// Optional
+						"match_criteria": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							MinItems: 0,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+									"match_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"DRG_ATTACHMENT_ID",
+											"DRG_ATTACHMENT_TYPE",
+											"MATCH_ALL",
+										}, true),
+									},
+
+									// Optional
+									"attachment_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"drg_attachment_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									// Computed
+								},
+							},
+						},
+
+						// Computed
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createCoreDrgRouteDistributionStatementsResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreDrgRouteDistributionStatementsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.CreateResource(d, sync)
+}
+
+func readCoreDrgRouteDistributionStatementsResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreDrgRouteDistributionStatementsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.ReadResource(sync)
+}
+
+func updateCoreDrgRouteDistributionStatementsResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreDrgRouteDistributionStatementsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteCoreDrgRouteDistributionStatementsResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreDrgRouteDistributionStatementsResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.DeleteResource(d, sync)
+}
+
+type CoreDrgRouteDistributionStatementsResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    []oci_core.DrgRouteDistributionStatement
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) ID() string {
+	return s.drgRouteDistributionId()
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) drgRouteDistributionId() string {
+	if drgRouteDistributionId, ok := s.D.GetOkExists("drg_route_distribution_id"); ok {
+		return drgRouteDistributionId.(string)
+	}
+	return s.D.Id()
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) Create() error {
+	return s.reconcile()
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) Get() error {
+	statements, err := s.listStatements()
+	if err != nil {
+		return err
+	}
+
+	s.Res = statements
+	return nil
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) Update() error {
+	return s.reconcile()
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) Delete() error {
+	statements, err := s.listStatements()
+	if err != nil {
+		return err
+	}
+
+	statementIds := make([]string, 0, len(statements))
+	for _, statement := range statements {
+		statementIds = append(statementIds, *statement.Id)
+	}
+
+	return s.removeStatements(statementIds)
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) SetData() error {
+	s.D.Set("drg_route_distribution_id", s.drgRouteDistributionId())
+
+	statements := []interface{}{}
+	for _, item := range s.Res {
+		statements = append(statements, drgRouteDistributionStatementToMap(item))
+	}
+	s.D.Set("statements", schema.NewSet(drgRouteDistributionStatementsHashCodeForSets, statements))
+
+	return nil
+}
+
+// reconcile makes the statements of the route distribution match the configured statements. Priorities are unique
+// within a route distribution so statements are matched by priority: unchanged statements are left untouched, statements
+// whose match criteria changed are updated in place and statements whose action changed are replaced. Statements that
+// are no longer configured are removed first so that their priorities can be reused by the statements being added.
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) reconcile() error {
+	existingStatements, err := s.listStatements()
+	if err != nil {
+		return err
+	}
+
+	existingByPriority := map[int]oci_core.DrgRouteDistributionStatement{}
+	for _, statement := range existingStatements {
+		if statement.Priority != nil {
+			existingByPriority[*statement.Priority] = statement
+		}
+	}
+
+	var statementsToAdd []oci_core.AddDrgRouteDistributionStatementDetails
+	var statementsToUpdate []oci_core.UpdateDrgRouteDistributionStatementDetails
+	var statementIdsToRemove []string
+
+	if statements, ok := s.D.GetOkExists("statements"); ok {
+		for _, item := range statements.(*schema.Set).List() {
+			hash := drgRouteDistributionStatementsHashCodeForSets(item)
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "statements", hash)
+			priority := item.(map[string]interface{})["priority"].(int)
+
+			matchCriteria, err := s.mapToMatchCriteria(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+
+			if existing, ok := existingByPriority[priority]; ok {
+				delete(existingByPriority, priority)
+
+				if drgRouteDistributionStatementsHashCodeForSets(drgRouteDistributionStatementToMap(existing)) == hash {
+					continue
+				}
+				if strings.EqualFold(string(existing.Action), item.(map[string]interface{})["action"].(string)) {
+					statementsToUpdate = append(statementsToUpdate, oci_core.UpdateDrgRouteDistributionStatementDetails{
+						Id:            existing.Id,
+						MatchCriteria: matchCriteria,
+						Priority:      &priority,
+					})
+					continue
+				}
+				statementIdsToRemove = append(statementIdsToRemove, *existing.Id)
+			}
+
+			statementsToAdd = append(statementsToAdd, oci_core.AddDrgRouteDistributionStatementDetails{
+				Action:        oci_core.AddDrgRouteDistributionStatementDetailsActionEnum(strings.ToUpper(item.(map[string]interface{})["action"].(string))),
+				MatchCriteria: matchCriteria,
+				Priority:      &priority,
+			})
+		}
+	}
+
+	for _, statement := range existingByPriority {
+		statementIdsToRemove = append(statementIdsToRemove, *statement.Id)
+	}
+
+	if err := s.removeStatements(statementIdsToRemove); err != nil {
+		return err
+	}
+	if err := s.updateStatements(statementsToUpdate); err != nil {
+		return err
+	}
+	if err := s.addStatements(statementsToAdd); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) listStatements() ([]oci_core.DrgRouteDistributionStatement, error) {
+	request := oci_core.ListDrgRouteDistributionStatementsRequest{}
+
+	drgRouteDistributionId := s.drgRouteDistributionId()
+	request.DrgRouteDistributionId = &drgRouteDistributionId
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	statements := response.Items
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListDrgRouteDistributionStatements(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return statements, nil
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) addStatements(statements []oci_core.AddDrgRouteDistributionStatementDetails) error {
+	if len(statements) == 0 {
+		return nil
+	}
+
+	drgRouteDistributionId := s.drgRouteDistributionId()
+
+	request := oci_core.AddDrgRouteDistributionStatementsRequest{}
+	request.DrgRouteDistributionId = &drgRouteDistributionId
+	request.Statements = statements
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if _, err := s.Client.AddDrgRouteDistributionStatements(context.Background(), request); err != nil {
+		return fmt.Errorf("failed to add distribution statements, error: %v", err)
+	}
+
+	return nil
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) updateStatements(statements []oci_core.UpdateDrgRouteDistributionStatementDetails) error {
+	if len(statements) == 0 {
+		return nil
+	}
+
+	drgRouteDistributionId := s.drgRouteDistributionId()
+
+	request := oci_core.UpdateDrgRouteDistributionStatementsRequest{}
+	request.DrgRouteDistributionId = &drgRouteDistributionId
+	request.Statements = statements
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if _, err := s.Client.UpdateDrgRouteDistributionStatements(context.Background(), request); err != nil {
+		return fmt.Errorf("failed to update distribution statements, error: %v", err)
+	}
+
+	return nil
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) removeStatements(statementIds []string) error {
+	if len(statementIds) == 0 {
+		return nil
+	}
+
+	drgRouteDistributionId := s.drgRouteDistributionId()
+
+	request := oci_core.RemoveDrgRouteDistributionStatementsRequest{}
+	request.DrgRouteDistributionId = &drgRouteDistributionId
+	request.StatementIds = statementIds
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if _, err := s.Client.RemoveDrgRouteDistributionStatements(context.Background(), request); err != nil {
+		return fmt.Errorf("failed to remove distribution statements, error: %v", err)
+	}
+
+	return nil
+}
+
+func (s *CoreDrgRouteDistributionStatementsResourceCrud) mapToMatchCriteria(fieldKeyFormat string) ([]oci_core.DrgRouteDistributionMatchCriteria, error) {
+	matchCriteria, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "match_criteria"))
+	if !ok {
+		return nil, nil
+	}
+	tmpList := matchCriteria.([]interface{})
+	if len(tmpList) == 0 {
+		return nil, nil
+	}
+
+	fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "match_criteria"), 0)
+	matchType, _ := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormatNextLevel, "match_type"))
+
+	var result oci_core.DrgRouteDistributionMatchCriteria
+	switch strings.ToUpper(matchType.(string)) {
+	case "DRG_ATTACHMENT_ID":
+		details := oci_core.DrgAttachmentIdDrgRouteDistributionMatchCriteria{}
+		if drgAttachmentId, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormatNextLevel, "drg_attachment_id")); ok {
+			tmp := drgAttachmentId.(string)
+			details.DrgAttachmentId = &tmp
+		}
+		result = details
+	case "DRG_ATTACHMENT_TYPE":
+		details := oci_core.DrgAttachmentTypeDrgRouteDistributionMatchCriteria{}
+		if attachmentType, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormatNextLevel, "attachment_type")); ok {
+			details.AttachmentType = oci_core.DrgAttachmentTypeDrgRouteDistributionMatchCriteriaAttachmentTypeEnum(attachmentType.(string))
+		}
+		result = details
+	case "MATCH_ALL":
+		result = oci_core.DrgAttachmentMatchAllDrgRouteDistributionMatchCriteria{}
+	default:
+		return nil, fmt.Errorf("unknown match_type '%v' was specified", matchType)
+	}
+
+	return []oci_core.DrgRouteDistributionMatchCriteria{result}, nil
+}
+
+func drgRouteDistributionStatementToMap(obj oci_core.DrgRouteDistributionStatement) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	result["action"] = string(obj.Action)
+
+	if obj.Id != nil {
+		result["id"] = string(*obj.Id)
+	}
+
+	matchCriteria := []interface{}{}
+	for _, item := range obj.MatchCriteria {
+		if criteria := DrgRouteDistributionMatchCriteriaToMap(item); criteria != nil {
+			matchCriteria = append(matchCriteria, criteria)
+		}
+	}
+	result["match_criteria"] = matchCriteria
+
+	if obj.Priority != nil {
+		result["priority"] = int(*obj.Priority)
+	}
+
+	return result
+}
+
+// drgRouteDistributionStatementsCustomizeDiff rejects statements sharing a priority at plan time, the service would
+// otherwise fail the bulk add or update after part of the statements have been reconciled
+func drgRouteDistributionStatementsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("statements") {
+		return nil
+	}
+	statements, ok := d.Get("statements").(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	priorities := map[int]bool{}
+	for _, item := range statements.List() {
+		statement, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		priority, ok := statement["priority"].(int)
+		if !ok {
+			continue
+		}
+		if priorities[priority] {
+			return fmt.Errorf("statements must have unique priorities, priority %d is used more than once", priority)
+		}
+		priorities[priority] = true
+	}
+	return nil
+}
+
+// drgRouteDistributionStatementsHashCodeForSets identifies a statement by its content. A statement without match
+// criteria matches everything, so it hashes the same as a statement with a MATCH_ALL criteria.
+func drgRouteDistributionStatementsHashCodeForSets(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if action, ok := m["action"]; ok && action != "" {
+		buf.WriteString(fmt.Sprintf("%v-", strings.ToUpper(action.(string))))
+	}
+	if priority, ok := m["priority"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", priority))
+	}
+
+	matchType := "MATCH_ALL"
+	var matchCriteriaRaw map[string]interface{}
+	if matchCriteria, ok := m["match_criteria"]; ok {
+		if tmpList := matchCriteria.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+			matchCriteriaRaw = tmpList[0].(map[string]interface{})
+			if rawMatchType, ok := matchCriteriaRaw["match_type"]; ok && rawMatchType != "" {
+				matchType = strings.ToUpper(rawMatchType.(string))
+			}
+		}
+	}
+	buf.WriteString(fmt.Sprintf("%v-", matchType))
+	switch matchType {
+	case "DRG_ATTACHMENT_ID":
+		if drgAttachmentId, ok := matchCriteriaRaw["drg_attachment_id"]; ok {
+			buf.WriteString(fmt.Sprintf("%v-", drgAttachmentId))
+		}
+	case "DRG_ATTACHMENT_TYPE":
+		if attachmentType, ok := matchCriteriaRaw["attachment_type"]; ok {
+			buf.WriteString(fmt.Sprintf("%v-", attachmentType))
+		}
+	}
+
+	return utils.GetStringHashcode(buf.String())
+}
@@ -27,9 +27,10 @@ func CoreDrgRouteTableResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("drg"),
 			},
 
 			// Optional
@@ -275,6 +276,16 @@ func (s *CoreDrgRouteTableResourceCrud) Update() error {
 	}
 
 	s.Res = &response.DrgRouteTable
+
+	// Reassigning the import route distribution is applied asynchronously, wait until the route table reports it
+	if request.ImportDrgRouteDistributionId != nil {
+		importDrgRouteDistributionFunc := func() bool {
+			return s.Res.ImportDrgRouteDistributionId != nil && *s.Res.ImportDrgRouteDistributionId == *request.ImportDrgRouteDistributionId &&
+				s.Res.LifecycleState == oci_core.DrgRouteTableLifecycleStateAvailable
+		}
+		return tfresource.WaitForResourceCondition(s, importDrgRouteDistributionFunc, s.D.Timeout(schema.TimeoutUpdate))
+	}
+
 	return nil
 }
 
@@ -32,9 +32,10 @@ func CoreDrgRouteTableRouteRuleResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"drg_route_table_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("drgroutetable"),
 			},
 			// Computed
 			"attributes": {
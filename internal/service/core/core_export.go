@@ -1069,6 +1069,14 @@ var coreResourceGraph = tf_export.TerraformResourceGraph{
 			},
 		},
 	},
+	"oci_core_volume_group": {
+		{
+			TerraformResourceHints: exportCoreVolumeBackupPolicyAssignmentHints,
+			DatasourceQueryParams: map[string]string{
+				"asset_id": "id",
+			},
+		},
+	},
 	"oci_core_drg_route_table": {
 		{
 			TerraformResourceHints: exportCoreDrgRouteTableRouteRuleHints,
@@ -36,8 +36,9 @@ func CoreImageResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 
 			// Optional
@@ -121,9 +122,10 @@ func CoreImageResource() *schema.Resource {
 				},
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
 			},
 			"launch_mode": {
 				Type:     schema.TypeString,
@@ -0,0 +1,239 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/httpreplay"
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+func CoreInstanceActionResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Create: createCoreInstanceAction,
+		Read:   readCoreInstanceAction,
+		Delete: deleteCoreInstanceAction,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.InstanceActionActionStop),
+					string(oci_core.InstanceActionActionStart),
+					string(oci_core.InstanceActionActionSoftreset),
+					string(oci_core.InstanceActionActionReset),
+					string(oci_core.InstanceActionActionSoftstop),
+					string(oci_core.InstanceActionActionSenddiagnosticinterrupt),
+					string(oci_core.InstanceActionActionDiagnosticreboot),
+				}, true),
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
+			},
+
+			// Optional
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     schema.TypeString,
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createCoreInstanceAction(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreInstanceActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ComputeClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readCoreInstanceAction(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreInstanceActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ComputeClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+// Return nil because this is an action-type operation that cannot be undone
+func deleteCoreInstanceAction(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type CoreInstanceActionResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_core.ComputeClient
+	Res                    *oci_core.Instance
+	Etag                   *string
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreInstanceActionResourceCrud) ID() string {
+	return tfresource.GenerateDataSourceHashID("CoreInstanceActionResource-", CoreInstanceActionResource(), s.D)
+}
+
+func (s *CoreInstanceActionResourceCrud) Create() error {
+	request := oci_core.InstanceActionRequest{}
+
+	if action, ok := s.D.GetOkExists("action"); ok {
+		request.Action = oci_core.InstanceActionActionEnum(strings.ToUpper(action.(string)))
+	}
+
+	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
+		tmp := instanceId.(string)
+		request.InstanceId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.InstanceAction(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Instance
+	s.Etag = response.Etag
+
+	targetState, ok := instanceActionTargetState(request.Action)
+	if !ok {
+		return nil
+	}
+
+	if !instanceActionRestarts(request.Action) {
+		instanceReachedStateFunc := func() bool { return s.Res.LifecycleState == targetState }
+		return tfresource.WaitForResourceCondition(s, instanceReachedStateFunc, s.D.Timeout(schema.TimeoutCreate))
+	}
+
+	return s.waitForRestart()
+}
+
+// waitForRestart waits until a restarting instance is running again. The instance is usually still RUNNING when the
+// action is accepted and a fast reboot can complete between two polls, so the restart is detected by a change of the
+// etag of the instance, which changes with every lifecycle state transition, rather than by observing the transitions.
+// InstanceAction does not return a work request that could be waited on instead.
+func (s *CoreInstanceActionResourceCrud) waitForRestart() error {
+	const restartPending = "RESTART_PENDING"
+
+	actionEtag := s.Etag
+	restarted := s.Res.LifecycleState != oci_core.InstanceLifecycleStateRunning
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			restartPending,
+			string(oci_core.InstanceLifecycleStateStopping),
+			string(oci_core.InstanceLifecycleStateStopped),
+			string(oci_core.InstanceLifecycleStateStarting),
+		},
+		Target: []string{
+			string(oci_core.InstanceLifecycleStateRunning),
+		},
+		Refresh: func() (interface{}, string, error) {
+			if err := s.Get(); err != nil {
+				return nil, "", err
+			}
+			if s.Res.LifecycleState != oci_core.InstanceLifecycleStateRunning || etagChanged(actionEtag, s.Etag) {
+				restarted = true
+			} else if !restarted {
+				return s.Res, restartPending, nil
+			}
+			return s.Res, string(s.Res.LifecycleState), nil
+		},
+		Timeout:      s.D.Timeout(schema.TimeoutCreate),
+		PollInterval: 5 * time.Second,
+	}
+	// Set PollInterval to 1 for replay mode.
+	if httpreplay.ShouldRetryImmediately() {
+		stateConf.PollInterval = 1
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func (s *CoreInstanceActionResourceCrud) Get() error {
+	request := oci_core.GetInstanceRequest{}
+
+	if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
+		tmp := instanceId.(string)
+		request.InstanceId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetInstance(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Instance
+	s.Etag = response.Etag
+	return nil
+}
+
+func (s *CoreInstanceActionResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	return nil
+}
+
+// etagChanged returns whether the etag differs from the one of the earlier response, an unknown etag is not a change
+func etagChanged(previous *string, current *string) bool {
+	return previous != nil && current != nil && *previous != *current
+}
+
+// instanceActionRestarts returns whether the action stops and starts a running instance
+func instanceActionRestarts(action oci_core.InstanceActionActionEnum) bool {
+	switch action {
+	case oci_core.InstanceActionActionReset, oci_core.InstanceActionActionSoftreset, oci_core.InstanceActionActionDiagnosticreboot:
+		return true
+	default:
+		return false
+	}
+}
+
+// instanceActionTargetState returns the lifecycle state the instance settles in once the action completes, actions
+// that do not change the power state of the instance are not waited on
+func instanceActionTargetState(action oci_core.InstanceActionActionEnum) (oci_core.InstanceLifecycleStateEnum, bool) {
+	switch action {
+	case oci_core.InstanceActionActionStop, oci_core.InstanceActionActionSoftstop:
+		return oci_core.InstanceLifecycleStateStopped, true
+	case oci_core.InstanceActionActionStart, oci_core.InstanceActionActionReset, oci_core.InstanceActionActionSoftreset,
+		oci_core.InstanceActionActionDiagnosticreboot:
+		return oci_core.InstanceLifecycleStateRunning, true
+	default:
+		return "", false
+	}
+}
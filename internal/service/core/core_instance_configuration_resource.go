@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -29,11 +30,19 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 		Read:     readCoreInstanceConfiguration,
 		Update:   updateCoreInstanceConfiguration,
 		Delete:   deleteCoreInstanceConfiguration,
+		CustomizeDiff: customdiff.All(
+			tfresource.RequiredWhen(tfresource.AttributeIn("source", "INSTANCE"), "source is INSTANCE", "instance_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("instance_details.0.source", "INSTANCE"), "instance_details.0.source is INSTANCE", "instance_details.0.instance_id"),
+			tfresource.RequiredWhen(
+				tfresource.AllOf(tfresource.AttributeIsConfigured("instance_details.0"), instanceConfigurationIsNotFromInstance),
+				"the instance configuration is not created from an instance", "instance_details.0.instance_type"),
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 
 			// Optional
@@ -65,9 +74,12 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
+
+						// Optional
 						"instance_type": {
 							Type:             schema.TypeString,
-							Required:         true,
+							Optional:         true,
+							Computed:         true,
 							ForceNew:         true,
 							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 							ValidateFunc: validation.StringInSlice([]string{
@@ -75,8 +87,22 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 								"instance_options",
 							}, true),
 						},
-
-						// Optional
+						"instance_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: tfresource.ValidateOcid("instance"),
+						},
+						"source": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+							ValidateFunc: validation.StringInSlice([]string{
+								"INSTANCE",
+								"NONE",
+							}, true),
+						},
 						"block_volumes": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -244,10 +270,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 													ForceNew: true,
 												},
 												"compartment_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-													Computed: true,
-													ForceNew: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ForceNew:     true,
+													ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 												},
 												"defined_tags": {
 													Type:             schema.TypeMap,
@@ -475,10 +502,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 										ForceNew: true,
 									},
 									"compartment_id": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
-										ForceNew: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 									},
 									"create_vnic_details": {
 										Type:     schema.TypeList,
@@ -570,7 +598,8 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 													ForceNew: true,
 													Set:      tfresource.LiteralTypeHashCodeForSets,
 													Elem: &schema.Schema{
-														Type: schema.TypeString,
+														Type:         schema.TypeString,
+														ValidateFunc: tfresource.ValidateOcid("networksecuritygroup"),
 													},
 												},
 												"private_ip": {
@@ -593,10 +622,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 													ForceNew: true,
 												},
 												"subnet_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-													Computed: true,
-													ForceNew: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ForceNew:     true,
+													ValidateFunc: tfresource.ValidateOcid("subnet"),
 												},
 
 												// Computed
@@ -1024,9 +1054,10 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 
 															// Optional
 															"compartment_id": {
-																Type:     schema.TypeString,
-																Optional: true,
-																Computed: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 															},
 															"defined_tags_filter": {
 																Type:             schema.TypeMap,
@@ -1246,9 +1277,10 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 																ForceNew: true,
 															},
 															"compartment_id": {
-																Type:     schema.TypeString,
-																Optional: true,
-																Computed: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 															},
 															"defined_tags": {
 																Type:             schema.TypeMap,
@@ -1476,9 +1508,10 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 													ForceNew: true,
 												},
 												"compartment_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-													Computed: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 												},
 												"create_vnic_details": {
 													Type:     schema.TypeList,
@@ -1571,7 +1604,8 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 																ForceNew: true,
 																Set:      tfresource.LiteralTypeHashCodeForSets,
 																Elem: &schema.Schema{
-																	Type: schema.TypeString,
+																	Type:         schema.TypeString,
+																	ValidateFunc: tfresource.ValidateOcid("networksecuritygroup"),
 																},
 															},
 															"private_ip": {
@@ -1594,10 +1628,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 																ForceNew: true,
 															},
 															"subnet_id": {
-																Type:     schema.TypeString,
-																Optional: true,
-																Computed: true,
-																ForceNew: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ForceNew:     true,
+																ValidateFunc: tfresource.ValidateOcid("subnet"),
 															},
 
 															// Computed
@@ -2022,9 +2057,10 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 
 																		// Optional
 																		"compartment_id": {
-																			Type:     schema.TypeString,
-																			Optional: true,
-																			Computed: true,
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			Computed:     true,
+																			ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 																		},
 																		"defined_tags_filter": {
 																			Type:             schema.TypeMap,
@@ -2162,7 +2198,8 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 																ForceNew: true,
 																Set:      tfresource.LiteralTypeHashCodeForSets,
 																Elem: &schema.Schema{
-																	Type: schema.TypeString,
+																	Type:         schema.TypeString,
+																	ValidateFunc: tfresource.ValidateOcid("networksecuritygroup"),
 																},
 															},
 															"private_ip": {
@@ -2185,10 +2222,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 																ForceNew: true,
 															},
 															"subnet_id": {
-																Type:     schema.TypeString,
-																Optional: true,
-																Computed: true,
-																ForceNew: true,
+																Type:         schema.TypeString,
+																Optional:     true,
+																Computed:     true,
+																ForceNew:     true,
+																ValidateFunc: tfresource.ValidateOcid("subnet"),
 															},
 
 															// Computed
@@ -2317,7 +2355,8 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 													ForceNew: true,
 													Set:      tfresource.LiteralTypeHashCodeForSets,
 													Elem: &schema.Schema{
-														Type: schema.TypeString,
+														Type:         schema.TypeString,
+														ValidateFunc: tfresource.ValidateOcid("networksecuritygroup"),
 													},
 												},
 												"private_ip": {
@@ -2340,10 +2379,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 													ForceNew: true,
 												},
 												"subnet_id": {
-													Type:     schema.TypeString,
-													Optional: true,
-													Computed: true,
-													ForceNew: true,
+													Type:         schema.TypeString,
+													Optional:     true,
+													Computed:     true,
+													ForceNew:     true,
+													ValidateFunc: tfresource.ValidateOcid("subnet"),
 												},
 
 												// Computed
@@ -2373,10 +2413,11 @@ func CoreInstanceConfigurationResource() *schema.Resource {
 				},
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
 			},
 			"source": {
 				Type:             schema.TypeString,
@@ -2560,6 +2601,13 @@ func (s *CoreInstanceConfigurationResourceCrud) SetData() error {
 	if s.Res.InstanceDetails != nil {
 		instanceDetailsArray := []interface{}{}
 		if instanceDetailsMap := InstanceConfigurationInstanceDetailsToMap(&s.Res.InstanceDetails, false); instanceDetailsMap != nil {
+			// source and instance_id are only used on create and are not returned by the service
+			if source, ok := s.D.GetOk("instance_details.0.source"); ok {
+				instanceDetailsMap["source"] = source
+			}
+			if instanceId, ok := s.D.GetOk("instance_details.0.instance_id"); ok {
+				instanceDetailsMap["instance_id"] = instanceId
+			}
 			instanceDetailsArray = append(instanceDetailsArray, instanceDetailsMap)
 		}
 		s.D.Set("instance_details", instanceDetailsArray)
@@ -4784,6 +4832,8 @@ func (s *CoreInstanceConfigurationResourceCrud) populateTopLevelPolymorphicCreat
 	var source string
 	if ok {
 		source = sourceRaw.(string)
+	} else if sourceRaw, ok := s.D.GetOkExists("instance_details.0.source"); ok {
+		source = sourceRaw.(string)
 	} else {
 		source = "NONE" // default value
 	}
@@ -4793,6 +4843,9 @@ func (s *CoreInstanceConfigurationResourceCrud) populateTopLevelPolymorphicCreat
 		if instanceId, ok := s.D.GetOkExists("instance_id"); ok {
 			tmp := instanceId.(string)
 			details.InstanceId = &tmp
+		} else if instanceId, ok := s.D.GetOkExists("instance_details.0.instance_id"); ok {
+			tmp := instanceId.(string)
+			details.InstanceId = &tmp
 		}
 		if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
 			tmp := compartmentId.(string)
@@ -4850,6 +4903,12 @@ func (s *CoreInstanceConfigurationResourceCrud) populateTopLevelPolymorphicCreat
 	return nil
 }
 
+// instanceConfigurationIsNotFromInstance is true when neither the top level nor the instance_details source captures
+// the configuration from an existing instance
+func instanceConfigurationIsNotFromInstance(d *schema.ResourceDiff) bool {
+	return !tfresource.AttributeIn("source", "INSTANCE")(d) && !tfresource.AttributeIn("instance_details.0.source", "INSTANCE")(d)
+}
+
 func (s *CoreInstanceConfigurationResourceCrud) updateCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_core.ChangeInstanceConfigurationCompartmentRequest{}
 
@@ -5,6 +5,7 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,11 +23,12 @@ func CoreInstanceMaintenanceEventResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreInstanceMaintenanceEvent,
-		Read:     readCoreInstanceMaintenanceEvent,
-		Update:   updateCoreInstanceMaintenanceEvent,
-		Delete:   deleteCoreInstanceMaintenanceEvent,
+		Timeouts:      tfresource.DefaultTimeout,
+		Create:        createCoreInstanceMaintenanceEvent,
+		Read:          readCoreInstanceMaintenanceEvent,
+		Update:        updateCoreInstanceMaintenanceEvent,
+		Delete:        deleteCoreInstanceMaintenanceEvent,
+		CustomizeDiff: instanceMaintenanceEventCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_maintenance_event_id": {
@@ -194,18 +196,6 @@ func (s *CoreInstanceMaintenanceEventResourceCrud) ID() string {
 	return *s.Res.Id
 }
 
-func (s *CoreInstanceMaintenanceEventResourceCrud) CreatedPending() []string {
-	return []string{
-		string(oci_core.InstanceMaintenanceEventLifecycleStateScheduled),
-	}
-}
-
-func (s *CoreInstanceMaintenanceEventResourceCrud) CreatedTarget() []string {
-	return []string{
-		string(oci_core.InstanceMaintenanceEventLifecycleStateSucceeded),
-	}
-}
-
 func (s *CoreInstanceMaintenanceEventResourceCrud) DeletedPending() []string {
 	return []string{}
 }
@@ -248,12 +238,23 @@ func (s *CoreInstanceMaintenanceEventResourceCrud) Create() error {
 		request.InstanceMaintenanceEventId = &tmp
 	}
 
+	// The maintenance event already exists, it is adopted and only rescheduled if the configured window differs
+	s.D.SetId(*request.InstanceMaintenanceEventId)
+	if err := s.Get(); err != nil {
+		return err
+	}
+
 	if timeWindowStart, ok := s.D.GetOkExists("time_window_start"); ok {
 		tmp, err := time.Parse(time.RFC3339, timeWindowStart.(string))
 		if err != nil {
 			return err
 		}
-		request.TimeWindowStart = &oci_common.SDKTime{Time: tmp}
+		if s.Res.TimeWindowStart == nil || !s.Res.TimeWindowStart.Equal(tmp) {
+			if err := validateInstanceMaintenanceEventReschedule(s.Res, tmp); err != nil {
+				return err
+			}
+			request.TimeWindowStart = &oci_common.SDKTime{Time: tmp}
+		}
 	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
@@ -332,11 +333,17 @@ func (s *CoreInstanceMaintenanceEventResourceCrud) Update() error {
 	tmp := s.D.Id()
 	request.InstanceMaintenanceEventId = &tmp
 
-	if timeWindowStart, ok := s.D.GetOkExists("time_window_start"); ok {
+	if timeWindowStart, ok := s.D.GetOkExists("time_window_start"); ok && s.D.HasChange("time_window_start") {
 		tmp, err := time.Parse(time.RFC3339, timeWindowStart.(string))
 		if err != nil {
 			return err
 		}
+		if err := s.Get(); err != nil {
+			return err
+		}
+		if err := validateInstanceMaintenanceEventReschedule(s.Res, tmp); err != nil {
+			return err
+		}
 		request.TimeWindowStart = &oci_common.SDKTime{Time: tmp}
 	}
 
@@ -439,3 +446,47 @@ func (s *CoreInstanceMaintenanceEventResourceCrud) SetData() error {
 
 	return nil
 }
+
+// instanceMaintenanceEventCustomizeDiff rejects a new time window at plan time if the refreshed event shows that it
+// cannot be rescheduled to it, events that are adopted by Create are validated once they have been read
+func instanceMaintenanceEventCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("time_window_start") || !d.NewValueKnown("time_window_start") {
+		return nil
+	}
+	timeWindowStart, err := time.Parse(time.RFC3339, d.Get("time_window_start").(string))
+	if err != nil {
+		return fmt.Errorf("time_window_start must be an RFC3339 timestamp: %v", err)
+	}
+
+	event := &oci_core.InstanceMaintenanceEvent{
+		LifecycleState: oci_core.InstanceMaintenanceEventLifecycleStateEnum(d.Get("state").(string)),
+	}
+	if canReschedule, ok := d.GetOkExists("can_reschedule"); ok {
+		tmp := canReschedule.(bool)
+		event.CanReschedule = &tmp
+	}
+	if timeHardDueDate, ok := d.GetOk("time_hard_due_date"); ok {
+		if tmp, err := time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", timeHardDueDate.(string)); err == nil {
+			event.TimeHardDueDate = &oci_common.SDKTime{Time: tmp}
+		}
+	}
+	return validateInstanceMaintenanceEventReschedule(event, timeWindowStart)
+}
+
+// validateInstanceMaintenanceEventReschedule checks that the maintenance event can be moved to the time window, the
+// window has to start in the future and no later than the hard due date of the event
+func validateInstanceMaintenanceEventReschedule(event *oci_core.InstanceMaintenanceEvent, timeWindowStart time.Time) error {
+	if event.CanReschedule != nil && !*event.CanReschedule {
+		return fmt.Errorf("the instance maintenance event cannot be rescheduled, remove time_window_start from the configuration")
+	}
+	if event.LifecycleState != "" && event.LifecycleState != oci_core.InstanceMaintenanceEventLifecycleStateScheduled {
+		return fmt.Errorf("the instance maintenance event can only be rescheduled while it is %s, it is %s", oci_core.InstanceMaintenanceEventLifecycleStateScheduled, event.LifecycleState)
+	}
+	if !timeWindowStart.After(time.Now()) {
+		return fmt.Errorf("time_window_start %s must be in the future", timeWindowStart.Format(time.RFC3339))
+	}
+	if event.TimeHardDueDate != nil && timeWindowStart.After(event.TimeHardDueDate.Time) {
+		return fmt.Errorf("time_window_start %s must not be later than the hard due date of the maintenance event %s", timeWindowStart.Format(time.RFC3339), event.TimeHardDueDate.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -32,9 +32,10 @@ func CoreInstancePoolInstanceResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
 			},
 			"instance_pool_id": {
 				Type:     schema.TypeString,
@@ -5,6 +5,8 @@ package core
 
 import (
 	"context"
+	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
@@ -33,7 +35,7 @@ func CoreInstancePoolInstancesDataSource() *schema.Resource {
 			"instances": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem:     tfresource.GetDataSourceItemSchema(CoreInstancePoolInstanceResource()),
+				Elem:     coreInstancePoolInstancesItemSchema(),
 			},
 		},
 	}
@@ -43,14 +45,33 @@ func readCoreInstancePoolInstances(d *schema.ResourceData, m interface{}) error
 	sync := &CoreInstancePoolInstancesDataSourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ComputeManagementClient()
+	sync.ComputeClient = m.(*client.OracleClients).ComputeClient()
+	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
 
 	return tfresource.ReadResource(sync)
 }
 
+// coreInstancePoolInstancesItemSchema adds the addresses of the primary VNIC to the instance pool instance schema, so
+// that pool members can be consumed without looking up each instance
+func coreInstancePoolInstancesItemSchema() *schema.Resource {
+	item := tfresource.GetDataSourceItemSchema(CoreInstancePoolInstanceResource())
+	item.Schema["private_ip"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	item.Schema["public_ip"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	return item
+}
+
 type CoreInstancePoolInstancesDataSourceCrud struct {
-	D      *schema.ResourceData
-	Client *oci_core.ComputeManagementClient
-	Res    *oci_core.ListInstancePoolInstancesResponse
+	D                    *schema.ResourceData
+	Client               *oci_core.ComputeManagementClient
+	ComputeClient        *oci_core.ComputeClient
+	VirtualNetworkClient *oci_core.VirtualNetworkClient
+	Res                  *oci_core.ListInstancePoolInstancesResponse
 }
 
 func (s *CoreInstancePoolInstancesDataSourceCrud) VoidState() {
@@ -149,6 +170,21 @@ func (s *CoreInstancePoolInstancesDataSourceCrud) SetData() error {
 			instancePoolInstance["state"] = *r.State
 		}
 
+		if r.Id != nil && r.State != nil && strings.EqualFold(*r.State, string(oci_core.InstanceLifecycleStateRunning)) && s.ComputeClient != nil {
+			vnic, vnicError := getInstancePrimaryVnic(s.ComputeClient, s.VirtualNetworkClient, r.CompartmentId, r.Id, false)
+			if vnicError != nil || vnic == nil {
+				log.Printf("[WARN] Primary VNIC could not be found for instance pool instance %s: %q", *r.Id, vnicError)
+			} else {
+				if vnic.PrivateIp != nil {
+					instancePoolInstance["private_ip"] = *vnic.PrivateIp
+				}
+
+				if vnic.PublicIp != nil {
+					instancePoolInstance["public_ip"] = *vnic.PublicIp
+				}
+			}
+		}
+
 		if r.TimeCreated != nil {
 			instancePoolInstance["time_created"] = r.TimeCreated.String()
 		}
@@ -42,8 +42,9 @@ func CoreInstancePoolResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"instance_configuration_id": {
 				Type:     schema.TypeString,
@@ -86,8 +87,9 @@ func CoreInstancePoolResource() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									// Required
 									"subnet_id": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: tfresource.ValidateOcid("subnet"),
 									},
 
 									// Optional
@@ -128,8 +130,9 @@ func CoreInstancePoolResource() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									// Required
 									"subnet_id": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: tfresource.ValidateOcid("subnet"),
 									},
 
 									// Optional
@@ -49,8 +49,9 @@ func CoreInstanceResource() *schema.Resource {
 				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 
 			// Optional
@@ -95,6 +96,10 @@ func CoreInstanceResource() *schema.Resource {
 									"desired_state": {
 										Type:     schema.TypeString,
 										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(oci_core.InstanceAgentPluginConfigDetailsDesiredStateEnabled),
+											string(oci_core.InstanceAgentPluginConfigDetailsDesiredStateDisabled),
+										}, false),
 									},
 									"name": {
 										Type:     schema.TypeString,
@@ -249,7 +254,8 @@ func CoreInstanceResource() *schema.Resource {
 							Computed: true,
 							Set:      tfresource.LiteralTypeHashCodeForSets,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: tfresource.ValidateOcid("networksecuritygroup"),
 							},
 						},
 						"private_ip": {
@@ -271,10 +277,11 @@ func CoreInstanceResource() *schema.Resource {
 							Computed: true,
 						},
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: tfresource.ValidateOcid("subnet"),
 						},
 						"vlan_id": {
 							Type:     schema.TypeString,
@@ -505,9 +512,10 @@ func CoreInstanceResource() *schema.Resource {
 
 									// Optional
 									"compartment_id": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 									},
 									"display_name": {
 										Type:     schema.TypeString,
@@ -718,9 +726,10 @@ func CoreInstanceResource() *schema.Resource {
 
 						// Optional
 						"baseline_ocpu_utilization": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(oci_core.GetUpdateInstanceShapeConfigDetailsBaselineOcpuUtilizationEnumStringValues(), false),
 						},
 						"memory_in_gbs": {
 							Type:     schema.TypeFloat,
@@ -842,9 +851,10 @@ func CoreInstanceResource() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									// Required
 									"compartment_id": {
-										Type:     schema.TypeString,
-										Required: true,
-										ForceNew: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 									},
 
 									// Optional
@@ -881,17 +891,22 @@ func CoreInstanceResource() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"is_restart_on_boot_volume_resize_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 
 						// Computed
 					},
 				},
 			},
 			"subnet_id": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Computed:   true,
-				ForceNew:   true,
-				Deprecated: tfresource.FieldDeprecatedForAnother("subnet_id", "subnet_id under create_vnic_details"),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Deprecated:   tfresource.FieldDeprecatedForAnother("subnet_id", "subnet_id under create_vnic_details"),
+				ValidateFunc: tfresource.ValidateOcid("subnet"),
 			},
 			"is_cross_numa_node": {
 				Type:     schema.TypeBool,
@@ -968,6 +983,18 @@ func CoreInstanceResource() *schema.Resource {
 				oldConfig, newConfig := d.GetChange("platform_config.0.type")
 				return isPlatformConfigBm(oldConfig) || isPlatformConfigBm(newConfig)
 			}),
+			instanceCapacityReservationCustomizeDiff,
+			instancePlatformConfigCustomizeDiff,
+			// Cross attribute validations that would otherwise only fail at apply time with a service error
+			tfresource.RequiredWhen(
+				tfresource.AllOf(tfresource.IsCreateOrChange("shape"), tfresource.AttributeContains("shape", ".Flex")),
+				"a flexible shape is used", "shape_config.0"),
+			tfresource.ErrorWhen(
+				tfresource.AllOf(tfresource.IsCreateOrChange("is_pv_encryption_in_transit_enabled", "launch_options.0.boot_volume_type"), tfresource.AttributeIsTrue("is_pv_encryption_in_transit_enabled"), tfresource.AttributeNotIn("launch_options.0.boot_volume_type", string(oci_core.LaunchOptionsBootVolumeTypeParavirtualized))),
+				"is_pv_encryption_in_transit_enabled is only supported when launch_options.0.boot_volume_type is PARAVIRTUALIZED"),
+			tfresource.ErrorWhen(
+				tfresource.AllOf(tfresource.IsCreateOrChange("launch_options.0.is_pv_encryption_in_transit_enabled", "launch_options.0.boot_volume_type"), tfresource.AttributeIsTrue("launch_options.0.is_pv_encryption_in_transit_enabled"), tfresource.AttributeNotIn("launch_options.0.boot_volume_type", string(oci_core.LaunchOptionsBootVolumeTypeParavirtualized))),
+				"launch_options.0.is_pv_encryption_in_transit_enabled is only supported when launch_options.0.boot_volume_type is PARAVIRTUALIZED"),
 		),
 	}
 }
@@ -1428,6 +1455,13 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		return err
 	}
 
+	// Move the instance into/out of a capacity reservation or to another dedicated VM host
+	err = s.updatePlacement()
+
+	if err != nil {
+		return err
+	}
+
 	request := oci_core.UpdateInstanceRequest{}
 
 	if updateOperationConstraint, ok := s.D.GetOkExists("update_operation_constraint"); ok {
@@ -1455,14 +1489,6 @@ func (s *CoreInstanceResourceCrud) Update() error {
 		}
 	}
 
-	if capacityReservationId, ok := s.D.GetOkExists("capacity_reservation_id"); ok {
-		tmp := capacityReservationId.(string)
-		request.CapacityReservationId = &tmp
-	}
-	if dedicatedVmHostId, ok := s.D.GetOkExists("dedicated_vm_host_id"); ok {
-		tmp := dedicatedVmHostId.(string)
-		request.DedicatedVmHostId = &tmp
-	}
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
 		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
 		if err != nil {
@@ -3625,17 +3651,21 @@ func mapToExtendedMetadata(rm map[string]interface{}) (map[string]interface{}, e
 }
 
 func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
+	return getInstancePrimaryVnic(s.Client, s.VirtualNetworkClient, s.Res.CompartmentId, s.Res.Id, s.DisableNotFoundRetries)
+}
+
+func getInstancePrimaryVnic(computeClient *oci_core.ComputeClient, virtualNetworkClient *oci_core.VirtualNetworkClient, compartmentId *string, instanceId *string, disableNotFoundRetries bool) (*oci_core.Vnic, error) {
 	request := oci_core.ListVnicAttachmentsRequest{
-		CompartmentId: s.Res.CompartmentId,
-		InstanceId:    s.Res.Id,
+		CompartmentId: compartmentId,
+		InstanceId:    instanceId,
 		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core"),
+			RetryPolicy: tfresource.GetRetryPolicy(disableNotFoundRetries, "core"),
 		},
 	}
 	var attachments []oci_core.VnicAttachment
 
 	for {
-		result, err := s.Client.ListVnicAttachments(context.Background(), request)
+		result, err := computeClient.ListVnicAttachments(context.Background(), request)
 		if err != nil {
 			return nil, err
 		}
@@ -3660,7 +3690,7 @@ func (s *CoreInstanceResourceCrud) getPrimaryVnic() (*oci_core.Vnic, error) {
 					RetryPolicy: tfresource.GetRetryPolicy(true, "core"),
 				},
 			}
-			response, _ := s.VirtualNetworkClient.GetVnic(context.Background(), request)
+			response, _ := virtualNetworkClient.GetVnic(context.Background(), request)
 			vnic := &response.Vnic
 
 			// Ignore errors on GetVnic, since we might not have permissions to view some secondary VNICs.
@@ -3808,6 +3838,9 @@ func (s *CoreInstanceResourceCrud) mapToUpdateInstanceBootVolumeSizeInGbs(fieldK
 		if err != nil {
 			return fmt.Errorf("unable to convert bootVolumeSizeInGBs string: %s to an int64 and encountered error: %v", tmp, err)
 		}
+		if restart, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "is_restart_on_boot_volume_resize_enabled")); ok && restart.(bool) {
+			return s.updateBootVolumeSizeInGbsWithRestart(tmpInt64)
+		}
 		err = s.updateBootVolumeSizeInGbs(tmpInt64)
 		if err != nil {
 			return err
@@ -3877,6 +3910,41 @@ func (s *CoreInstanceResourceCrud) updateBootVolumeSizeInGbs(bootVolumeSizeInGBs
 	return nil
 }
 
+// updateBootVolumeSizeInGbsWithRestart gracefully stops a running instance, resizes its boot volume and starts the
+// instance again. Boot volumes are resized online, the restart is only needed for the guest OS to pick up the new size
+// without a manual rescan. The instance is started again even if the resize failed, unless it is meant to be stopped.
+func (s *CoreInstanceResourceCrud) updateBootVolumeSizeInGbsWithRestart(bootVolumeSizeInGBs int64) error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleState != oci_core.InstanceLifecycleStateRunning {
+		return s.updateBootVolumeSizeInGbs(bootVolumeSizeInGBs)
+	}
+
+	log.Printf("[DEBUG] stopping instance %s to resize its boot volume to %d GBs", s.D.Id(), bootVolumeSizeInGBs)
+	if err := s.InstanceAction(oci_core.InstanceActionActionSoftstop, oci_core.InstanceLifecycleStateStopped); err != nil {
+		return fmt.Errorf("failed to stop instance %s before resizing its boot volume: %v", s.D.Id(), err)
+	}
+
+	log.Printf("[DEBUG] resizing the boot volume of instance %s to %d GBs", s.D.Id(), bootVolumeSizeInGBs)
+	resizeErr := s.updateBootVolumeSizeInGbs(bootVolumeSizeInGBs)
+
+	if state, ok := s.D.GetOkExists("state"); ok && strings.EqualFold(state.(string), string(oci_core.InstanceLifecycleStateStopped)) {
+		return resizeErr
+	}
+
+	log.Printf("[DEBUG] starting instance %s after resizing its boot volume", s.D.Id())
+	if err := s.InstanceAction(oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning); err != nil {
+		if resizeErr != nil {
+			return fmt.Errorf("%v, and failed to start instance %s again: %v", resizeErr, s.D.Id(), err)
+		}
+		return fmt.Errorf("failed to start instance %s after resizing its boot volume: %v", s.D.Id(), err)
+	}
+
+	return resizeErr
+}
+
 func waitForBootVolumeIfItIsUpdating(bootVolumeID *string, client *oci_core.BlockstorageClient, timeout time.Duration) (*oci_core.GetBootVolumeResponse, error) {
 	getBootVolumeRequest := oci_core.GetBootVolumeRequest{}
 
@@ -3995,6 +4063,106 @@ func (s *CoreInstanceResourceCrud) updateOptionsViaWorkRequest() error {
 	return nil
 }
 
+// updatePlacement moves the instance into/out of a capacity reservation (an empty capacity_reservation_id removes it
+// from its reservation) or to another dedicated VM host. Moving to another dedicated VM host requires the instance to
+// be stopped, as does any placement change the service rejects with a conflict while the instance is running. In
+// those cases the instance is stopped, updated and then started again unless the configured state is STOPPED.
+func (s *CoreInstanceResourceCrud) updatePlacement() error {
+	if !s.D.HasChange("capacity_reservation_id") && !s.D.HasChange("dedicated_vm_host_id") {
+		return nil
+	}
+
+	request := oci_core.UpdateInstanceRequest{}
+
+	if s.D.HasChange("capacity_reservation_id") {
+		tmp := s.D.Get("capacity_reservation_id").(string)
+		request.CapacityReservationId = &tmp
+	}
+
+	requiresStop := false
+	if s.D.HasChange("dedicated_vm_host_id") {
+		oldRaw, newRaw := s.D.GetChange("dedicated_vm_host_id")
+		if oldRaw.(string) != "" && newRaw.(string) != "" {
+			tmp := newRaw.(string)
+			request.DedicatedVmHostId = &tmp
+			requiresStop = true
+		} else if newRaw.(string) != "" {
+			return fmt.Errorf("instance %s cannot be moved from on-demand capacity to dedicated VM host %s, the instance must be recreated", s.D.Id(), newRaw)
+		} else if oldRaw.(string) != "" && request.CapacityReservationId == nil {
+			// dedicated_vm_host_id removed from the config, the instance stays on its current host
+			return nil
+		}
+	}
+
+	if request.CapacityReservationId == nil && request.DedicatedVmHostId == nil {
+		return nil
+	}
+
+	idTmp := s.D.Id()
+	request.InstanceId = &idTmp
+
+	if updateOperationConstraint, ok := s.D.GetOkExists("update_operation_constraint"); ok {
+		request.UpdateOperationConstraint = oci_core.UpdateInstanceDetailsUpdateOperationConstraintEnum(updateOperationConstraint.(string))
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	if err := s.Get(); err != nil {
+		return err
+	}
+	wasRunning := s.Res.LifecycleState == oci_core.InstanceLifecycleStateRunning
+
+	if !requiresStop || !wasRunning {
+		_, err := s.Client.UpdateInstance(context.Background(), request)
+		if err == nil {
+			return s.waitForPlacementUpdate()
+		}
+		if failure, ok := common.IsServiceError(err); !ok || failure.GetHTTPStatusCode() != 409 || !wasRunning {
+			return err
+		}
+		log.Printf("[DEBUG] placement of instance %s cannot be changed while it is running, stopping it: %v", s.D.Id(), err)
+	}
+
+	if err := s.InstanceAction(oci_core.InstanceActionActionStop, oci_core.InstanceLifecycleStateStopped); err != nil {
+		return err
+	}
+
+	if _, err := s.Client.UpdateInstance(context.Background(), request); err != nil {
+		return err
+	}
+	if err := s.waitForPlacementUpdate(); err != nil {
+		return err
+	}
+
+	if wantedState, ok := s.D.GetOkExists("state"); ok && strings.EqualFold(wantedState.(string), string(oci_core.InstanceLifecycleStateStopped)) {
+		return nil
+	}
+	return s.InstanceAction(oci_core.InstanceActionActionStart, oci_core.InstanceLifecycleStateRunning)
+}
+
+// waitForPlacementUpdate waits for the instance to report the configured capacity reservation and dedicated VM host
+func (s *CoreInstanceResourceCrud) waitForPlacementUpdate() error {
+	placementUpdatedFunc := func() bool {
+		if s.Res == nil || s.Res.LifecycleState == oci_core.InstanceLifecycleStateMoving {
+			return false
+		}
+		if s.D.HasChange("capacity_reservation_id") {
+			capacityReservationId := ""
+			if s.Res.CapacityReservationId != nil {
+				capacityReservationId = *s.Res.CapacityReservationId
+			}
+			if capacityReservationId != s.D.Get("capacity_reservation_id").(string) {
+				return false
+			}
+		}
+		if dedicatedVmHostId := s.D.Get("dedicated_vm_host_id").(string); s.D.HasChange("dedicated_vm_host_id") && dedicatedVmHostId != "" {
+			return s.Res.DedicatedVmHostId != nil && *s.Res.DedicatedVmHostId == dedicatedVmHostId
+		}
+		return true
+	}
+	return tfresource.WaitForResourceCondition(s, placementUpdatedFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
 func (s *CoreInstanceResourceCrud) mapToUpdateInstanceAvailabilityConfigDetails(fieldKeyFormat string) (oci_core.UpdateInstanceAvailabilityConfigDetails, error) {
 	result := oci_core.UpdateInstanceAvailabilityConfigDetails{}
 
@@ -4011,6 +4179,114 @@ func (s *CoreInstanceResourceCrud) mapToUpdateInstanceAvailabilityConfigDetails(
 	return result, nil
 }
 
+// instanceCapacityReservationCustomizeDiff plans the removal of the instance from its capacity reservation when
+// capacity_reservation_id is set to an empty string, the attribute is computed so an empty value would otherwise keep
+// the current reservation without a diff
+func instanceCapacityReservationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	config := d.GetRawConfig()
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+	capacityReservationId := config.GetAttr("capacity_reservation_id")
+	if !capacityReservationId.IsKnown() || capacityReservationId.IsNull() || capacityReservationId.AsString() != "" {
+		return nil
+	}
+	if oldCapacityReservationId, _ := d.GetChange("capacity_reservation_id"); oldCapacityReservationId.(string) == "" {
+		return nil
+	}
+	return d.SetNew("capacity_reservation_id", "")
+}
+
+var (
+	vmPlatformConfigAttributes = []string{
+		"is_measured_boot_enabled",
+		"is_memory_encryption_enabled",
+		"is_secure_boot_enabled",
+		"is_symmetric_multi_threading_enabled",
+		"is_trusted_platform_module_enabled",
+	}
+	intelBmPlatformConfigAttributes = append([]string{
+		"config_map",
+		"is_input_output_memory_management_unit_enabled",
+		"numa_nodes_per_socket",
+		"percentage_of_cores_enabled",
+	}, vmPlatformConfigAttributes...)
+	amdBmGpuPlatformConfigAttributes = append([]string{
+		"are_virtual_instructions_enabled",
+		"config_map",
+		"is_access_control_service_enabled",
+		"is_input_output_memory_management_unit_enabled",
+		"numa_nodes_per_socket",
+	}, vmPlatformConfigAttributes...)
+	amdBmPlatformConfigAttributes = append([]string{"percentage_of_cores_enabled"}, amdBmGpuPlatformConfigAttributes...)
+
+	// platformConfigAttributes lists the platform_config attributes that apply to each platform type
+	platformConfigAttributes = map[string][]string{
+		"AMD_MILAN_BM":     amdBmPlatformConfigAttributes,
+		"AMD_MILAN_BM_GPU": amdBmGpuPlatformConfigAttributes,
+		"AMD_ROME_BM":      amdBmPlatformConfigAttributes,
+		"AMD_ROME_BM_GPU":  amdBmGpuPlatformConfigAttributes,
+		"AMD_VM":           vmPlatformConfigAttributes,
+		"GENERIC_BM":       amdBmPlatformConfigAttributes,
+		"INTEL_ICELAKE_BM": intelBmPlatformConfigAttributes,
+		"INTEL_SKYLAKE_BM": intelBmPlatformConfigAttributes,
+		"INTEL_VM":         vmPlatformConfigAttributes,
+	}
+
+	// platformConfigNumaNodesPerSocket lists the NUMA settings supported by each bare metal platform type
+	platformConfigNumaNodesPerSocket = map[string][]string{
+		"AMD_MILAN_BM":     oci_core.GetAmdMilanBmLaunchInstancePlatformConfigNumaNodesPerSocketEnumStringValues(),
+		"AMD_MILAN_BM_GPU": oci_core.GetAmdMilanBmGpuLaunchInstancePlatformConfigNumaNodesPerSocketEnumStringValues(),
+		"AMD_ROME_BM":      oci_core.GetAmdRomeBmLaunchInstancePlatformConfigNumaNodesPerSocketEnumStringValues(),
+		"AMD_ROME_BM_GPU":  oci_core.GetAmdRomeBmGpuLaunchInstancePlatformConfigNumaNodesPerSocketEnumStringValues(),
+		"GENERIC_BM":       oci_core.GetGenericBmLaunchInstancePlatformConfigNumaNodesPerSocketEnumStringValues(),
+		"INTEL_ICELAKE_BM": oci_core.GetIntelIcelakeBmLaunchInstancePlatformConfigNumaNodesPerSocketEnumStringValues(),
+		"INTEL_SKYLAKE_BM": oci_core.GetIntelSkylakeBmLaunchInstancePlatformConfigNumaNodesPerSocketEnumStringValues(),
+	}
+)
+
+// instancePlatformConfigCustomizeDiff rejects platform_config attributes that do not apply to the platform type, they
+// would otherwise be silently dropped from the launch request and show up as a perpetual diff
+func instancePlatformConfigCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("platform_config.0.type") {
+		return nil
+	}
+	platformType, _ := d.Get("platform_config.0.type").(string)
+	platformType = strings.ToUpper(platformType)
+	supportedAttributes, ok := platformConfigAttributes[platformType]
+	if !ok {
+		return nil
+	}
+
+	// the bare metal AMD attributes are a superset of the attributes of every other platform type
+	for _, attribute := range amdBmPlatformConfigAttributes {
+		if tfresource.IsAttributeConfigured(d, "platform_config.0."+attribute) && !containsString(supportedAttributes, attribute) {
+			return fmt.Errorf("platform_config.0.%s is not supported when platform_config.0.type is %s", attribute, platformType)
+		}
+	}
+
+	if !d.NewValueKnown("platform_config.0.numa_nodes_per_socket") {
+		return nil
+	}
+	numaNodesPerSocket, _ := d.Get("platform_config.0.numa_nodes_per_socket").(string)
+	if supportedValues := platformConfigNumaNodesPerSocket[platformType]; numaNodesPerSocket != "" && tfresource.IsAttributeConfigured(d, "platform_config.0.numa_nodes_per_socket") && !containsString(supportedValues, numaNodesPerSocket) {
+		return fmt.Errorf("platform_config.0.numa_nodes_per_socket must be one of %s when platform_config.0.type is %s, got %s", strings.Join(supportedValues, ", "), platformType, numaNodesPerSocket)
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 func isPlatformConfigBm(platformConfig interface{}) bool {
 	platformConfigType := platformConfig.(string)
 	return platformConfigType != "" && platformConfigType != "INTEL_VM" && platformConfigType != "AMD_VM"
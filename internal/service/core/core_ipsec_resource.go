@@ -29,8 +29,9 @@ func CoreIpSecConnectionResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"cpe_id": {
 				Type:     schema.TypeString,
@@ -38,9 +39,10 @@ func CoreIpSecConnectionResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"drg_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("drg"),
 			},
 			"static_routes": {
 				Type:     schema.TypeList,
@@ -101,9 +103,10 @@ func CoreIpSecConnectionResource() *schema.Resource {
 							},
 						},
 						"drg_route_table_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: tfresource.ValidateOcid("drgroutetable"),
 						},
 					},
 				},
@@ -27,8 +27,9 @@ func CoreIpv6Resource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"vnic_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("vnic"),
 			},
 
 			// Optional
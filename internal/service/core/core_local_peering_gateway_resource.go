@@ -31,13 +31,15 @@ func CoreLocalPeeringGatewayResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("vcn"),
 			},
 
 			// Optional
@@ -60,9 +62,10 @@ func CoreLocalPeeringGatewayResource() *schema.Resource {
 				Elem:     schema.TypeString,
 			},
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("routetable"),
 			},
 			// @CODEGEN we use peer_id to do the connect action
 			"peer_id": {
@@ -0,0 +1,679 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// The Add and Remove security rule APIs accept at most 25 rules per request
+const networkSecurityGroupSecurityRulesBatchSize = 25
+
+func CoreNetworkSecurityGroupSecurityRulesResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createCoreNetworkSecurityGroupSecurityRulesResource,
+		Read:     readCoreNetworkSecurityGroupSecurityRulesResource,
+		Update:   updateCoreNetworkSecurityGroupSecurityRulesResource,
+		Delete:   deleteCoreNetworkSecurityGroupSecurityRulesResource,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"network_security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"security_rules": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      networkSecurityGroupSecurityRulesHashCodeForSets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"direction": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_core.SecurityRuleDirectionEgress),
+								string(oci_core.SecurityRuleDirectionIngress),
+							}, false),
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"icmp_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+									"type": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+
+									// Optional
+									"code": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  -1,
+									},
+
+									// Computed
+								},
+							},
+						},
+						"source": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"stateless": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"tcp_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+
+									// Optional
+									"destination_port_range": networkSecurityGroupPortRangeSchema(),
+									"source_port_range":      networkSecurityGroupPortRangeSchema(),
+
+									// Computed
+								},
+							},
+						},
+						"udp_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+
+									// Optional
+									"destination_port_range": networkSecurityGroupPortRangeSchema(),
+									"source_port_range":      networkSecurityGroupPortRangeSchema(),
+
+									// Computed
+								},
+							},
+						},
+
+						// Computed
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_valid": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"time_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func networkSecurityGroupPortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Required
+				"max": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+				"min": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+
+				// Optional
+
+				// Computed
+			},
+		},
+	}
+}
+
+func createCoreNetworkSecurityGroupSecurityRulesResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreNetworkSecurityGroupSecurityRulesResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.CreateResource(d, sync)
+}
+
+func readCoreNetworkSecurityGroupSecurityRulesResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreNetworkSecurityGroupSecurityRulesResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.ReadResource(sync)
+}
+
+func updateCoreNetworkSecurityGroupSecurityRulesResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreNetworkSecurityGroupSecurityRulesResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteCoreNetworkSecurityGroupSecurityRulesResource(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreNetworkSecurityGroupSecurityRulesResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	return tfresource.DeleteResource(d, sync)
+}
+
+type CoreNetworkSecurityGroupSecurityRulesResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    []oci_core.SecurityRule
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) ID() string {
+	return s.networkSecurityGroupId()
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) networkSecurityGroupId() string {
+	if networkSecurityGroupId, ok := s.D.GetOkExists("network_security_group_id"); ok {
+		return networkSecurityGroupId.(string)
+	}
+	return s.D.Id()
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) Create() error {
+	return s.reconcile()
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) Get() error {
+	rules, err := s.listSecurityRules()
+	if err != nil {
+		return err
+	}
+
+	s.Res = rules
+	return nil
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) Update() error {
+	return s.reconcile()
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) Delete() error {
+	rules, err := s.listSecurityRules()
+	if err != nil {
+		return err
+	}
+
+	ruleIds := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ruleIds = append(ruleIds, *rule.Id)
+	}
+
+	return s.removeSecurityRules(ruleIds)
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) SetData() error {
+	s.D.Set("network_security_group_id", s.networkSecurityGroupId())
+
+	securityRules := []interface{}{}
+	for _, item := range s.Res {
+		securityRules = append(securityRules, nsgSecurityRuleToMap(item))
+	}
+	s.D.Set("security_rules", schema.NewSet(networkSecurityGroupSecurityRulesHashCodeForSets, securityRules))
+
+	return nil
+}
+
+// reconcile makes the rules of the network security group match the configured rule set. Rules are matched by their
+// content, rules that are already present are left untouched, missing rules are added before the rules that are no
+// longer configured are removed so that traffic allowed by both the old and new rule sets is never interrupted.
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) reconcile() error {
+	existingRules, err := s.listSecurityRules()
+	if err != nil {
+		return err
+	}
+
+	existingRuleIds := map[int][]string{}
+	for _, rule := range existingRules {
+		hash := networkSecurityGroupSecurityRulesHashCodeForSets(nsgSecurityRuleToMap(rule))
+		existingRuleIds[hash] = append(existingRuleIds[hash], *rule.Id)
+	}
+
+	var rulesToAdd []oci_core.AddSecurityRuleDetails
+	if securityRules, ok := s.D.GetOkExists("security_rules"); ok {
+		for _, item := range securityRules.(*schema.Set).List() {
+			hash := networkSecurityGroupSecurityRulesHashCodeForSets(item)
+			if ids := existingRuleIds[hash]; len(ids) > 0 {
+				existingRuleIds[hash] = ids[1:]
+				continue
+			}
+
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "security_rules", hash)
+			converted, err := s.mapToAddSecurityRuleDetails(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			rulesToAdd = append(rulesToAdd, converted)
+		}
+	}
+
+	var ruleIdsToRemove []string
+	for _, ids := range existingRuleIds {
+		ruleIdsToRemove = append(ruleIdsToRemove, ids...)
+	}
+
+	if err := s.addSecurityRules(rulesToAdd); err != nil {
+		return err
+	}
+	if err := s.removeSecurityRules(ruleIdsToRemove); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) listSecurityRules() ([]oci_core.SecurityRule, error) {
+	request := oci_core.ListNetworkSecurityGroupSecurityRulesRequest{}
+
+	networkSecurityGroupId := s.networkSecurityGroupId()
+	request.NetworkSecurityGroupId = &networkSecurityGroupId
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+	rules := response.Items
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListNetworkSecurityGroupSecurityRules(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return rules, nil
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) addSecurityRules(rules []oci_core.AddSecurityRuleDetails) error {
+	networkSecurityGroupId := s.networkSecurityGroupId()
+
+	for start := 0; start < len(rules); start += networkSecurityGroupSecurityRulesBatchSize {
+		end := start + networkSecurityGroupSecurityRulesBatchSize
+		if end > len(rules) {
+			end = len(rules)
+		}
+
+		request := oci_core.AddNetworkSecurityGroupSecurityRulesRequest{}
+		request.NetworkSecurityGroupId = &networkSecurityGroupId
+		request.SecurityRules = rules[start:end]
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		if _, err := s.Client.AddNetworkSecurityGroupSecurityRules(context.Background(), request); err != nil {
+			return fmt.Errorf("failed to add security rules, error: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) removeSecurityRules(ruleIds []string) error {
+	networkSecurityGroupId := s.networkSecurityGroupId()
+
+	for start := 0; start < len(ruleIds); start += networkSecurityGroupSecurityRulesBatchSize {
+		end := start + networkSecurityGroupSecurityRulesBatchSize
+		if end > len(ruleIds) {
+			end = len(ruleIds)
+		}
+
+		request := oci_core.RemoveNetworkSecurityGroupSecurityRulesRequest{}
+		request.NetworkSecurityGroupId = &networkSecurityGroupId
+		request.SecurityRuleIds = ruleIds[start:end]
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		if _, err := s.Client.RemoveNetworkSecurityGroupSecurityRules(context.Background(), request); err != nil {
+			return fmt.Errorf("failed to remove security rules, error: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) mapToAddSecurityRuleDetails(fieldKeyFormat string) (oci_core.AddSecurityRuleDetails, error) {
+	result := oci_core.AddSecurityRuleDetails{}
+
+	if description, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "description")); ok && description != "" {
+		tmp := description.(string)
+		result.Description = &tmp
+	}
+
+	if destination, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "destination")); ok && destination != "" {
+		tmp := destination.(string)
+		result.Destination = &tmp
+	}
+
+	if destinationType, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "destination_type")); ok && destinationType != "" {
+		result.DestinationType = oci_core.AddSecurityRuleDetailsDestinationTypeEnum(destinationType.(string))
+	}
+
+	if direction, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "direction")); ok {
+		result.Direction = oci_core.AddSecurityRuleDetailsDirectionEnum(direction.(string))
+	}
+
+	if icmpOptions, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "icmp_options")); ok {
+		if tmpList := icmpOptions.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "icmp_options"), 0)
+			tmp, err := s.mapToIcmpOptions(fieldKeyFormatNextLevel)
+			if err != nil {
+				return result, fmt.Errorf("unable to convert icmp_options, encountered error: %v", err)
+			}
+			result.IcmpOptions = &tmp
+		}
+	}
+
+	if protocol, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "protocol")); ok {
+		tmp := protocol.(string)
+		result.Protocol = &tmp
+	}
+
+	if source, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "source")); ok && source != "" {
+		tmp := source.(string)
+		result.Source = &tmp
+	}
+
+	if sourceType, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "source_type")); ok && sourceType != "" {
+		result.SourceType = oci_core.AddSecurityRuleDetailsSourceTypeEnum(sourceType.(string))
+	}
+
+	if stateless, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "stateless")); ok {
+		tmp := stateless.(bool)
+		result.IsStateless = &tmp
+	}
+
+	if tcpOptions, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "tcp_options")); ok {
+		if tmpList := tcpOptions.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "tcp_options"), 0)
+			destinationPortRange, sourcePortRange, err := s.mapToPortRanges(fieldKeyFormatNextLevel)
+			if err != nil {
+				return result, fmt.Errorf("unable to convert tcp_options, encountered error: %v", err)
+			}
+			result.TcpOptions = &oci_core.TcpOptions{DestinationPortRange: destinationPortRange, SourcePortRange: sourcePortRange}
+		}
+	}
+
+	if udpOptions, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "udp_options")); ok {
+		if tmpList := udpOptions.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "udp_options"), 0)
+			destinationPortRange, sourcePortRange, err := s.mapToPortRanges(fieldKeyFormatNextLevel)
+			if err != nil {
+				return result, fmt.Errorf("unable to convert udp_options, encountered error: %v", err)
+			}
+			result.UdpOptions = &oci_core.UdpOptions{DestinationPortRange: destinationPortRange, SourcePortRange: sourcePortRange}
+		}
+	}
+
+	return result, nil
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) mapToIcmpOptions(fieldKeyFormat string) (oci_core.IcmpOptions, error) {
+	result := oci_core.IcmpOptions{}
+
+	if code, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "code")); ok {
+		tmp := code.(int)
+		if tmp > -1 {
+			result.Code = &tmp
+		}
+	}
+
+	if type_, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "type")); ok {
+		tmp := type_.(int)
+		result.Type = &tmp
+	}
+
+	return result, nil
+}
+
+// mapToPortRanges converts the destination and source port ranges shared by tcp_options and udp_options
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) mapToPortRanges(fieldKeyFormat string) (*oci_core.PortRange, *oci_core.PortRange, error) {
+	var destinationPortRange, sourcePortRange *oci_core.PortRange
+
+	if portRange, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "destination_port_range")); ok {
+		if tmpList := portRange.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "destination_port_range"), 0)
+			tmp, err := s.mapToPortRange(fieldKeyFormatNextLevel)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to convert destination_port_range, encountered error: %v", err)
+			}
+			destinationPortRange = &tmp
+		}
+	}
+
+	if portRange, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "source_port_range")); ok {
+		if tmpList := portRange.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormatNextLevel := fmt.Sprintf("%s.%d.%%s", fmt.Sprintf(fieldKeyFormat, "source_port_range"), 0)
+			tmp, err := s.mapToPortRange(fieldKeyFormatNextLevel)
+			if err != nil {
+				return nil, nil, fmt.Errorf("unable to convert source_port_range, encountered error: %v", err)
+			}
+			sourcePortRange = &tmp
+		}
+	}
+
+	return destinationPortRange, sourcePortRange, nil
+}
+
+func (s *CoreNetworkSecurityGroupSecurityRulesResourceCrud) mapToPortRange(fieldKeyFormat string) (oci_core.PortRange, error) {
+	result := oci_core.PortRange{}
+
+	if max, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "max")); ok {
+		tmp := max.(int)
+		result.Max = &tmp
+	}
+
+	if min, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "min")); ok {
+		tmp := min.(int)
+		result.Min = &tmp
+	}
+
+	return result, nil
+}
+
+func nsgSecurityRuleToMap(obj oci_core.SecurityRule) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.Description != nil {
+		result["description"] = string(*obj.Description)
+	}
+
+	if obj.Destination != nil {
+		result["destination"] = string(*obj.Destination)
+	}
+
+	result["destination_type"] = string(obj.DestinationType)
+
+	result["direction"] = string(obj.Direction)
+
+	if obj.IcmpOptions != nil {
+		result["icmp_options"] = []interface{}{nsgIcmpOptionsToMap(obj.IcmpOptions)}
+	}
+
+	if obj.Id != nil {
+		result["id"] = string(*obj.Id)
+	}
+
+	if obj.IsValid != nil {
+		result["is_valid"] = bool(*obj.IsValid)
+	}
+
+	if obj.Protocol != nil {
+		result["protocol"] = string(*obj.Protocol)
+	}
+
+	if obj.Source != nil {
+		result["source"] = string(*obj.Source)
+	}
+
+	result["source_type"] = string(obj.SourceType)
+
+	if obj.IsStateless != nil {
+		result["stateless"] = bool(*obj.IsStateless)
+	} else {
+		result["stateless"] = false
+	}
+
+	if obj.TcpOptions != nil {
+		result["tcp_options"] = []interface{}{nsgTcpOptionsToMap(obj.TcpOptions)}
+	}
+
+	if obj.TimeCreated != nil {
+		result["time_created"] = obj.TimeCreated.String()
+	}
+
+	if obj.UdpOptions != nil {
+		result["udp_options"] = []interface{}{nsgUdpOptionsToMap(obj.UdpOptions)}
+	}
+
+	return result
+}
+
+// networkSecurityGroupSecurityRulesHashCodeForSets identifies a rule by its content. Only the source of ingress rules
+// and the destination of egress rules are part of the hash, and unset types default to CIDR_BLOCK the way the service
+// does, so a configured rule and the rule read back from the service hash to the same value.
+func networkSecurityGroupSecurityRulesHashCodeForSets(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	direction, _ := m["direction"].(string)
+	buf.WriteString(fmt.Sprintf("%v-", direction))
+
+	if protocol, ok := m["protocol"]; ok && protocol != "" {
+		buf.WriteString(fmt.Sprintf("%v-", protocol))
+	}
+	if description, ok := m["description"]; ok && description != "" {
+		buf.WriteString(fmt.Sprintf("%v-", description))
+	}
+	if strings.EqualFold(direction, string(oci_core.SecurityRuleDirectionIngress)) {
+		if source, ok := m["source"]; ok && source != "" {
+			buf.WriteString(fmt.Sprintf("%v-", source))
+		}
+		if sourceType, ok := m["source_type"]; ok && sourceType != "" {
+			buf.WriteString(fmt.Sprintf("%v-", sourceType))
+		} else {
+			buf.WriteString(fmt.Sprintf("%v-", oci_core.SecurityRuleSourceTypeCidrBlock))
+		}
+	} else {
+		if destination, ok := m["destination"]; ok && destination != "" {
+			buf.WriteString(fmt.Sprintf("%v-", destination))
+		}
+		if destinationType, ok := m["destination_type"]; ok && destinationType != "" {
+			buf.WriteString(fmt.Sprintf("%v-", destinationType))
+		} else {
+			buf.WriteString(fmt.Sprintf("%v-", oci_core.SecurityRuleDestinationTypeCidrBlock))
+		}
+	}
+	if stateless, ok := m["stateless"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", stateless))
+	} else {
+		buf.WriteString(fmt.Sprintf("%v-", "false"))
+	}
+	if icmpOptions, ok := m["icmp_options"]; ok {
+		if tmpList := icmpOptions.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+			buf.WriteString("icmp_options-")
+			icmpOptionsRaw := tmpList[0].(map[string]interface{})
+			if code, ok := icmpOptionsRaw["code"]; ok {
+				buf.WriteString(fmt.Sprintf("%v-", code))
+			}
+			if type_, ok := icmpOptionsRaw["type"]; ok {
+				buf.WriteString(fmt.Sprintf("%v-", type_))
+			}
+		}
+	}
+	for _, options := range []string{"tcp_options", "udp_options"} {
+		if rawOptions, ok := m[options]; ok {
+			if tmpList := rawOptions.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+				buf.WriteString(fmt.Sprintf("%s-", options))
+				optionsRaw := tmpList[0].(map[string]interface{})
+				for _, portRange := range []string{"destination_port_range", "source_port_range"} {
+					if rawPortRange, ok := optionsRaw[portRange]; ok {
+						if tmpList := rawPortRange.([]interface{}); len(tmpList) > 0 && tmpList[0] != nil {
+							buf.WriteString(fmt.Sprintf("%s-", portRange))
+							portRangeRaw := tmpList[0].(map[string]interface{})
+							if max, ok := portRangeRaw["max"]; ok {
+								buf.WriteString(fmt.Sprintf("%v-", max))
+							}
+							if min, ok := portRangeRaw["min"]; ok {
+								buf.WriteString(fmt.Sprintf("%v-", min))
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return utils.GetStringHashcode(buf.String())
+}
@@ -65,9 +65,10 @@ func CorePrivateIpResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"vnic_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("vnic"),
 			},
 
 			// Computed
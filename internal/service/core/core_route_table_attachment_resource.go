@@ -28,14 +28,16 @@ func CoreRouteTableAttachmentResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("subnet"),
 			},
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("routetable"),
 			},
 		},
 	}
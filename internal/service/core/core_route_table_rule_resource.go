@@ -0,0 +1,348 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// routeTableRuleUpdateAttempts bounds how often the rules of a route table are read and updated again when another
+// client modified the route table in between
+const routeTableRuleUpdateAttempts = 10
+
+func CoreRouteTableRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createCoreRouteTableRule,
+		Read:     readCoreRouteTableRule,
+		Update:   updateCoreRouteTableRule,
+		Delete:   deleteCoreRouteTableRule,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("routetable"),
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_entity_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			// Optional
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"destination_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.RouteRuleDestinationTypeCidrBlock),
+					string(oci_core.RouteRuleDestinationTypeServiceCidrBlock),
+				}, false),
+			},
+
+			// Computed
+			"route_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createCoreRouteTableRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreRouteTableRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readCoreRouteTableRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreRouteTableRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateCoreRouteTableRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreRouteTableRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteCoreRouteTableRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreRouteTableRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type CoreRouteTableRuleResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    *oci_core.RouteRule
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreRouteTableRuleResourceCrud) ID() string {
+	return GetRouteTableRuleCompositeId(s.D.Get("route_table_id").(string), string(s.Res.DestinationType), *s.Res.Destination)
+}
+
+func (s *CoreRouteTableRuleResourceCrud) Create() error {
+	routeTableId := s.D.Get("route_table_id").(string)
+
+	rule := s.mapToRouteRule()
+	err := s.updateRouteRules(routeTableId, func(rules []oci_core.RouteRule) ([]oci_core.RouteRule, error) {
+		for _, existing := range rules {
+			if routeRuleMatches(existing, string(rule.DestinationType), *rule.Destination) {
+				return nil, fmt.Errorf("a route rule for destination %s already exists in route table %s", *rule.Destination, routeTableId)
+			}
+		}
+		return append(rules, rule), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Res = &rule
+	return nil
+}
+
+func (s *CoreRouteTableRuleResourceCrud) Get() error {
+	routeTableId, destinationType, destination, err := parseRouteTableRuleCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+
+	routeTable, _, err := s.getRouteTable(routeTableId)
+	if err != nil {
+		return err
+	}
+	s.D.Set("route_table_id", routeTableId)
+
+	for i := range routeTable.RouteRules {
+		if routeRuleMatches(routeTable.RouteRules[i], destinationType, destination) {
+			s.Res = &routeTable.RouteRules[i]
+			return nil
+		}
+	}
+
+	return fmt.Errorf("route rule for destination %s not found in route table %s", destination, routeTableId)
+}
+
+func (s *CoreRouteTableRuleResourceCrud) Update() error {
+	routeTableId, destinationType, destination, err := parseRouteTableRuleCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+
+	rule := s.mapToRouteRule()
+	err = s.updateRouteRules(routeTableId, func(rules []oci_core.RouteRule) ([]oci_core.RouteRule, error) {
+		for i := range rules {
+			if routeRuleMatches(rules[i], destinationType, destination) {
+				rules[i] = rule
+				return rules, nil
+			}
+		}
+		return nil, fmt.Errorf("route rule for destination %s not found in route table %s", destination, routeTableId)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Res = &rule
+	return nil
+}
+
+func (s *CoreRouteTableRuleResourceCrud) Delete() error {
+	routeTableId, destinationType, destination, err := parseRouteTableRuleCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+
+	return s.updateRouteRules(routeTableId, func(rules []oci_core.RouteRule) ([]oci_core.RouteRule, error) {
+		for i := range rules {
+			if routeRuleMatches(rules[i], destinationType, destination) {
+				return append(rules[:i], rules[i+1:]...), nil
+			}
+		}
+		// The rule is already gone, nothing to update
+		return nil, nil
+	})
+}
+
+func (s *CoreRouteTableRuleResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	if s.Res.Description != nil {
+		s.D.Set("description", *s.Res.Description)
+	}
+
+	if s.Res.Destination != nil {
+		s.D.Set("destination", *s.Res.Destination)
+	}
+
+	s.D.Set("destination_type", s.Res.DestinationType)
+
+	if s.Res.NetworkEntityId != nil {
+		s.D.Set("network_entity_id", *s.Res.NetworkEntityId)
+	}
+
+	s.D.Set("route_type", s.Res.RouteType)
+
+	return nil
+}
+
+func (s *CoreRouteTableRuleResourceCrud) getRouteTable(routeTableId string) (*oci_core.RouteTable, *string, error) {
+	request := oci_core.GetRouteTableRequest{
+		RtId: &routeTableId,
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetRouteTable(context.Background(), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &response.RouteTable, response.Etag, nil
+}
+
+// updateRouteRules replaces the rules of the route table with the result of modify applied to its current rules, local
+// rules excluded. A nil result leaves the route table unchanged. The update is conditional on the etag of the route
+// table read before: when another client modified the route table in between, the update fails with a 412 and the
+// route table is read and modified again, so that concurrent changes to other rules are never overwritten.
+func (s *CoreRouteTableRuleResourceCrud) updateRouteRules(routeTableId string, modify func(rules []oci_core.RouteRule) ([]oci_core.RouteRule, error)) error {
+	for attempt := 1; ; attempt++ {
+		routeTable, etag, err := s.getRouteTable(routeTableId)
+		if err != nil {
+			return err
+		}
+
+		rules := []oci_core.RouteRule{}
+		for _, existing := range routeTable.RouteRules {
+			if existing.RouteType != oci_core.RouteRuleRouteTypeLocal {
+				rules = append(rules, existing)
+			}
+		}
+
+		rules, err = modify(rules)
+		if err != nil || rules == nil {
+			return err
+		}
+
+		request := oci_core.UpdateRouteTableRequest{
+			RtId:    &routeTableId,
+			IfMatch: etag,
+		}
+		request.RouteRules = rules
+
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		_, err = s.Client.UpdateRouteTable(context.Background(), request)
+		if failure, ok := oci_common.IsServiceError(err); ok && failure.GetHTTPStatusCode() == 412 && attempt < routeTableRuleUpdateAttempts {
+			log.Printf("[DEBUG] route table %s was modified since it was read, updating its rules again", routeTableId)
+			time.Sleep(time.Duration(attempt) * time.Second)
+			continue
+		}
+		return err
+	}
+}
+
+func (s *CoreRouteTableRuleResourceCrud) mapToRouteRule() oci_core.RouteRule {
+	result := oci_core.RouteRule{}
+
+	if description, ok := s.D.GetOkExists("description"); ok && description != "" {
+		tmp := description.(string)
+		result.Description = &tmp
+	}
+
+	if destination, ok := s.D.GetOkExists("destination"); ok {
+		tmp := destination.(string)
+		result.Destination = &tmp
+	}
+
+	if destinationType, ok := s.D.GetOkExists("destination_type"); ok && destinationType != "" {
+		result.DestinationType = oci_core.RouteRuleDestinationTypeEnum(destinationType.(string))
+	} else {
+		result.DestinationType = oci_core.RouteRuleDestinationTypeCidrBlock
+	}
+
+	if networkEntityId, ok := s.D.GetOkExists("network_entity_id"); ok {
+		tmp := networkEntityId.(string)
+		result.NetworkEntityId = &tmp
+	}
+
+	return result
+}
+
+// routeRuleMatches is true when the rule routes the destination, a route table holds at most one rule per destination
+func routeRuleMatches(rule oci_core.RouteRule, destinationType string, destination string) bool {
+	if rule.Destination == nil || *rule.Destination != destination {
+		return false
+	}
+	ruleDestinationType := string(rule.DestinationType)
+	if ruleDestinationType == "" {
+		ruleDestinationType = string(oci_core.RouteRuleDestinationTypeCidrBlock)
+	}
+	return ruleDestinationType == destinationType
+}
+
+func GetRouteTableRuleCompositeId(routeTableId string, destinationType string, destination string) string {
+	routeTableId = url.PathEscape(routeTableId)
+	destination = url.PathEscape(destination)
+	compositeId := "routeTables/" + routeTableId + "/routeRules/" + destinationType + "/" + destination
+	return compositeId
+}
+
+func parseRouteTableRuleCompositeId(compositeId string) (routeTableId string, destinationType string, destination string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("routeTables/.*/routeRules/.*/.*", compositeId)
+	if !match || len(parts) != 5 {
+		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+		return
+	}
+	routeTableId, _ = url.PathUnescape(parts[1])
+	destinationType = parts[3]
+	destination, _ = url.PathUnescape(parts[4])
+
+	return
+}
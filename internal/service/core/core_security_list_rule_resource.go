@@ -0,0 +1,670 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+)
+
+// securityListRuleUpdateAttempts bounds how often the rules of a security list are read and updated again when another
+// client modified the security list in between
+const securityListRuleUpdateAttempts = 10
+
+func CoreSecurityListRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createCoreSecurityListRule,
+		Read:     readCoreSecurityListRule,
+		Delete:   deleteCoreSecurityListRule,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"security_list_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.SecurityRuleDirectionEgress),
+					string(oci_core.SecurityRuleDirectionIngress),
+				}, false),
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.EgressSecurityRuleDestinationTypeCidrBlock),
+					string(oci_core.EgressSecurityRuleDestinationTypeServiceCidrBlock),
+				}, false),
+			},
+			"icmp_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"type": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// Optional
+						"code": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							Default:  -1,
+						},
+
+						// Computed
+					},
+				},
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.IngressSecurityRuleSourceTypeCidrBlock),
+					string(oci_core.IngressSecurityRuleSourceTypeServiceCidrBlock),
+				}, false),
+			},
+			"stateless": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"tcp_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+						"destination_port_range": securityListRulePortRangeSchema(),
+						"source_port_range":      securityListRulePortRangeSchema(),
+
+						// Computed
+					},
+				},
+			},
+			"udp_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+						"destination_port_range": securityListRulePortRangeSchema(),
+						"source_port_range":      securityListRulePortRangeSchema(),
+
+						// Computed
+					},
+				},
+			},
+
+			// Computed
+		},
+		CustomizeDiff: customdiff.All(
+			tfresource.RequiredWhen(tfresource.AttributeIn("direction", string(oci_core.SecurityRuleDirectionIngress)), "direction is INGRESS", "source"),
+			tfresource.ConflictsWhen(tfresource.AttributeIn("direction", string(oci_core.SecurityRuleDirectionIngress)), "direction is INGRESS", "destination", "destination_type"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("direction", string(oci_core.SecurityRuleDirectionEgress)), "direction is EGRESS", "destination"),
+			tfresource.ConflictsWhen(tfresource.AttributeIn("direction", string(oci_core.SecurityRuleDirectionEgress)), "direction is EGRESS", "source", "source_type"),
+		),
+	}
+}
+
+func securityListRulePortRangeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Required
+				"max": {
+					Type:     schema.TypeInt,
+					Required: true,
+					ForceNew: true,
+				},
+				"min": {
+					Type:     schema.TypeInt,
+					Required: true,
+					ForceNew: true,
+				},
+
+				// Optional
+
+				// Computed
+			},
+		},
+	}
+}
+
+func createCoreSecurityListRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreSecurityListRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readCoreSecurityListRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreSecurityListRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func deleteCoreSecurityListRule(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreSecurityListRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type CoreSecurityListRuleResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_core.VirtualNetworkClient
+	Res                    *oci_core.SecurityList
+	IngressRule            *oci_core.IngressSecurityRule
+	EgressRule             *oci_core.EgressSecurityRule
+	DisableNotFoundRetries bool
+}
+
+func (s *CoreSecurityListRuleResourceCrud) ID() string {
+	securityListId := s.D.Get("security_list_id").(string)
+	if s.IngressRule != nil {
+		return GetSecurityListRuleCompositeId(securityListId, string(oci_core.SecurityRuleDirectionIngress), ingressSecurityRuleHash(*s.IngressRule))
+	}
+	if s.EgressRule != nil {
+		return GetSecurityListRuleCompositeId(securityListId, string(oci_core.SecurityRuleDirectionEgress), egressSecurityRuleHash(*s.EgressRule))
+	}
+	return ""
+}
+
+func (s *CoreSecurityListRuleResourceCrud) Create() error {
+	securityListId := s.D.Get("security_list_id").(string)
+
+	securityList, err := s.updateSecurityListRules(securityListId, func(securityList *oci_core.SecurityList, request *oci_core.UpdateSecurityListRequest) (bool, error) {
+		switch s.D.Get("direction").(string) {
+		case string(oci_core.SecurityRuleDirectionIngress):
+			rule := s.mapToIngressSecurityRule()
+			hash := ingressSecurityRuleHash(rule)
+			for _, existing := range securityList.IngressSecurityRules {
+				if ingressSecurityRuleHash(existing) == hash {
+					return false, fmt.Errorf("an identical ingress rule already exists in security list %s", securityListId)
+				}
+			}
+			request.IngressSecurityRules = append(securityList.IngressSecurityRules, rule)
+			s.IngressRule = &rule
+		default:
+			rule := s.mapToEgressSecurityRule()
+			hash := egressSecurityRuleHash(rule)
+			for _, existing := range securityList.EgressSecurityRules {
+				if egressSecurityRuleHash(existing) == hash {
+					return false, fmt.Errorf("an identical egress rule already exists in security list %s", securityListId)
+				}
+			}
+			request.EgressSecurityRules = append(securityList.EgressSecurityRules, rule)
+			s.EgressRule = &rule
+		}
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Res = securityList
+	return nil
+}
+
+func (s *CoreSecurityListRuleResourceCrud) Get() error {
+	securityListId, direction, hash, err := parseSecurityListRuleCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+
+	securityList, _, err := s.getSecurityList(securityListId)
+	if err != nil {
+		return err
+	}
+	s.Res = securityList
+	s.D.Set("security_list_id", securityListId)
+
+	if direction == string(oci_core.SecurityRuleDirectionIngress) {
+		for i := range securityList.IngressSecurityRules {
+			if ingressSecurityRuleHash(securityList.IngressSecurityRules[i]) == hash {
+				s.IngressRule = &securityList.IngressSecurityRules[i]
+				return nil
+			}
+		}
+	} else {
+		for i := range securityList.EgressSecurityRules {
+			if egressSecurityRuleHash(securityList.EgressSecurityRules[i]) == hash {
+				s.EgressRule = &securityList.EgressSecurityRules[i]
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%s rule not found in security list %s", strings.ToLower(direction), securityListId)
+}
+
+func (s *CoreSecurityListRuleResourceCrud) Delete() error {
+	securityListId, direction, hash, err := parseSecurityListRuleCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+
+	_, err = s.updateSecurityListRules(securityListId, func(securityList *oci_core.SecurityList, request *oci_core.UpdateSecurityListRequest) (bool, error) {
+		removed := false
+		if direction == string(oci_core.SecurityRuleDirectionIngress) {
+			rules := []oci_core.IngressSecurityRule{}
+			for _, rule := range securityList.IngressSecurityRules {
+				if !removed && ingressSecurityRuleHash(rule) == hash {
+					removed = true
+					continue
+				}
+				rules = append(rules, rule)
+			}
+			request.IngressSecurityRules = rules
+		} else {
+			rules := []oci_core.EgressSecurityRule{}
+			for _, rule := range securityList.EgressSecurityRules {
+				if !removed && egressSecurityRuleHash(rule) == hash {
+					removed = true
+					continue
+				}
+				rules = append(rules, rule)
+			}
+			request.EgressSecurityRules = rules
+		}
+		return removed, nil
+	})
+	return err
+}
+
+func (s *CoreSecurityListRuleResourceCrud) SetData() error {
+	if s.IngressRule != nil {
+		rule := s.IngressRule
+		s.D.Set("direction", string(oci_core.SecurityRuleDirectionIngress))
+		s.setRuleData(rule.Protocol, rule.Description, rule.IsStateless, rule.IcmpOptions, rule.TcpOptions, rule.UdpOptions)
+
+		if rule.Source != nil {
+			s.D.Set("source", *rule.Source)
+		}
+
+		s.D.Set("source_type", rule.SourceType)
+	}
+
+	if s.EgressRule != nil {
+		rule := s.EgressRule
+		s.D.Set("direction", string(oci_core.SecurityRuleDirectionEgress))
+		s.setRuleData(rule.Protocol, rule.Description, rule.IsStateless, rule.IcmpOptions, rule.TcpOptions, rule.UdpOptions)
+
+		if rule.Destination != nil {
+			s.D.Set("destination", *rule.Destination)
+		}
+
+		s.D.Set("destination_type", rule.DestinationType)
+	}
+
+	return nil
+}
+
+func (s *CoreSecurityListRuleResourceCrud) setRuleData(protocol *string, description *string, isStateless *bool, icmpOptions *oci_core.IcmpOptions, tcpOptions *oci_core.TcpOptions, udpOptions *oci_core.UdpOptions) {
+	if protocol != nil {
+		s.D.Set("protocol", *protocol)
+	}
+
+	if description != nil {
+		s.D.Set("description", *description)
+	}
+
+	if isStateless != nil {
+		s.D.Set("stateless", *isStateless)
+	} else {
+		s.D.Set("stateless", false)
+	}
+
+	if icmpOptions != nil {
+		s.D.Set("icmp_options", []interface{}{nsgIcmpOptionsToMap(icmpOptions)})
+	} else {
+		s.D.Set("icmp_options", nil)
+	}
+
+	if tcpOptions != nil {
+		s.D.Set("tcp_options", []interface{}{nsgTcpOptionsToMap(tcpOptions)})
+	} else {
+		s.D.Set("tcp_options", nil)
+	}
+
+	if udpOptions != nil {
+		s.D.Set("udp_options", []interface{}{nsgUdpOptionsToMap(udpOptions)})
+	} else {
+		s.D.Set("udp_options", nil)
+	}
+}
+
+func (s *CoreSecurityListRuleResourceCrud) getSecurityList(securityListId string) (*oci_core.SecurityList, *string, error) {
+	request := oci_core.GetSecurityListRequest{
+		SecurityListId: &securityListId,
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.Client.GetSecurityList(context.Background(), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &response.SecurityList, response.Etag, nil
+}
+
+// updateSecurityListRules reads the security list and lets modify set the rule lists to update on the request, modify
+// returns false when the security list is left unchanged. The update is conditional on the etag of the security list
+// read before: when another client modified the security list in between, the update fails with a 412 and the security
+// list is read and modified again, so that concurrent changes to other rules are never overwritten.
+func (s *CoreSecurityListRuleResourceCrud) updateSecurityListRules(securityListId string, modify func(securityList *oci_core.SecurityList, request *oci_core.UpdateSecurityListRequest) (bool, error)) (*oci_core.SecurityList, error) {
+	for attempt := 1; ; attempt++ {
+		securityList, etag, err := s.getSecurityList(securityListId)
+		if err != nil {
+			return nil, err
+		}
+
+		request := oci_core.UpdateSecurityListRequest{
+			SecurityListId: &securityListId,
+			IfMatch:        etag,
+		}
+
+		changed, err := modify(securityList, &request)
+		if err != nil || !changed {
+			return securityList, err
+		}
+
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		response, err := s.Client.UpdateSecurityList(context.Background(), request)
+		if failure, ok := oci_common.IsServiceError(err); ok && failure.GetHTTPStatusCode() == 412 && attempt < securityListRuleUpdateAttempts {
+			log.Printf("[DEBUG] security list %s was modified since it was read, updating its rules again", securityListId)
+			time.Sleep(time.Duration(attempt) * time.Second)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &response.SecurityList, nil
+	}
+}
+
+func (s *CoreSecurityListRuleResourceCrud) mapToIngressSecurityRule() oci_core.IngressSecurityRule {
+	result := oci_core.IngressSecurityRule{}
+
+	if description, ok := s.D.GetOkExists("description"); ok && description != "" {
+		tmp := description.(string)
+		result.Description = &tmp
+	}
+
+	if protocol, ok := s.D.GetOkExists("protocol"); ok {
+		tmp := protocol.(string)
+		result.Protocol = &tmp
+	}
+
+	if source, ok := s.D.GetOkExists("source"); ok {
+		tmp := source.(string)
+		result.Source = &tmp
+	}
+
+	if sourceType, ok := s.D.GetOkExists("source_type"); ok && sourceType != "" {
+		result.SourceType = oci_core.IngressSecurityRuleSourceTypeEnum(sourceType.(string))
+	}
+
+	if stateless, ok := s.D.GetOkExists("stateless"); ok {
+		tmp := stateless.(bool)
+		result.IsStateless = &tmp
+	}
+
+	result.IcmpOptions, result.TcpOptions, result.UdpOptions = s.mapToRuleOptions()
+
+	return result
+}
+
+func (s *CoreSecurityListRuleResourceCrud) mapToEgressSecurityRule() oci_core.EgressSecurityRule {
+	result := oci_core.EgressSecurityRule{}
+
+	if description, ok := s.D.GetOkExists("description"); ok && description != "" {
+		tmp := description.(string)
+		result.Description = &tmp
+	}
+
+	if destination, ok := s.D.GetOkExists("destination"); ok {
+		tmp := destination.(string)
+		result.Destination = &tmp
+	}
+
+	if destinationType, ok := s.D.GetOkExists("destination_type"); ok && destinationType != "" {
+		result.DestinationType = oci_core.EgressSecurityRuleDestinationTypeEnum(destinationType.(string))
+	}
+
+	if protocol, ok := s.D.GetOkExists("protocol"); ok {
+		tmp := protocol.(string)
+		result.Protocol = &tmp
+	}
+
+	if stateless, ok := s.D.GetOkExists("stateless"); ok {
+		tmp := stateless.(bool)
+		result.IsStateless = &tmp
+	}
+
+	result.IcmpOptions, result.TcpOptions, result.UdpOptions = s.mapToRuleOptions()
+
+	return result
+}
+
+func (s *CoreSecurityListRuleResourceCrud) mapToRuleOptions() (*oci_core.IcmpOptions, *oci_core.TcpOptions, *oci_core.UdpOptions) {
+	var icmpOptions *oci_core.IcmpOptions
+	var tcpOptions *oci_core.TcpOptions
+	var udpOptions *oci_core.UdpOptions
+
+	if tmpList, ok := s.D.Get("icmp_options").([]interface{}); ok && len(tmpList) > 0 {
+		icmpOptions = &oci_core.IcmpOptions{}
+		if type_, ok := s.D.GetOkExists("icmp_options.0.type"); ok {
+			tmp := type_.(int)
+			icmpOptions.Type = &tmp
+		}
+		if code, ok := s.D.GetOkExists("icmp_options.0.code"); ok && code.(int) > -1 {
+			tmp := code.(int)
+			icmpOptions.Code = &tmp
+		}
+	}
+
+	if tmpList, ok := s.D.Get("tcp_options").([]interface{}); ok && len(tmpList) > 0 {
+		tcpOptions = &oci_core.TcpOptions{
+			DestinationPortRange: s.mapToRulePortRange("tcp_options.0.destination_port_range"),
+			SourcePortRange:      s.mapToRulePortRange("tcp_options.0.source_port_range"),
+		}
+	}
+
+	if tmpList, ok := s.D.Get("udp_options").([]interface{}); ok && len(tmpList) > 0 {
+		udpOptions = &oci_core.UdpOptions{
+			DestinationPortRange: s.mapToRulePortRange("udp_options.0.destination_port_range"),
+			SourcePortRange:      s.mapToRulePortRange("udp_options.0.source_port_range"),
+		}
+	}
+
+	return icmpOptions, tcpOptions, udpOptions
+}
+
+func (s *CoreSecurityListRuleResourceCrud) mapToRulePortRange(key string) *oci_core.PortRange {
+	if tmpList, ok := s.D.Get(key).([]interface{}); !ok || len(tmpList) == 0 {
+		return nil
+	}
+
+	result := oci_core.PortRange{}
+
+	if max, ok := s.D.GetOkExists(key + ".0.max"); ok {
+		tmp := max.(int)
+		result.Max = &tmp
+	}
+
+	if min, ok := s.D.GetOkExists(key + ".0.min"); ok {
+		tmp := min.(int)
+		result.Min = &tmp
+	}
+
+	return &result
+}
+
+func ingressSecurityRuleHash(rule oci_core.IngressSecurityRule) string {
+	return securityListRuleHash(string(oci_core.SecurityRuleDirectionIngress), rule.Protocol, rule.Source, string(rule.SourceType),
+		rule.IsStateless, rule.IcmpOptions, rule.TcpOptions, rule.UdpOptions, rule.Description)
+}
+
+func egressSecurityRuleHash(rule oci_core.EgressSecurityRule) string {
+	return securityListRuleHash(string(oci_core.SecurityRuleDirectionEgress), rule.Protocol, rule.Destination, string(rule.DestinationType),
+		rule.IsStateless, rule.IcmpOptions, rule.TcpOptions, rule.UdpOptions, rule.Description)
+}
+
+// securityListRuleHash identifies a security list rule by its content, unset values hash the same as the defaults the
+// service fills in so a configured rule and the rule read back from the service have the same hash
+func securityListRuleHash(direction string, protocol *string, endpoint *string, endpointType string, isStateless *bool,
+	icmpOptions *oci_core.IcmpOptions, tcpOptions *oci_core.TcpOptions, udpOptions *oci_core.UdpOptions, description *string) string {
+	var parts []string
+
+	parts = append(parts, direction, stringValue(protocol), stringValue(endpoint))
+
+	if endpointType == "" {
+		endpointType = "CIDR_BLOCK"
+	}
+	parts = append(parts, endpointType)
+
+	parts = append(parts, strconv.FormatBool(isStateless != nil && *isStateless))
+
+	if icmpOptions != nil {
+		code := -1
+		if icmpOptions.Code != nil {
+			code = *icmpOptions.Code
+		}
+		parts = append(parts, fmt.Sprintf("icmp_options-%d-%d", intValue(icmpOptions.Type), code))
+	}
+	if tcpOptions != nil {
+		parts = append(parts, "tcp_options", portRangeHashPart(tcpOptions.DestinationPortRange), portRangeHashPart(tcpOptions.SourcePortRange))
+	}
+	if udpOptions != nil {
+		parts = append(parts, "udp_options", portRangeHashPart(udpOptions.DestinationPortRange), portRangeHashPart(udpOptions.SourcePortRange))
+	}
+
+	parts = append(parts, stringValue(description))
+
+	return strconv.Itoa(utils.GetStringHashcode(strings.Join(parts, "-")))
+}
+
+func portRangeHashPart(portRange *oci_core.PortRange) string {
+	if portRange == nil {
+		return "none"
+	}
+	return fmt.Sprintf("%d:%d", intValue(portRange.Min), intValue(portRange.Max))
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+func GetSecurityListRuleCompositeId(securityListId string, direction string, ruleHash string) string {
+	securityListId = url.PathEscape(securityListId)
+	compositeId := "securityLists/" + securityListId + "/securityRules/" + strings.ToLower(direction) + "/" + ruleHash
+	return compositeId
+}
+
+func parseSecurityListRuleCompositeId(compositeId string) (securityListId string, direction string, ruleHash string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("securityLists/.*/securityRules/(ingress|egress)/.*", compositeId)
+	if !match || len(parts) != 5 {
+		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+		return
+	}
+	securityListId, _ = url.PathUnescape(parts[1])
+	direction = strings.ToUpper(parts[3])
+	ruleHash = parts[4]
+
+	return
+}
@@ -35,13 +35,15 @@ func CoreSubnetResource() *schema.Resource {
 				Required: true,
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"vcn_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("vcn"),
 			},
 
 			// Optional
@@ -60,9 +62,10 @@ func CoreSubnetResource() *schema.Resource {
 				Elem:             schema.TypeString,
 			},
 			"dhcp_options_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("dhcpoptions"),
 			},
 			"display_name": {
 				Type:     schema.TypeString,
@@ -110,9 +113,10 @@ func CoreSubnetResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("routetable"),
 			},
 			"security_list_ids": {
 				Type:     schema.TypeSet,
@@ -122,7 +126,8 @@ func CoreSubnetResource() *schema.Resource {
 				MinItems: 0,
 				Set:      tfresource.LiteralTypeHashCodeForSets,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: tfresource.ValidateOcid("securitylist"),
 				},
 			},
 
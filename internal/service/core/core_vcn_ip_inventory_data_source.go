@@ -0,0 +1,350 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package core
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+// CoreVcnIpInventoryDataSource walks the subnets of a VCN, optionally across a compartment tree, and returns the IP addresses allocated in them together with
+// the resource each of them is assigned to.
+func CoreVcnIpInventoryDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readSingularCoreVcnIpInventory,
+		Schema: map[string]*schema.Schema{
+			"vcn_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"compartment_id_in_subtree": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			// Computed
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"address_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assigned_resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assigned_resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assigned_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"associated_public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_host_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address_lifetime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_cidr": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vnic_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"ip_address_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readSingularCoreVcnIpInventory(d *schema.ResourceData, m interface{}) error {
+	sync := &CoreVcnIpInventoryDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VirtualNetworkClient()
+	sync.IdentityClient = m.(*client.OracleClients).IdentityClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type CoreVcnIpInventoryDataSourceCrud struct {
+	D              *schema.ResourceData
+	Client         *oci_core.VirtualNetworkClient
+	IdentityClient *oci_identity.IdentityClient
+	Res            []vcnIpInventorySubnet
+}
+
+// vcnIpInventorySubnet holds the IP inventory of one subnet and the VNICs of its private IPs, which the inventory
+// itself does not return.
+type vcnIpInventorySubnet struct {
+	SubnetId  *string
+	Inventory []oci_core.IpInventorySubnetResourceSummary
+	VnicIds   map[string]string
+}
+
+func (s *CoreVcnIpInventoryDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *CoreVcnIpInventoryDataSourceCrud) Get() error {
+	vcnId := s.D.Get("vcn_id").(string)
+
+	var compartmentId string
+	if tmp, ok := s.D.GetOkExists("compartment_id"); ok {
+		compartmentId = tmp.(string)
+	} else {
+		request := oci_core.GetVcnRequest{}
+		request.VcnId = &vcnId
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+
+		vcnResponse, err := s.Client.GetVcn(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		compartmentId = *vcnResponse.CompartmentId
+	}
+	s.D.Set("compartment_id", compartmentId)
+
+	compartmentIds := []string{compartmentId}
+	if compartmentIdInSubtree, ok := s.D.GetOkExists("compartment_id_in_subtree"); ok && compartmentIdInSubtree.(bool) {
+		subtreeCompartmentIds, err := s.listSubtreeCompartmentIds(compartmentId)
+		if err != nil {
+			return err
+		}
+		compartmentIds = append(compartmentIds, subtreeCompartmentIds...)
+	}
+
+	subnets := []oci_core.Subnet{}
+	for _, id := range compartmentIds {
+		items, err := s.listSubnets(id, vcnId)
+		if err != nil {
+			return err
+		}
+		subnets = append(subnets, items...)
+	}
+
+	s.Res = []vcnIpInventorySubnet{}
+	for _, subnet := range subnets {
+		request := oci_core.GetSubnetIpInventoryRequest{}
+		request.SubnetId = subnet.Id
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+
+		response, err := s.Client.GetSubnetIpInventory(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		vnicIds, err := s.listPrivateIpVnicIds(subnet.Id)
+		if err != nil {
+			return err
+		}
+
+		s.Res = append(s.Res, vcnIpInventorySubnet{
+			SubnetId:  subnet.Id,
+			Inventory: response.IpInventorySubnetResourceSummary,
+			VnicIds:   vnicIds,
+		})
+	}
+
+	return nil
+}
+
+// listSubtreeCompartmentIds returns the active compartments nested under the given compartment, at any depth
+func (s *CoreVcnIpInventoryDataSourceCrud) listSubtreeCompartmentIds(compartmentId string) ([]string, error) {
+	request := oci_identity.ListCompartmentsRequest{}
+	request.CompartmentId = &compartmentId
+	compartmentIdInSubtree := true
+	request.CompartmentIdInSubtree = &compartmentIdInSubtree
+	request.AccessLevel = oci_identity.ListCompartmentsAccessLevelAny
+	request.LifecycleState = oci_identity.CompartmentLifecycleStateActive
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "identity")
+
+	compartmentIds := []string{}
+	for {
+		response, err := s.IdentityClient.ListCompartments(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			if item.Id != nil {
+				compartmentIds = append(compartmentIds, *item.Id)
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return compartmentIds, nil
+}
+
+func (s *CoreVcnIpInventoryDataSourceCrud) listSubnets(compartmentId string, vcnId string) ([]oci_core.Subnet, error) {
+	request := oci_core.ListSubnetsRequest{}
+	request.CompartmentId = &compartmentId
+	request.VcnId = &vcnId
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+
+	response, err := s.Client.ListSubnets(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	items := response.Items
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListSubnets(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return items, nil
+}
+
+func (s *CoreVcnIpInventoryDataSourceCrud) listPrivateIpVnicIds(subnetId *string) (map[string]string, error) {
+	request := oci_core.ListPrivateIpsRequest{}
+	request.SubnetId = subnetId
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+
+	vnicIds := map[string]string{}
+	for {
+		response, err := s.Client.ListPrivateIps(context.Background(), request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			if item.Id != nil && item.VnicId != nil {
+				vnicIds[*item.Id] = *item.VnicId
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	return vnicIds, nil
+}
+
+func (s *CoreVcnIpInventoryDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(tfresource.GenerateDataSourceHashID("CoreVcnIpInventoryDataSource-", CoreVcnIpInventoryDataSource(), s.D))
+
+	ipAddresses := []interface{}{}
+	for _, subnet := range s.Res {
+		for _, item := range subnet.Inventory {
+			ipAddresses = append(ipAddresses, VcnIpInventoryIpAddressToMap(item, subnet.SubnetId, subnet.VnicIds))
+		}
+	}
+	s.D.Set("ip_addresses", ipAddresses)
+	s.D.Set("ip_address_count", len(ipAddresses))
+
+	return nil
+}
+
+func VcnIpInventoryIpAddressToMap(obj oci_core.IpInventorySubnetResourceSummary, subnetId *string, vnicIds map[string]string) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.AddressType != nil {
+		result["address_type"] = string(*obj.AddressType)
+	}
+
+	if obj.AssignedResourceName != nil {
+		result["assigned_resource_name"] = string(*obj.AssignedResourceName)
+	}
+
+	result["assigned_resource_type"] = string(obj.AssignedResourceType)
+
+	if obj.AssignedTime != nil {
+		result["assigned_time"] = obj.AssignedTime.String()
+	}
+
+	if obj.AssociatedPublicIp != nil {
+		result["associated_public_ip"] = string(*obj.AssociatedPublicIp)
+	}
+
+	if obj.DnsHostName != nil {
+		result["dns_host_name"] = string(*obj.DnsHostName)
+	}
+
+	if obj.IpAddress != nil {
+		result["ip_address"] = string(*obj.IpAddress)
+	}
+
+	result["ip_address_lifetime"] = string(obj.IpAddressLifetime)
+
+	if obj.IpId != nil {
+		result["ip_id"] = string(*obj.IpId)
+
+		if vnicId, ok := vnicIds[*obj.IpId]; ok {
+			result["vnic_id"] = vnicId
+		}
+	}
+
+	if obj.ParentCidr != nil {
+		result["parent_cidr"] = string(*obj.ParentCidr)
+	}
+
+	if subnetId != nil {
+		result["subnet_id"] = *subnetId
+	}
+
+	return result
+}
@@ -28,15 +28,15 @@ func CoreVcnResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 
 			// Optional
 			"byoipv6cidr_details": {
 				Type:     schema.TypeList,
 				Optional: true,
-				Computed: true,
 				// ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -44,12 +44,10 @@ func CoreVcnResource() *schema.Resource {
 						"byoipv6range_id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"ipv6cidr_block": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 
 						// Optional
@@ -379,8 +377,9 @@ func (s *CoreVcnResourceCrud) Update() error {
 		}
 	}
 
-	if byoipv6CidrDetails, ok := s.D.GetOkExists("byoipv6Cidr_details"); ok && s.D.HasChange("byoipv6Cidr_details") {
-		err := s.addByoIpv6CidrBlocks(byoipv6CidrDetails)
+	if s.D.HasChange("byoipv6cidr_details") {
+		oldRaw, newRaw := s.D.GetChange("byoipv6cidr_details")
+		err := s.updateByoIpv6CidrBlocks(oldRaw, newRaw)
 		if err != nil {
 			return err
 		}
@@ -469,21 +468,79 @@ func (s *CoreVcnResourceCrud) Update() error {
 	return nil
 }
 
-func (s *CoreVcnResourceCrud) addByoIpv6CidrBlocks(byoipv6CidrDetails interface{}) error {
-	request := oci_core.AddIpv6VcnCidrRequest{}
-	addVcnIpv6CidrDetails := oci_core.AddVcnIpv6CidrDetails{}
-	fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "byoipv6cidr_details", byoipv6CidrDetails)
-	converted, err := s.mapToByoipv6CidrDetails(fieldKeyFormat)
-	if err != nil {
-		return err
+// updateByoIpv6CidrBlocks assigns the BYOIPv6 prefixes added to byoipv6cidr_details to the VCN and releases the ones
+// that were removed, prefixes present in both the old and new configuration or already assigned to the VCN, e.g. after
+// an import, are left untouched
+func (s *CoreVcnResourceCrud) updateByoIpv6CidrBlocks(oldRaw interface{}, newRaw interface{}) error {
+	assigned := map[string]bool{}
+	for _, block := range s.D.Get("byoipv6cidr_blocks").([]interface{}) {
+		if block != nil {
+			assigned[block.(string)] = true
+		}
+	}
+
+	oldDetails := map[string]bool{}
+	for _, item := range oldRaw.([]interface{}) {
+		if item != nil {
+			detail := item.(map[string]interface{})
+			oldDetails[fmt.Sprintf("%v/%v", detail["byoipv6range_id"], detail["ipv6cidr_block"])] = true
+		}
+	}
+
+	newDetails := map[string]bool{}
+	for index, item := range newRaw.([]interface{}) {
+		if item == nil {
+			continue
+		}
+		detail := item.(map[string]interface{})
+		key := fmt.Sprintf("%v/%v", detail["byoipv6range_id"], detail["ipv6cidr_block"])
+		newDetails[key] = true
+		if oldDetails[key] || assigned[detail["ipv6cidr_block"].(string)] {
+			continue
+		}
+
+		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "byoipv6cidr_details", index)
+		converted, err := s.mapToByoipv6CidrDetails(fieldKeyFormat)
+		if err != nil {
+			return err
+		}
+		if err := s.addByoIpv6CidrBlock(converted); err != nil {
+			return err
+		}
 	}
 
-	addVcnIpv6CidrDetails.Byoipv6CidrDetail = &converted
+	for _, item := range oldRaw.([]interface{}) {
+		if item == nil {
+			continue
+		}
+		detail := item.(map[string]interface{})
+		if newDetails[fmt.Sprintf("%v/%v", detail["byoipv6range_id"], detail["ipv6cidr_block"])] {
+			continue
+		}
+
+		request := oci_core.RemoveIpv6VcnCidrRequest{}
+		idTmp := s.D.Id()
+		request.VcnId = &idTmp
+		ipv6CidrBlock := detail["ipv6cidr_block"].(string)
+		request.RemoveVcnIpv6CidrDetails = oci_core.RemoveVcnIpv6CidrDetails{Ipv6CidrBlock: &ipv6CidrBlock}
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+		if _, err := s.Client.RemoveIpv6VcnCidr(context.Background(), request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *CoreVcnResourceCrud) addByoIpv6CidrBlock(byoipv6CidrDetails oci_core.Byoipv6CidrDetails) error {
+	request := oci_core.AddIpv6VcnCidrRequest{}
+	addVcnIpv6CidrDetails := oci_core.AddVcnIpv6CidrDetails{}
+	addVcnIpv6CidrDetails.Byoipv6CidrDetail = &byoipv6CidrDetails
 	idTmp := s.D.Id()
 	request.VcnId = &idTmp
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
 	request.AddVcnIpv6CidrDetails = addVcnIpv6CidrDetails
-	_, err = s.Client.AddIpv6VcnCidr(context.Background(), request)
+	_, err := s.Client.AddIpv6VcnCidr(context.Background(), request)
 	if err != nil {
 		return err
 	}
@@ -555,6 +612,8 @@ func (s *CoreVcnResourceCrud) Delete() error {
 func (s *CoreVcnResourceCrud) SetData() error {
 	s.D.Set("byoipv6cidr_blocks", s.Res.Byoipv6CidrBlocks)
 
+	s.D.Set("byoipv6cidr_details", s.assignedByoipv6CidrDetails())
+
 	if s.Res.CidrBlock != nil {
 		s.D.Set("cidr_block", *s.Res.CidrBlock)
 	}
@@ -616,6 +675,28 @@ func (s *CoreVcnResourceCrud) SetData() error {
 	return nil
 }
 
+// assignedByoipv6CidrDetails returns the byoipv6cidr_details entries whose prefix is still assigned to the VCN, the
+// service only returns the prefixes so the BYOIPv6 range of each entry is kept from the state
+func (s *CoreVcnResourceCrud) assignedByoipv6CidrDetails() []interface{} {
+	assigned := map[string]bool{}
+	for _, block := range s.Res.Byoipv6CidrBlocks {
+		assigned[block] = true
+	}
+
+	result := []interface{}{}
+	for _, item := range s.D.Get("byoipv6cidr_details").([]interface{}) {
+		if item == nil {
+			continue
+		}
+		detail := item.(map[string]interface{})
+		if assigned[detail["ipv6cidr_block"].(string)] {
+			result = append(result, detail)
+		}
+	}
+
+	return result
+}
+
 func (s *CoreVcnResourceCrud) mapToByoipv6CidrDetails(fieldKeyFormat string) (oci_core.Byoipv6CidrDetails, error) {
 	result := oci_core.Byoipv6CidrDetails{}
 
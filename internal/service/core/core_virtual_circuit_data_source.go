@@ -125,6 +125,10 @@ func (s *CoreVirtualCircuitDataSourceCrud) SetData() error {
 		s.D.Set("oracle_bgp_asn", *s.Res.OracleBgpAsn)
 	}
 
+	if s.Res.ProviderName != nil {
+		s.D.Set("provider_name", *s.Res.ProviderName)
+	}
+
 	if s.Res.ProviderServiceId != nil {
 		s.D.Set("provider_service_id", *s.Res.ProviderServiceId)
 	}
@@ -133,6 +137,10 @@ func (s *CoreVirtualCircuitDataSourceCrud) SetData() error {
 		s.D.Set("provider_service_key_name", *s.Res.ProviderServiceKeyName)
 	}
 
+	if s.Res.ProviderServiceName != nil {
+		s.D.Set("provider_service_name", *s.Res.ProviderServiceName)
+	}
+
 	s.D.Set("provider_state", s.Res.ProviderState)
 
 	publicPrefixes := []interface{}{}
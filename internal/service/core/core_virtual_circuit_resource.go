@@ -32,8 +32,9 @@ func CoreVirtualCircuitResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -220,6 +221,14 @@ func CoreVirtualCircuitResource() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"provider_service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"provider_state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -815,6 +824,10 @@ func (s *CoreVirtualCircuitResourceCrud) SetData() error {
 		s.D.Set("oracle_bgp_asn", *s.Res.OracleBgpAsn)
 	}
 
+	if s.Res.ProviderName != nil {
+		s.D.Set("provider_name", *s.Res.ProviderName)
+	}
+
 	if s.Res.ProviderServiceId != nil {
 		s.D.Set("provider_service_id", *s.Res.ProviderServiceId)
 	}
@@ -823,6 +836,10 @@ func (s *CoreVirtualCircuitResourceCrud) SetData() error {
 		s.D.Set("provider_service_key_name", *s.Res.ProviderServiceKeyName)
 	}
 
+	if s.Res.ProviderServiceName != nil {
+		s.D.Set("provider_service_name", *s.Res.ProviderServiceName)
+	}
+
 	s.D.Set("provider_state", s.Res.ProviderState)
 
 	publicPrefixes := []interface{}{}
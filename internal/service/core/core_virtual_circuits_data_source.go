@@ -169,6 +169,10 @@ func (s *CoreVirtualCircuitsDataSourceCrud) SetData() error {
 			virtualCircuit["oracle_bgp_asn"] = *r.OracleBgpAsn
 		}
 
+		if r.ProviderName != nil {
+			virtualCircuit["provider_name"] = *r.ProviderName
+		}
+
 		if r.ProviderServiceId != nil {
 			virtualCircuit["provider_service_id"] = *r.ProviderServiceId
 		}
@@ -177,6 +181,10 @@ func (s *CoreVirtualCircuitsDataSourceCrud) SetData() error {
 			virtualCircuit["provider_service_key_name"] = *r.ProviderServiceKeyName
 		}
 
+		if r.ProviderServiceName != nil {
+			virtualCircuit["provider_service_name"] = *r.ProviderServiceName
+		}
+
 		virtualCircuit["provider_state"] = r.ProviderState
 
 		publicPrefixes := []interface{}{}
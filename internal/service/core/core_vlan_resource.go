@@ -31,8 +31,9 @@ func CoreVlanResource() *schema.Resource {
 				Required: true,
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"vcn_id": {
 				Type:     schema.TypeString,
@@ -72,13 +73,15 @@ func CoreVlanResource() *schema.Resource {
 				Computed: true,
 				Set:      tfresource.LiteralTypeHashCodeForSets,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: tfresource.ValidateOcid("networksecuritygroup"),
 				},
 			},
 			"route_table_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("routetable"),
 			},
 			"vlan_tag": {
 				Type:     schema.TypeInt,
@@ -117,7 +117,8 @@ func CoreVnicAttachmentResource() *schema.Resource {
 							Optional: true,
 							Set:      tfresource.LiteralTypeHashCodeForSets,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: tfresource.ValidateOcid("networksecuritygroup"),
 							},
 						},
 						"private_ip": {
@@ -139,10 +140,11 @@ func CoreVnicAttachmentResource() *schema.Resource {
 							Computed: true,
 						},
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: tfresource.ValidateOcid("subnet"),
 						},
 						"vlan_id": {
 							Type:     schema.TypeString,
@@ -156,9 +158,10 @@ func CoreVnicAttachmentResource() *schema.Resource {
 				},
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
 			},
 
 			// Optional
@@ -41,14 +41,16 @@ func CoreVolumeAttachmentResource() *schema.Resource {
 				}, true),
 			},
 			"instance_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("instance"),
 			},
 			"volume_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: tfresource.ValidateOcid("volume", "bootvolume"),
 			},
 
 			// Optional
@@ -118,8 +120,9 @@ func CoreVolumeAttachmentResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 				// Keep as optional to avoid validation during destroy for the legacy configurations
-				Optional:   true,
-				Deprecated: tfresource.FieldDeprecatedAndAvoidReferences("compartment_id"),
+				Optional:     true,
+				Deprecated:   tfresource.FieldDeprecatedAndAvoidReferences("compartment_id"),
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"ipv4": {
 				Type:     schema.TypeString,
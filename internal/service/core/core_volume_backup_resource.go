@@ -69,9 +69,10 @@ func CoreVolumeBackupResource() *schema.Resource {
 
 			// Optional
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"defined_tags": {
 				Type:             schema.TypeMap,
@@ -9,11 +9,13 @@ import (
 	"log"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_core "github.com/oracle/oci-go-sdk/v65/core"
 )
 
@@ -68,9 +70,43 @@ func CoreVolumeGroupBackupResource() *schema.Resource {
 
 			// Optional
 			"compartment_id": {
-				Type:     schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
+			},
+			"copy_to_region": {
+				Type:     schema.TypeList,
 				Optional: true,
-				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// Optional
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"kms_key_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						// Computed
+						"volume_group_backup_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 			"defined_tags": {
 				Type:             schema.TypeMap,
@@ -178,7 +214,8 @@ func createCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
 			log.Printf("error doing a SetData() after compartment Update: %v", err)
 		}
 	}
-	return nil
+
+	return sync.copyToRegions()
 }
 
 func readCoreVolumeGroupBackup(d *schema.ResourceData, m interface{}) error {
@@ -437,6 +474,10 @@ func (s *CoreVolumeGroupBackupResourceCrud) Update() error {
 }
 
 func (s *CoreVolumeGroupBackupResourceCrud) Delete() error {
+	if err := s.deleteRegionCopies(); err != nil {
+		return err
+	}
+
 	request := oci_core.DeleteVolumeGroupBackupRequest{}
 
 	tmp := s.D.Id()
@@ -532,3 +573,136 @@ func (s *CoreVolumeGroupBackupResourceCrud) updateCompartment(compartment interf
 
 	return nil
 }
+
+// copyToRegions copies the backup to each of the copy_to_region targets once it is available, and waits for the
+// copies to become available in their regions. The ID of each copy is saved as soon as the copy is requested, so that
+// copies made before a failure are still recorded in the state and deleted with the resource.
+func (s *CoreVolumeGroupBackupResourceCrud) copyToRegions() error {
+	copyToRegion, ok := s.D.GetOkExists("copy_to_region")
+	if !ok {
+		return nil
+	}
+
+	interfaces := copyToRegion.([]interface{})
+	copies := make([]interface{}, len(interfaces))
+	for i := range interfaces {
+		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "copy_to_region", i)
+		copyTarget := map[string]interface{}{}
+		copyTarget["region"] = s.D.Get(fmt.Sprintf(fieldKeyFormat, "region")).(string)
+		if displayName, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "display_name")); ok {
+			copyTarget["display_name"] = displayName.(string)
+		}
+		if kmsKeyId, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "kms_key_id")); ok {
+			copyTarget["kms_key_id"] = kmsKeyId.(string)
+		}
+		copies[i] = copyTarget
+	}
+
+	for _, item := range copies {
+		copyTarget := item.(map[string]interface{})
+		request := oci_core.CopyVolumeGroupBackupRequest{}
+
+		tmp := s.D.Id()
+		request.VolumeGroupBackupId = &tmp
+
+		region := copyTarget["region"].(string)
+		request.DestinationRegion = &region
+
+		if displayName, ok := copyTarget["display_name"]; ok {
+			tmp := displayName.(string)
+			request.DisplayName = &tmp
+		}
+
+		if kmsKeyId, ok := copyTarget["kms_key_id"]; ok {
+			tmp := kmsKeyId.(string)
+			request.KmsKeyId = &tmp
+		}
+
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		response, err := s.Client.CopyVolumeGroupBackup(context.Background(), request)
+		if err != nil {
+			return fmt.Errorf("failed to copy the volume group backup %s to region %s: %v", s.D.Id(), region, err)
+		}
+
+		copyTarget["volume_group_backup_id"] = *response.Id
+		s.D.Set("copy_to_region", copies)
+	}
+
+	for _, item := range copies {
+		copyTarget := item.(map[string]interface{})
+		err := s.waitForRegionCopy(copyTarget["region"].(string), copyTarget["volume_group_backup_id"].(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *CoreVolumeGroupBackupResourceCrud) waitForRegionCopy(region string, volumeGroupBackupId string) error {
+	err := s.createBlockStorageSourceRegionClient(region)
+	if err != nil {
+		return err
+	}
+	regionClient := s.SourceRegionClient
+
+	stateConf := &resource.StateChangeConf{
+		Pending: s.CreatedPending(),
+		Target:  s.CreatedTarget(),
+		Refresh: func() (interface{}, string, error) {
+			request := oci_core.GetVolumeGroupBackupRequest{}
+			request.VolumeGroupBackupId = &volumeGroupBackupId
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "core")
+
+			response, err := regionClient.GetVolumeGroupBackup(context.Background(), request)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return response.VolumeGroupBackup, string(response.LifecycleState), nil
+		},
+		Timeout: s.D.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("the copy %s of the volume group backup in region %s did not become available: %v", volumeGroupBackupId, region, err)
+	}
+
+	return nil
+}
+
+// deleteRegionCopies deletes the copies made for copy_to_region, they are owned by this resource
+func (s *CoreVolumeGroupBackupResourceCrud) deleteRegionCopies() error {
+	copyToRegion, ok := s.D.GetOkExists("copy_to_region")
+	if !ok {
+		return nil
+	}
+
+	for _, item := range copyToRegion.([]interface{}) {
+		copyTarget := item.(map[string]interface{})
+		volumeGroupBackupId, _ := copyTarget["volume_group_backup_id"].(string)
+		if volumeGroupBackupId == "" {
+			continue
+		}
+
+		err := s.createBlockStorageSourceRegionClient(copyTarget["region"].(string))
+		if err != nil {
+			return err
+		}
+
+		request := oci_core.DeleteVolumeGroupBackupRequest{}
+		request.VolumeGroupBackupId = &volumeGroupBackupId
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		_, err = s.SourceRegionClient.DeleteVolumeGroupBackup(context.Background(), request)
+		if err != nil {
+			if failure, isServiceError := oci_common.IsServiceError(err); isServiceError && failure.GetHTTPStatusCode() == 404 {
+				continue
+			}
+			return err
+		}
+	}
+
+	return nil
+}
@@ -24,11 +24,12 @@ func CoreVolumeResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createCoreVolume,
-		Read:     readCoreVolume,
-		Update:   updateCoreVolume,
-		Delete:   deleteCoreVolume,
+		Timeouts:      tfresource.DefaultTimeout,
+		Create:        createCoreVolume,
+		Read:          readCoreVolume,
+		Update:        updateCoreVolume,
+		Delete:        deleteCoreVolume,
+		CustomizeDiff: validateAutotunePolicies,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -38,8 +39,9 @@ func CoreVolumeResource() *schema.Resource {
 				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 
 			// Optional
@@ -969,3 +971,27 @@ func (s *CoreVolumeResourceCrud) updateCompartment(compartment interface{}) erro
 
 	return nil
 }
+
+// validateAutotunePolicies fails the plan when a performance based autotune policy of the config has no
+// max_vpus_per_gb, every policy in the list is checked
+func validateAutotunePolicies(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	autotunePolicies := rawConfig.GetAttr("autotune_policies")
+	if autotunePolicies.IsNull() || !autotunePolicies.IsKnown() {
+		return nil
+	}
+
+	for i := 0; i < autotunePolicies.LengthInt(); i++ {
+		autotuneType := fmt.Sprintf("autotune_policies.%d.autotune_type", i)
+		err := tfresource.RequiredWhen(
+			tfresource.AllOf(tfresource.AttributeIsConfigured(autotuneType), tfresource.AttributeIn(autotuneType, "PERFORMANCE_BASED")),
+			autotuneType+" is PERFORMANCE_BASED", fmt.Sprintf("autotune_policies.%d.max_vpus_per_gb", i))(ctx, d, meta)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -34,8 +34,9 @@ func CoreVtapResource() *schema.Resource {
 				Required: true,
 			},
 			"compartment_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: tfresource.ValidateOcid("compartment", "tenancy"),
 			},
 			"source_id": {
 				Type:     schema.TypeString,
@@ -201,6 +202,18 @@ func (s *CoreVtapResourceCrud) CreatedTarget() []string {
 	}
 }
 
+func (s *CoreVtapResourceCrud) UpdatedPending() []string {
+	return []string{
+		string(oci_core.VtapLifecycleStateUpdating),
+	}
+}
+
+func (s *CoreVtapResourceCrud) UpdatedTarget() []string {
+	return []string{
+		string(oci_core.VtapLifecycleStateAvailable),
+	}
+}
+
 func (s *CoreVtapResourceCrud) DeletedPending() []string {
 	return []string{
 		string(oci_core.VtapLifecycleStateTerminating),
@@ -440,9 +453,27 @@ func (s *CoreVtapResourceCrud) Update() error {
 		return err
 	}
 
+	if isVtapEnabled, ok := s.D.GetOkExists("is_vtap_enabled"); ok && s.D.HasChange("is_vtap_enabled") {
+		return s.waitForMirroringState(isVtapEnabled.(bool))
+	}
+
 	return s.Get()
 }
 
+// waitForMirroringState waits for the traffic mirroring of the VTAP to start or stop after is_vtap_enabled was changed
+func (s *CoreVtapResourceCrud) waitForMirroringState(isVtapEnabled bool) error {
+	expectedState := oci_core.VtapLifecycleStateDetailsStopped
+	if isVtapEnabled {
+		expectedState = oci_core.VtapLifecycleStateDetailsRunning
+	}
+
+	mirroringStateFunc := func() bool {
+		return s.Res.LifecycleState == oci_core.VtapLifecycleStateAvailable && s.Res.LifecycleStateDetails == expectedState
+	}
+
+	return tfresource.WaitForResourceCondition(s, mirroringStateFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
+
 func (s *CoreVtapResourceCrud) Delete() error {
 	request := oci_core.DeleteVtapRequest{}
 
@@ -133,6 +133,7 @@ func RegisterDatasource() {
 	tfresource.RegisterDatasource("oci_core_tunnel_security_associations", CoreTunnelSecurityAssociationsDataSource())
 	tfresource.RegisterDatasource("oci_core_vcn", CoreVcnDataSource())
 	tfresource.RegisterDatasource("oci_core_vcn_dns_resolver_association", CoreVcnDnsResolverAssociationDataSource())
+	tfresource.RegisterDatasource("oci_core_vcn_ip_inventory", CoreVcnIpInventoryDataSource())
 	tfresource.RegisterDatasource("oci_core_vcns", CoreVcnsDataSource())
 	tfresource.RegisterDatasource("oci_core_virtual_circuit", CoreVirtualCircuitDataSource())
 	tfresource.RegisterDatasource("oci_core_virtual_circuit_associated_tunnels", CoreVirtualCircuitAssociatedTunnelsDataSource())
@@ -10,6 +10,8 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_core_app_catalog_subscription", CoreAppCatalogSubscriptionResource())
 	tfresource.RegisterResource("oci_core_boot_volume", CoreBootVolumeResource())
 	tfresource.RegisterResource("oci_core_boot_volume_backup", CoreBootVolumeBackupResource())
+	tfresource.RegisterResource("oci_core_bulk_volume_attachment", CoreBulkVolumeAttachmentResource())
+	tfresource.RegisterResource("oci_core_byoip_range", CoreByoipRangeResource())
 	tfresource.RegisterResource("oci_core_capture_filter", CoreCaptureFilterResource())
 	tfresource.RegisterResource("oci_core_cluster_network", CoreClusterNetworkResource())
 	tfresource.RegisterResource("oci_core_compute_capacity_report", CoreComputeCapacityReportResource())
@@ -32,10 +34,12 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_core_drg_attachment_management", CoreDrgAttachmentManagementResource())
 	tfresource.RegisterResource("oci_core_drg_route_distribution", CoreDrgRouteDistributionResource())
 	tfresource.RegisterResource("oci_core_drg_route_distribution_statement", CoreDrgRouteDistributionStatementResource())
+	tfresource.RegisterResource("oci_core_drg_route_distribution_statements", CoreDrgRouteDistributionStatementsResource())
 	tfresource.RegisterResource("oci_core_drg_route_table", CoreDrgRouteTableResource())
 	tfresource.RegisterResource("oci_core_drg_route_table_route_rule", CoreDrgRouteTableRouteRuleResource())
 	tfresource.RegisterResource("oci_core_image", CoreImageResource())
 	tfresource.RegisterResource("oci_core_instance", CoreInstanceResource())
+	tfresource.RegisterResource("oci_core_instance_action", CoreInstanceActionResource())
 	tfresource.RegisterResource("oci_core_instance_configuration", CoreInstanceConfigurationResource())
 	tfresource.RegisterResource("oci_core_instance_console_connection", CoreInstanceConsoleConnectionResource())
 	tfresource.RegisterResource("oci_core_instance_maintenance_event", CoreInstanceMaintenanceEventResource())
@@ -50,14 +54,17 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_core_nat_gateway", CoreNatGatewayResource())
 	tfresource.RegisterResource("oci_core_network_security_group", CoreNetworkSecurityGroupResource())
 	tfresource.RegisterResource("oci_core_network_security_group_security_rule", CoreNetworkSecurityGroupSecurityRuleResource())
+	tfresource.RegisterResource("oci_core_network_security_group_security_rules", CoreNetworkSecurityGroupSecurityRulesResource())
 	tfresource.RegisterResource("oci_core_private_ip", CorePrivateIpResource())
 	tfresource.RegisterResource("oci_core_public_ip", CorePublicIpResource())
 	tfresource.RegisterResource("oci_core_public_ip_pool", CorePublicIpPoolResource())
 	tfresource.RegisterResource("oci_core_public_ip_pool_capacity", PublicIpPoolCapacityResource())
 	tfresource.RegisterResource("oci_core_remote_peering_connection", CoreRemotePeeringConnectionResource())
 	tfresource.RegisterResource("oci_core_route_table", CoreRouteTableResource())
+	tfresource.RegisterResource("oci_core_route_table_rule", CoreRouteTableRuleResource())
 	tfresource.RegisterResource("oci_core_route_table_attachment", CoreRouteTableAttachmentResource())
 	tfresource.RegisterResource("oci_core_security_list", CoreSecurityListResource())
+	tfresource.RegisterResource("oci_core_security_list_rule", CoreSecurityListRuleResource())
 	tfresource.RegisterResource("oci_core_service_gateway", CoreServiceGatewayResource())
 	tfresource.RegisterResource("oci_core_shape_management", CoreShapeResource())
 	tfresource.RegisterResource("oci_core_subnet", CoreSubnetResource())
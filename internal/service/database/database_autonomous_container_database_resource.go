@@ -6,13 +6,17 @@ package database
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -31,6 +35,10 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 		Read:   readDatabaseAutonomousContainerDatabase,
 		Update: updateDatabaseAutonomousContainerDatabase,
 		Delete: deleteDatabaseAutonomousContainerDatabase,
+		CustomizeDiff: customdiff.ForceNewIfChange("db_version", func(ctx context.Context, old, new, meta interface{}) bool {
+			// release updates within the same major version are applied in place by Update
+			return strings.Split(old.(string), ".")[0] != strings.Split(new.(string), ".")[0]
+		}),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"display_name": {
@@ -38,8 +46,9 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 				Required: true,
 			},
 			"patch_model": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(oci_database.GetUpdateAutonomousContainerDatabaseDetailsPatchModelEnumStringValues(), false),
 			},
 
 			// Optional
@@ -151,10 +160,10 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"db_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: autonomousContainerDatabaseDbVersionDiffSuppress,
 			},
 			"defined_tags": {
 				Type:             schema.TypeMap,
@@ -286,9 +295,10 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 							Computed: true,
 						},
 						"preference": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(oci_database.GetMaintenanceWindowPreferenceEnumStringValues(), false),
 						},
 						"skip_ru": {
 							Type:     schema.TypeList,
@@ -452,9 +462,10 @@ func DatabaseAutonomousContainerDatabaseResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"version_preference": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(oci_database.GetUpdateAutonomousContainerDatabaseDetailsVersionPreferenceEnumStringValues(), false),
 			},
 			"vm_failover_reservation": {
 				Type:     schema.TypeInt,
@@ -1118,9 +1129,96 @@ func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Update() error {
 	}
 
 	s.Res = &response.AutonomousContainerDatabase
+
+	if dbVersion, ok := s.D.GetOkExists("db_version"); ok && s.D.HasChange("db_version") {
+		return s.applyReleaseUpdate(dbVersion.(string))
+	}
+
 	return nil
 }
 
+// applyReleaseUpdate patches the container database to dbVersion by running its next maintenance run now. Only the
+// release update scheduled by that maintenance run can be applied.
+func (s *DatabaseAutonomousContainerDatabaseResourceCrud) applyReleaseUpdate(dbVersion string) error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+	if s.Res.NextMaintenanceRunId == nil {
+		return fmt.Errorf("cannot update db_version to %s, no maintenance run is scheduled for the autonomous container database", dbVersion)
+	}
+
+	maintenanceRunRequest := oci_database.GetMaintenanceRunRequest{}
+	maintenanceRunRequest.MaintenanceRunId = s.Res.NextMaintenanceRunId
+	maintenanceRunRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	maintenanceRunResponse, err := s.Client.GetMaintenanceRun(context.Background(), maintenanceRunRequest)
+	if err != nil {
+		return err
+	}
+	if maintenanceRunResponse.PatchId == nil {
+		return fmt.Errorf("cannot update db_version to %s, the next maintenance run %s does not apply a release update", dbVersion, *s.Res.NextMaintenanceRunId)
+	}
+
+	patchRequest := oci_database.GetAutonomousPatchRequest{}
+	patchRequest.AutonomousPatchId = maintenanceRunResponse.PatchId
+	patchRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	patchResponse, err := s.Client.GetAutonomousPatch(context.Background(), patchRequest)
+	if err != nil {
+		return err
+	}
+	if patchResponse.Version == nil || *patchResponse.Version != dbVersion {
+		patchVersion := ""
+		if patchResponse.Version != nil {
+			patchVersion = *patchResponse.Version
+		}
+		return fmt.Errorf("cannot update db_version to %s, the next maintenance run %s applies version %s", dbVersion, *s.Res.NextMaintenanceRunId, patchVersion)
+	}
+
+	updateMaintenanceRunRequest := oci_database.UpdateMaintenanceRunRequest{}
+	updateMaintenanceRunRequest.MaintenanceRunId = s.Res.NextMaintenanceRunId
+	isPatchNowEnabled := true
+	updateMaintenanceRunRequest.IsPatchNowEnabled = &isPatchNowEnabled
+	updateMaintenanceRunRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	if _, err := s.Client.UpdateMaintenanceRun(context.Background(), updateMaintenanceRunRequest); err != nil {
+		return err
+	}
+
+	releaseUpdateApplied := func() bool {
+		return s.Res.DbVersion != nil && *s.Res.DbVersion == dbVersion && s.Res.LifecycleState == oci_database.AutonomousContainerDatabaseLifecycleStateAvailable
+	}
+	return tfresource.WaitForResourceCondition(s, releaseUpdateApplied, s.D.Timeout(schema.TimeoutUpdate))
+}
+
+// autonomousContainerDatabaseDbVersionDiffSuppress ignores a configured db_version that is older than the current one
+// within the same major version, since maintenance runs apply release updates outside of Terraform and a container
+// database cannot be downgraded. Newer versions are applied in place and other major versions force a new resource.
+func autonomousContainerDatabaseDbVersionDiffSuppress(key string, old string, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	oldVersion := strings.Split(old, ".")
+	newVersion := strings.Split(new, ".")
+	if oldVersion[0] != newVersion[0] {
+		return false
+	}
+	for i := 1; i < len(oldVersion) && i < len(newVersion); i++ {
+		oldPart, err := strconv.Atoi(oldVersion[i])
+		if err != nil {
+			return false
+		}
+		newPart, err := strconv.Atoi(newVersion[i])
+		if err != nil {
+			return false
+		}
+		if oldPart != newPart {
+			return newPart < oldPart
+		}
+	}
+	return false
+}
+
 func (s *DatabaseAutonomousContainerDatabaseResourceCrud) Delete() error {
 	request := oci_database.TerminateAutonomousContainerDatabaseRequest{}
 
@@ -5,6 +5,7 @@ package database
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
@@ -43,6 +44,10 @@ func DatabaseAutonomousDatabaseInstanceWalletManagementResource() *schema.Resour
 				Optional: true,
 				Default:  false,
 			},
+			"rotation_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 
 			// Computed
 			"state": {
@@ -174,6 +179,16 @@ func (s *DatabaseAutonomousDatabaseInstanceWalletManagementResourceCrud) Update(
 		request.ShouldRotate = &tmp
 	}
 
+	if _, ok := s.D.GetOkExists("rotation_trigger"); ok && s.D.HasChange("rotation_trigger") && !s.D.IsNewResource() {
+		oldRaw, newRaw := s.D.GetChange("rotation_trigger")
+		if oldRaw.(int) >= newRaw.(int) {
+			s.D.Set("rotation_trigger", oldRaw)
+			return fmt.Errorf("new value of rotation_trigger should be greater than the old value")
+		}
+		shouldRotate := true
+		request.ShouldRotate = &shouldRotate
+	}
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
 	_, err := s.Client.UpdateAutonomousDatabaseWallet(context.Background(), request)
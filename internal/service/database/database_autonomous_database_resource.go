@@ -18,6 +18,8 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -41,6 +43,17 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 		Read:   readDatabaseAutonomousDatabase,
 		Update: updateDatabaseAutonomousDatabase,
 		Delete: deleteDatabaseAutonomousDatabase,
+		CustomizeDiff: customdiff.All(
+			tfresource.RequiredWhen(tfresource.AttributeIn("source", "BACKUP_FROM_ID"), "source is BACKUP_FROM_ID", "autonomous_database_backup_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("source", "BACKUP_FROM_TIMESTAMP"), "source is BACKUP_FROM_TIMESTAMP", "autonomous_database_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("source", "BACKUP_FROM_TIMESTAMP"), "source is BACKUP_FROM_TIMESTAMP", "timestamp", "use_latest_available_backup_time_stamp"),
+			tfresource.ConflictsWhen(tfresource.AttributeIsTrue("use_latest_available_backup_time_stamp"), "use_latest_available_backup_time_stamp is true", "timestamp"),
+			tfresource.RequiredWhen(
+				tfresource.AttributeIn("source", "CLONE_TO_REFRESHABLE", "CROSS_REGION_DATAGUARD", "CROSS_REGION_DISASTER_RECOVERY", "CROSS_TENANCY_DISASTER_RECOVERY", "DATABASE", "UNDELETE_ADB"),
+				"source is CLONE_TO_REFRESHABLE, CROSS_REGION_DATAGUARD, CROSS_REGION_DISASTER_RECOVERY, CROSS_TENANCY_DISASTER_RECOVERY, DATABASE or UNDELETE_ADB", "source_id"),
+			tfresource.ConflictsWhen(tfresource.AttributeIsConfigured("ocpu_count"), "ocpu_count is set", "cpu_core_count"),
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("compute_model", "ECPU"), "compute_model is not ECPU, elastic pools are only available for ECPU databases", "resource_pool_leader_id", "resource_pool_summary"),
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -495,9 +508,10 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 							Computed: true,
 						},
 						"pool_size": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntInSlice([]int{128, 256, 512, 1024, 2048, 4096}),
 						},
 
 						// Computed
@@ -650,6 +664,22 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 				Optional:         true,
 				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 			},
+			"failover_to_remote_peer_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+			},
+			"is_snapshot_standby": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"time_snapshot_standby_enabled_till": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: tfresource.TimeDiffSuppressFunction,
+			},
 			"rotate_key_trigger": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -667,6 +697,15 @@ func DatabaseAutonomousDatabaseResource() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"is_shrink_only"},
 			},
+			"refresh_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"time_refresh_cutoff": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: tfresource.TimeDiffSuppressFunction,
+			},
 
 			// Computed
 			"actual_used_data_storage_size_in_tbs": {
@@ -1526,6 +1565,13 @@ func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		return err
 	}
 
+	if sync.D.HasChange("is_snapshot_standby") || sync.D.HasChange("time_snapshot_standby_enabled_till") {
+		err = sync.updateSnapshotStandby()
+		if err != nil {
+			return err
+		}
+	}
+
 	if _, ok := sync.D.GetOkExists("is_shrink_only"); ok && sync.D.HasChange("is_shrink_only") {
 		oldRaw, newRaw := sync.D.GetChange("is_shrink_only")
 		if !oldRaw.(bool) && newRaw.(bool) {
@@ -1552,6 +1598,18 @@ func updateDatabaseAutonomousDatabase(d *schema.ResourceData, m interface{}) err
 		}
 	}
 
+	if _, ok := sync.D.GetOkExists("refresh_trigger"); ok && sync.D.HasChange("refresh_trigger") {
+		oldRaw, newRaw := sync.D.GetChange("refresh_trigger")
+		if oldRaw.(int) < newRaw.(int) {
+			if err := sync.RefreshAutonomousDatabase(); err != nil {
+				return err
+			}
+		} else {
+			sync.D.Set("refresh_trigger", oldRaw)
+			return fmt.Errorf("new value of refresh_trigger should be greater than the old value")
+		}
+	}
+
 	stateActive, stateInactive := false, false
 
 	if sync.D.HasChange("state") {
@@ -2548,6 +2606,14 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) SetData() error {
 
 	if s.Res.RemoteDisasterRecoveryConfiguration != nil {
 		s.D.Set("remote_disaster_recovery_configuration", []interface{}{DisasterRecoveryConfigurationToMap(s.Res.RemoteDisasterRecoveryConfiguration)})
+
+		if s.Res.RemoteDisasterRecoveryConfiguration.IsSnapshotStandby != nil {
+			s.D.Set("is_snapshot_standby", *s.Res.RemoteDisasterRecoveryConfiguration.IsSnapshotStandby)
+		}
+
+		if s.Res.RemoteDisasterRecoveryConfiguration.TimeSnapshotStandbyEnabledTill != nil {
+			s.D.Set("time_snapshot_standby_enabled_till", s.Res.RemoteDisasterRecoveryConfiguration.TimeSnapshotStandbyEnabledTill.Format(time.RFC3339Nano))
+		}
 	} else {
 		s.D.Set("remote_disaster_recovery_configuration", nil)
 	}
@@ -6024,10 +6090,36 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) validateSwitchoverDatabase() er
 				s.D.Set("switchover_to_remote_peer_id", oldId)
 				return err
 			}
+
+			if err := s.waitForRemotePeer(newId); err != nil {
+				return err
+			}
 		}
 		s.D.Set("switchover_to_remote_peer_id", newId)
 	}
 
+	//	Remote peer failover
+	if _, ok := s.D.GetOkExists("failover_to_remote_peer_id"); ok && s.D.HasChange("failover_to_remote_peer_id") {
+		oldIdRaw, newIdRaw := s.D.GetChange("failover_to_remote_peer_id")
+		oldId := strings.ToLower(strings.TrimSpace(oldIdRaw.(string)))
+		newId := strings.ToLower(strings.TrimSpace(newIdRaw.(string)))
+
+		if newId != "" {
+			_, dgRegionTypeExists := s.D.GetOkExists("dataguard_region_type")
+			_, dgRoleExists := s.D.GetOkExists("role")
+			if !dgRegionTypeExists || !dgRoleExists {
+				return fmt.Errorf("Autonomous Data Guard not found in enabled state")
+			}
+
+			// the peer is not waited for, a failover is usually done because its region is not reachable
+			if err := s.failoverDatabase(newId); err != nil {
+				s.D.Set("failover_to_remote_peer_id", oldId)
+				return err
+			}
+		}
+		s.D.Set("failover_to_remote_peer_id", newId)
+	}
+
 	return nil
 }
 
@@ -6058,6 +6150,145 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) switchoverDatabase(peerDbId str
 	return nil
 }
 
+func (s *DatabaseAutonomousDatabaseResourceCrud) failoverDatabase(peerDbId string) error {
+	request := oci_database.FailOverAutonomousDatabaseRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+	if peerDbId != "" {
+		request.PeerDbId = &peerDbId
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.FailOverAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workId := response.OpcWorkRequestId
+	if workId != nil {
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// waitForRemotePeer waits in the region of a cross-region peer until the peer completed its role change
+func (s *DatabaseAutonomousDatabaseResourceCrud) waitForRemotePeer(peerDbId string) error {
+	region, err := s.getPeerRegion(peerDbId)
+	if err != nil {
+		return err
+	}
+	if region == "" {
+		log.Printf("[WARN] the region of the peer %s of the Autonomous Database %s was not found, not waiting for the peer", peerDbId, s.D.Id())
+		return nil
+	}
+
+	regionClient, err := newDatabaseRegionClient(s.Client, region)
+	if err != nil {
+		return err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(oci_database.AutonomousDatabaseLifecycleStateRoleChangeInProgress),
+			string(oci_database.AutonomousDatabaseLifecycleStateUpdating),
+			string(oci_database.AutonomousDatabaseLifecycleStateRestarting),
+		},
+		Target: []string{
+			string(oci_database.AutonomousDatabaseLifecycleStateAvailable),
+			string(oci_database.AutonomousDatabaseLifecycleStateStandby),
+		},
+		Refresh: func() (interface{}, string, error) {
+			request := oci_database.GetAutonomousDatabaseRequest{}
+			request.AutonomousDatabaseId = &peerDbId
+			request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+
+			response, err := regionClient.GetAutonomousDatabase(context.Background(), request)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return response.AutonomousDatabase, string(response.LifecycleState), nil
+		},
+		Timeout: s.D.Timeout(schema.TimeoutUpdate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("the peer %s of the Autonomous Database in region %s did not complete its role change: %v", peerDbId, region, err)
+	}
+
+	return nil
+}
+
+func (s *DatabaseAutonomousDatabaseResourceCrud) getPeerRegion(peerDbId string) (string, error) {
+	request := oci_database.ListAutonomousDatabasePeersRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	for {
+		response, err := s.Client.ListAutonomousDatabasePeers(context.Background(), request)
+		if err != nil {
+			return "", err
+		}
+
+		for _, peer := range response.Items {
+			if peer.Id != nil && strings.EqualFold(*peer.Id, peerDbId) && peer.Region != nil {
+				return *peer.Region, nil
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			return "", nil
+		}
+		request.Page = response.OpcNextPage
+	}
+}
+
+// updateSnapshotStandby converts a cross-region standby to a snapshot standby or back to a physical standby
+func (s *DatabaseAutonomousDatabaseResourceCrud) updateSnapshotStandby() error {
+	request := oci_database.ChangeDisasterRecoveryConfigurationRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+
+	if isSnapshotStandby, ok := s.D.GetOkExists("is_snapshot_standby"); ok {
+		tmp := isSnapshotStandby.(bool)
+		request.IsSnapshotStandby = &tmp
+	}
+
+	if timeSnapshotStandbyEnabledTill, ok := s.D.GetOkExists("time_snapshot_standby_enabled_till"); ok && timeSnapshotStandbyEnabledTill.(string) != "" {
+		tmp, err := time.Parse(time.RFC3339, timeSnapshotStandbyEnabledTill.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeSnapshotStandbyEnabledTill = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.ChangeDisasterRecoveryConfiguration(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workId := response.OpcWorkRequestId
+	if workId != nil {
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *DatabaseAutonomousDatabaseResourceCrud) updateOperationsInsightsStatus(autonomousDatabaseId string, operationsInsightsStatus oci_database.AutonomousDatabaseOperationsInsightsStatusEnum) error {
 	switch operationsInsightsStatus {
 	case oci_database.AutonomousDatabaseOperationsInsightsStatusEnabled:
@@ -6192,6 +6423,39 @@ func (s *DatabaseAutonomousDatabaseResourceCrud) ShrinkAutonomousDatabase(state
 	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
 
+// RefreshAutonomousDatabase refreshes a refreshable clone with the data of its source database on demand
+func (s *DatabaseAutonomousDatabaseResourceCrud) RefreshAutonomousDatabase() error {
+	request := oci_database.AutonomousDatabaseManualRefreshRequest{}
+
+	tmp := s.D.Id()
+	request.AutonomousDatabaseId = &tmp
+
+	if timeRefreshCutoff, ok := s.D.GetOkExists("time_refresh_cutoff"); ok && timeRefreshCutoff.(string) != "" {
+		tmp, err := time.Parse(time.RFC3339, timeRefreshCutoff.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeRefreshCutoff = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.AutonomousDatabaseManualRefresh(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workId := response.OpcWorkRequestId
+	if workId != nil {
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *DatabaseAutonomousDatabaseResourceCrud) updateOpenModeAndPermission(autonomousDatabaseId string, openMode oci_database.UpdateAutonomousDatabaseDetailsOpenModeEnum, permissionLevel oci_database.UpdateAutonomousDatabaseDetailsPermissionLevelEnum) error {
 	updateRequest := oci_database.UpdateAutonomousDatabaseRequest{}
 	updateRequest.AutonomousDatabaseId = &autonomousDatabaseId
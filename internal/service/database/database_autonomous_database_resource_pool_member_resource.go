@@ -0,0 +1,152 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	oci_database "github.com/oracle/oci-go-sdk/v65/database"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func DatabaseAutonomousDatabaseResourcePoolMemberResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createDatabaseAutonomousDatabaseResourcePoolMember,
+		Read:     readDatabaseAutonomousDatabaseResourcePoolMember,
+		Delete:   deleteDatabaseAutonomousDatabaseResourcePoolMember,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"autonomous_database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_pool_leader_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+
+			// Computed
+			"time_of_joining_resource_pool": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDatabaseAutonomousDatabaseResourcePoolMember(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readDatabaseAutonomousDatabaseResourcePoolMember(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func deleteDatabaseAutonomousDatabaseResourcePoolMember(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.AutonomousDatabase
+	WorkRequestClient      *oci_work_requests.WorkRequestClient
+	DisableNotFoundRetries bool
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud) ID() string {
+	return "resource-pool-member-" + s.D.Get("autonomous_database_id").(string)
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud) Create() error {
+	autonomousDatabaseId := s.D.Get("autonomous_database_id").(string)
+
+	if err := validateAutonomousDatabaseResourcePoolComputeModel(s.Client, autonomousDatabaseId, s.DisableNotFoundRetries); err != nil {
+		return err
+	}
+
+	resourcePoolLeaderId := s.D.Get("resource_pool_leader_id").(string)
+	response, err := updateAutonomousDatabaseResourcePool(s.Client, s.WorkRequestClient, oci_database.UpdateAutonomousDatabaseDetails{
+		ResourcePoolLeaderId: &resourcePoolLeaderId,
+	}, autonomousDatabaseId, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+	if err != nil {
+		return err
+	}
+
+	s.Res = response
+	return nil
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud) Get() error {
+	request := oci_database.GetAutonomousDatabaseRequest{}
+
+	tmp := s.D.Get("autonomous_database_id").(string)
+	request.AutonomousDatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.AutonomousDatabase
+	return nil
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud) Delete() error {
+	// The service removes a member from its pool when the leader is set to a blank value
+	resourcePoolLeaderId := " "
+	_, err := updateAutonomousDatabaseResourcePool(s.Client, s.WorkRequestClient, oci_database.UpdateAutonomousDatabaseDetails{
+		ResourcePoolLeaderId: &resourcePoolLeaderId,
+	}, s.D.Get("autonomous_database_id").(string), s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+
+	return err
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolMemberResourceCrud) SetData() error {
+	// The database left the pool or joined another one outside of Terraform, remove it from the state
+	// so that it joins the configured pool again on next apply
+	if s.Res.ResourcePoolLeaderId == nil || *s.Res.ResourcePoolLeaderId != s.D.Get("resource_pool_leader_id").(string) {
+		s.VoidState()
+		return nil
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("autonomous_database_id", *s.Res.Id)
+	}
+
+	s.D.Set("resource_pool_leader_id", *s.Res.ResourcePoolLeaderId)
+
+	if s.Res.TimeOfJoiningResourcePool != nil {
+		s.D.Set("time_of_joining_resource_pool", s.Res.TimeOfJoiningResourcePool.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_database "github.com/oracle/oci-go-sdk/v65/database"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func DatabaseAutonomousDatabaseResourcePoolResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createDatabaseAutonomousDatabaseResourcePool,
+		Read:     readDatabaseAutonomousDatabaseResourcePool,
+		Update:   updateDatabaseAutonomousDatabaseResourcePool,
+		Delete:   deleteDatabaseAutonomousDatabaseResourcePool,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"autonomous_database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pool_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{128, 256, 512, 1024, 2048, 4096}),
+			},
+
+			// Optional
+
+			// Computed
+			"compute_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDatabaseAutonomousDatabaseResourcePool(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readDatabaseAutonomousDatabaseResourcePool(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateDatabaseAutonomousDatabaseResourcePool(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteDatabaseAutonomousDatabaseResourcePool(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type DatabaseAutonomousDatabaseResourcePoolResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	Res                    *oci_database.AutonomousDatabase
+	WorkRequestClient      *oci_work_requests.WorkRequestClient
+	DisableNotFoundRetries bool
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolResourceCrud) ID() string {
+	return "resource-pool-" + s.D.Get("autonomous_database_id").(string)
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolResourceCrud) Create() error {
+	autonomousDatabaseId := s.D.Get("autonomous_database_id").(string)
+
+	if err := validateAutonomousDatabaseResourcePoolComputeModel(s.Client, autonomousDatabaseId, s.DisableNotFoundRetries); err != nil {
+		return err
+	}
+
+	poolSize := s.D.Get("pool_size").(int)
+	isDisabled := false
+	response, err := updateAutonomousDatabaseResourcePool(s.Client, s.WorkRequestClient, oci_database.UpdateAutonomousDatabaseDetails{
+		ResourcePoolSummary: &oci_database.ResourcePoolSummary{
+			PoolSize:   &poolSize,
+			IsDisabled: &isDisabled,
+		},
+	}, autonomousDatabaseId, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+	if err != nil {
+		return err
+	}
+
+	s.Res = response
+	return nil
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolResourceCrud) Get() error {
+	request := oci_database.GetAutonomousDatabaseRequest{}
+
+	tmp := s.D.Get("autonomous_database_id").(string)
+	request.AutonomousDatabaseId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.AutonomousDatabase
+	return nil
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolResourceCrud) Update() error {
+	poolSize := s.D.Get("pool_size").(int)
+	response, err := updateAutonomousDatabaseResourcePool(s.Client, s.WorkRequestClient, oci_database.UpdateAutonomousDatabaseDetails{
+		ResourcePoolSummary: &oci_database.ResourcePoolSummary{
+			PoolSize: &poolSize,
+		},
+	}, s.D.Get("autonomous_database_id").(string), s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	if err != nil {
+		return err
+	}
+
+	s.Res = response
+	return nil
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolResourceCrud) Delete() error {
+	isDisabled := true
+	_, err := updateAutonomousDatabaseResourcePool(s.Client, s.WorkRequestClient, oci_database.UpdateAutonomousDatabaseDetails{
+		ResourcePoolSummary: &oci_database.ResourcePoolSummary{
+			IsDisabled: &isDisabled,
+		},
+	}, s.D.Get("autonomous_database_id").(string), s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries)
+
+	return err
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolResourceCrud) SetData() error {
+	// The pool was disabled outside of Terraform, remove it from the state so that it is recreated on next apply
+	if s.Res.ResourcePoolSummary == nil || (s.Res.ResourcePoolSummary.IsDisabled != nil && *s.Res.ResourcePoolSummary.IsDisabled) {
+		s.VoidState()
+		return nil
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("autonomous_database_id", *s.Res.Id)
+	}
+
+	s.D.Set("compute_model", s.Res.ComputeModel)
+
+	if s.Res.ResourcePoolSummary.PoolSize != nil {
+		s.D.Set("pool_size", *s.Res.ResourcePoolSummary.PoolSize)
+	}
+
+	return nil
+}
+
+// Elastic pools are only available for databases using the ECPU compute model, check it before
+// creating the pool or joining it to fail with a clear error instead of a generic service error.
+func validateAutonomousDatabaseResourcePoolComputeModel(client *oci_database.DatabaseClient, autonomousDatabaseId string, disableNotFoundRetries bool) error {
+	request := oci_database.GetAutonomousDatabaseRequest{}
+	request.AutonomousDatabaseId = &autonomousDatabaseId
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(disableNotFoundRetries, "database")
+
+	response, err := client.GetAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if response.ComputeModel != oci_database.AutonomousDatabaseComputeModelEcpu {
+		return fmt.Errorf("autonomous database %s uses the %s compute model, elastic pools are only available for ECPU databases", autonomousDatabaseId, response.ComputeModel)
+	}
+
+	return nil
+}
+
+func updateAutonomousDatabaseResourcePool(client *oci_database.DatabaseClient, workRequestClient *oci_work_requests.WorkRequestClient, details oci_database.UpdateAutonomousDatabaseDetails, autonomousDatabaseId string, timeout time.Duration, disableNotFoundRetries bool) (*oci_database.AutonomousDatabase, error) {
+	request := oci_database.UpdateAutonomousDatabaseRequest{}
+	request.AutonomousDatabaseId = &autonomousDatabaseId
+	request.UpdateAutonomousDatabaseDetails = details
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(disableNotFoundRetries, "database")
+
+	response, err := client.UpdateAutonomousDatabase(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	workId := response.OpcWorkRequestId
+	if workId != nil {
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(workRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, timeout, disableNotFoundRetries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	getRequest := oci_database.GetAutonomousDatabaseRequest{}
+	getRequest.AutonomousDatabaseId = &autonomousDatabaseId
+
+	getRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(disableNotFoundRetries, "database")
+
+	getResponse, err := client.GetAutonomousDatabase(context.Background(), getRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &getResponse.AutonomousDatabase, nil
+}
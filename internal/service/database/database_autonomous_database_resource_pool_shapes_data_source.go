@@ -0,0 +1,113 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_database "github.com/oracle/oci-go-sdk/v65/database"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func DatabaseAutonomousDatabaseResourcePoolShapesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readDatabaseAutonomousDatabaseResourcePoolShapes,
+		Schema: map[string]*schema.Schema{
+			"filter": tfresource.DataSourceFiltersSchema(),
+			"resource_pool_shapes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"shape": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readDatabaseAutonomousDatabaseResourcePoolShapes(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseAutonomousDatabaseResourcePoolShapesDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type DatabaseAutonomousDatabaseResourcePoolShapesDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_database.DatabaseClient
+	Res    *oci_database.ResourcePoolShapesResponse
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolShapesDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolShapesDataSourceCrud) Get() error {
+	request := oci_database.ResourcePoolShapesRequest{}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "database")
+
+	response, err := s.Client.ResourcePoolShapes(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	request.Page = s.Res.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ResourcePoolShapes(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res.Items = append(s.Res.Items, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	return nil
+}
+
+func (s *DatabaseAutonomousDatabaseResourcePoolShapesDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(tfresource.GenerateDataSourceHashID("DatabaseAutonomousDatabaseResourcePoolShapesDataSource-", DatabaseAutonomousDatabaseResourcePoolShapesDataSource(), s.D))
+	resources := []map[string]interface{}{}
+
+	for _, r := range s.Res.Items {
+		resourcePoolShape := map[string]interface{}{}
+
+		if r.Shape != nil {
+			resourcePoolShape["shape"] = *r.Shape
+		}
+
+		resources = append(resources, resourcePoolShape)
+	}
+
+	if f, fOk := s.D.GetOkExists("filter"); fOk {
+		resources = tfresource.ApplyFilters(f.(*schema.Set), resources, DatabaseAutonomousDatabaseResourcePoolShapesDataSource().Schema["resource_pool_shapes"].Elem.(*schema.Resource).Schema)
+	}
+
+	if err := s.D.Set("resource_pool_shapes", resources); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 )
@@ -29,8 +30,13 @@ func DatabaseCloudDatabaseManagementResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"management_type": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.EnableDatabaseManagementDetailsManagementTypeBasic),
+					string(oci_database.EnableDatabaseManagementDetailsManagementTypeAdvanced),
+				}, true),
 			},
 			"private_end_point_id": {
 				Type:     schema.TypeString,
@@ -79,6 +85,12 @@ func DatabaseCloudDatabaseManagementResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+
+			// Computed
+			"management_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -102,7 +114,11 @@ func updateDatabaseCloudDatabaseManagement(d *schema.ResourceData, m interface{}
 }
 
 func readDatabaseCloudDatabaseManagement(d *schema.ResourceData, m interface{}) error {
-	return nil
+	sync := &DatabaseCloudDatabaseManagementResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+
+	return tfresource.ReadResource(sync)
 }
 
 func deleteDatabaseCloudDatabaseManagement(d *schema.ResourceData, m interface{}) error {
@@ -367,7 +383,26 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) Delete() error {
 	return s.getDatabaseFromWorkRequest(workId, oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate))
 }
 
+// SetData reports the Database Management configuration of the database, so that enabling, disabling or changing
+// the management type outside of Terraform shows up as a diff
 func (s *DatabaseCloudDatabaseManagementResourceCrud) SetData() error {
+	if s.Res == nil || s.Res.DatabaseManagementConfig == nil {
+		return nil
+	}
+
+	config := s.Res.DatabaseManagementConfig
+	s.D.Set("management_status", config.ManagementStatus)
+
+	switch config.ManagementStatus {
+	case oci_database.CloudDatabaseManagementConfigManagementStatusEnabled, oci_database.CloudDatabaseManagementConfigManagementStatusUpdating, oci_database.CloudDatabaseManagementConfigManagementStatusFailedUpdating:
+		s.D.Set("enable_management", true)
+		if config.ManagementType != "" {
+			s.D.Set("management_type", config.ManagementType)
+		}
+	case oci_database.CloudDatabaseManagementConfigManagementStatusDisabled:
+		s.D.Set("enable_management", false)
+	}
+
 	return nil
 }
 
@@ -403,8 +438,14 @@ func (s *DatabaseCloudDatabaseManagementResourceCrud) getDatabaseFromWorkRequest
 func (s *DatabaseCloudDatabaseManagementResourceCrud) Get() error {
 	request := oci_database.GetDatabaseRequest{}
 
-	tmp := s.D.Id()
-	request.DatabaseId = &tmp
+	// the ID of the resource is a hash of its arguments once it was created
+	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
+		tmp := databaseId.(string)
+		request.DatabaseId = &tmp
+	} else {
+		tmp := s.D.Id()
+		request.DatabaseId = &tmp
+	}
 
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
 
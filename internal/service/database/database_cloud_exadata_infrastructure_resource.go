@@ -12,6 +12,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -32,6 +33,10 @@ func DatabaseCloudExadataInfrastructureResource() *schema.Resource {
 		Read:   readDatabaseCloudExadataInfrastructure,
 		Update: updateDatabaseCloudExadataInfrastructure,
 		Delete: deleteDatabaseCloudExadataInfrastructure,
+		CustomizeDiff: customdiff.All(
+			tfresource.ErrorWhen(tfresource.AttributeDecreased("compute_count"), "compute_count can only be increased, database servers cannot be removed from a cloud Exadata infrastructure"),
+			tfresource.ErrorWhen(tfresource.AttributeDecreased("storage_count"), "storage_count can only be increased, storage servers cannot be removed from a cloud Exadata infrastructure"),
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
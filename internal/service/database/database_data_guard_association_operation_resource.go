@@ -0,0 +1,219 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_database "github.com/oracle/oci-go-sdk/v65/database"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+)
+
+func DatabaseDataGuardAssociationOperationResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: tfresource.GetTimeoutDuration("2h"),
+			Delete: tfresource.GetTimeoutDuration("2h"),
+		},
+		Create: createDatabaseDataGuardAssociationOperation,
+		Read:   readDatabaseDataGuardAssociationOperation,
+		Update: updateDatabaseDataGuardAssociationOperation,
+		Delete: deleteDatabaseDataGuardAssociationOperation,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"data_guard_association_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database_admin_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"operation": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"failover",
+					"reinstate",
+					"switchover",
+				}, true),
+			},
+
+			// Optional
+			"operation_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"peer_role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDatabaseDataGuardAssociationOperation(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseDataGuardAssociationOperationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readDatabaseDataGuardAssociationOperation(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseDataGuardAssociationOperationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+// The password is only used to run the operation, a new password is kept for the next run without running it again
+func updateDatabaseDataGuardAssociationOperation(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+// A role change cannot be undone by destroying the resource, run the opposite operation instead
+func deleteDatabaseDataGuardAssociationOperation(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type DatabaseDataGuardAssociationOperationResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	WorkRequestClient      *oci_work_requests.WorkRequestClient
+	Res                    *oci_database.DataGuardAssociation
+	DisableNotFoundRetries bool
+}
+
+func (s *DatabaseDataGuardAssociationOperationResourceCrud) ID() string {
+	return fmt.Sprintf("dataGuardAssociations/%s/%s/%d", s.D.Get("data_guard_association_id").(string), strings.ToLower(s.D.Get("operation").(string)), s.D.Get("operation_trigger").(int))
+}
+
+func (s *DatabaseDataGuardAssociationOperationResourceCrud) Create() error {
+	dataGuardAssociationId := s.D.Get("data_guard_association_id").(string)
+	databaseAdminPassword := s.D.Get("database_admin_password").(string)
+	databaseId := s.D.Get("database_id").(string)
+	retryPolicy := tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	var workId *string
+	switch operation := strings.ToLower(s.D.Get("operation").(string)); operation {
+	case "switchover":
+		request := oci_database.SwitchoverDataGuardAssociationRequest{
+			DataGuardAssociationId: &dataGuardAssociationId,
+			DatabaseId:             &databaseId,
+		}
+		request.DatabaseAdminPassword = &databaseAdminPassword
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := s.Client.SwitchoverDataGuardAssociation(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		workId = response.OpcWorkRequestId
+	case "failover":
+		request := oci_database.FailoverDataGuardAssociationRequest{
+			DataGuardAssociationId: &dataGuardAssociationId,
+			DatabaseId:             &databaseId,
+		}
+		request.DatabaseAdminPassword = &databaseAdminPassword
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := s.Client.FailoverDataGuardAssociation(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		workId = response.OpcWorkRequestId
+	case "reinstate":
+		request := oci_database.ReinstateDataGuardAssociationRequest{
+			DataGuardAssociationId: &dataGuardAssociationId,
+			DatabaseId:             &databaseId,
+		}
+		request.DatabaseAdminPassword = &databaseAdminPassword
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := s.Client.ReinstateDataGuardAssociation(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		workId = response.OpcWorkRequestId
+	default:
+		return fmt.Errorf("unknown operation '%v' was specified", operation)
+	}
+
+	if workId != nil {
+		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "database", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the association is updating until both databases have completed the role change
+	return tfresource.WaitForResourceCondition(s, func() bool {
+		return s.Res.LifecycleState == oci_database.DataGuardAssociationLifecycleStateAvailable
+	}, s.D.Timeout(schema.TimeoutCreate))
+}
+
+func (s *DatabaseDataGuardAssociationOperationResourceCrud) Get() error {
+	request := oci_database.GetDataGuardAssociationRequest{}
+
+	if dataGuardAssociationId, ok := s.D.GetOkExists("data_guard_association_id"); ok {
+		tmp := dataGuardAssociationId.(string)
+		request.DataGuardAssociationId = &tmp
+	}
+
+	if databaseId, ok := s.D.GetOkExists("database_id"); ok {
+		tmp := databaseId.(string)
+		request.DatabaseId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDataGuardAssociation(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DataGuardAssociation
+	return nil
+}
+
+func (s *DatabaseDataGuardAssociationOperationResourceCrud) SetData() error {
+	s.D.Set("peer_role", s.Res.PeerRole)
+
+	s.D.Set("role", s.Res.Role)
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	return nil
+}
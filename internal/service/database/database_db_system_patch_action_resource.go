@@ -0,0 +1,225 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_database "github.com/oracle/oci-go-sdk/v65/database"
+	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
+)
+
+func DatabaseDbSystemPatchActionResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: tfresource.GetTimeoutDuration("2h"),
+			Delete: tfresource.GetTimeoutDuration("2h"),
+		},
+		Create: createDatabaseDbSystemPatchAction,
+		Read:   readDatabaseDbSystemPatchAction,
+		Delete: deleteDatabaseDbSystemPatchAction,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"db_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"patch_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"action": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          string(oci_database.PatchDetailsActionApply),
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc:     validation.StringInSlice(oci_database.GetPatchDetailsActionEnumStringValues(), true),
+			},
+
+			// Computed
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"patch_history_entry_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"patch_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_ended": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_started": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDatabaseDbSystemPatchAction(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseDbSystemPatchActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+	sync.WorkRequestClient = m.(*client.OracleClients).WorkRequestClient
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readDatabaseDbSystemPatchAction(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseDbSystemPatchActionResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+// The patch cannot be rolled back by the service, destroying the resource only removes the patch history entry from the state
+func deleteDatabaseDbSystemPatchAction(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type DatabaseDbSystemPatchActionResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_database.DatabaseClient
+	WorkRequestClient      *oci_work_requests.WorkRequestClient
+	Res                    *oci_database.PatchHistoryEntry
+	DisableNotFoundRetries bool
+}
+
+func (s *DatabaseDbSystemPatchActionResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *DatabaseDbSystemPatchActionResourceCrud) Create() error {
+	request := oci_database.UpdateDbSystemRequest{}
+
+	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
+		tmp := dbSystemId.(string)
+		request.DbSystemId = &tmp
+	}
+
+	patchDetails := oci_database.PatchDetails{}
+
+	if action, ok := s.D.GetOkExists("action"); ok {
+		patchDetails.Action = oci_database.PatchDetailsActionEnum(strings.ToUpper(action.(string)))
+	}
+
+	if patchId, ok := s.D.GetOkExists("patch_id"); ok {
+		tmp := patchId.(string)
+		patchDetails.PatchId = &tmp
+	}
+
+	request.Version = &patchDetails
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.UpdateDbSystem(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workId := response.OpcWorkRequestId
+	if workId != nil {
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "dbSystem", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the patch history entry of the action is the last one of the DB system
+	getDbSystemRequest := oci_database.GetDbSystemRequest{
+		DbSystemId: request.DbSystemId,
+	}
+	getDbSystemRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	getDbSystemResponse, err := s.Client.GetDbSystem(context.Background(), getDbSystemRequest)
+	if err != nil {
+		return err
+	}
+
+	if getDbSystemResponse.LastPatchHistoryEntryId == nil {
+		return fmt.Errorf("no patch history entry was recorded for DB system %s", *request.DbSystemId)
+	}
+
+	return s.getPatchHistoryEntry(*getDbSystemResponse.LastPatchHistoryEntryId)
+}
+
+func (s *DatabaseDbSystemPatchActionResourceCrud) Get() error {
+	return s.getPatchHistoryEntry(s.D.Id())
+}
+
+func (s *DatabaseDbSystemPatchActionResourceCrud) getPatchHistoryEntry(patchHistoryEntryId string) error {
+	request := oci_database.GetDbSystemPatchHistoryEntryRequest{}
+
+	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
+		tmp := dbSystemId.(string)
+		request.DbSystemId = &tmp
+	}
+
+	request.PatchHistoryEntryId = &patchHistoryEntryId
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.GetDbSystemPatchHistoryEntry(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.PatchHistoryEntry
+	return nil
+}
+
+func (s *DatabaseDbSystemPatchActionResourceCrud) SetData() error {
+	if s.Res.Action != "" {
+		s.D.Set("action", s.Res.Action)
+	}
+
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("patch_history_entry_id", *s.Res.Id)
+	}
+
+	if s.Res.PatchId != nil {
+		s.D.Set("patch_id", *s.Res.PatchId)
+	}
+
+	s.D.Set("patch_type", s.Res.PatchType)
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeEnded != nil {
+		s.D.Set("time_ended", s.Res.TimeEnded.String())
+	}
+
+	if s.Res.TimeStarted != nil {
+		s.D.Set("time_started", s.Res.TimeStarted.String())
+	}
+
+	return nil
+}
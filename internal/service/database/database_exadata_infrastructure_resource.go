@@ -15,6 +15,7 @@ import (
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
 )
@@ -333,9 +334,10 @@ func DatabaseExadataInfrastructureResource() *schema.Resource {
 			},
 
 			"additional_storage_count": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"availability_domain": {
 				Type:     schema.TypeString,
@@ -933,6 +935,11 @@ func (s *DatabaseExadataInfrastructureResourceCrud) Update() error {
 				return err
 			}
 			s.Res = &response.ExadataInfrastructure
+
+			// the activated storage servers only add capacity to the VM clusters once the storage is expanded
+			if s.Res.ActivatedStorageCount != nil && *s.Res.ActivatedStorageCount > 0 {
+				return s.addStorageCapacity()
+			}
 			return nil
 		}
 	}
@@ -1480,3 +1487,27 @@ func (s *DatabaseExadataInfrastructureResourceCrud) activateExadataInfrastructur
 
 	return &response, nil
 }
+
+func (s *DatabaseExadataInfrastructureResourceCrud) addStorageCapacity() error {
+	request := oci_database.AddStorageCapacityExadataInfrastructureRequest{}
+
+	tmp := s.D.Id()
+	request.ExadataInfrastructureId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	response, err := s.Client.AddStorageCapacityExadataInfrastructure(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workId := response.OpcWorkRequestId
+	if workId != nil {
+		_, err = tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "exadataInfrastructure", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.Get()
+}
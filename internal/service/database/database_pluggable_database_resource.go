@@ -187,6 +187,16 @@ func DatabasePluggableDatabaseResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"open_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_database.PluggableDatabaseOpenModeReadWrite),
+					string(oci_database.PluggableDatabaseOpenModeMounted),
+				}, true),
+			},
 
 			// Computed
 			"compartment_id": {
@@ -227,10 +237,6 @@ func DatabasePluggableDatabaseResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"open_mode": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
 			"pdb_node_level_details": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -328,6 +334,13 @@ func createDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) erro
 			return err
 		}
 	}
+
+	if openMode, ok := sync.D.GetOkExists("open_mode"); ok && !strings.EqualFold(openMode.(string), string(sync.Res.OpenMode)) {
+		err := sync.UpdateOpenMode(openMode.(string))
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 
 }
@@ -394,6 +407,16 @@ func updateDatabasePluggableDatabase(d *schema.ResourceData, m interface{}) erro
 		}
 	}
 
+	if _, ok := sync.D.GetOkExists("open_mode"); ok && sync.D.HasChange("open_mode") {
+		oldRaw, newRaw := sync.D.GetChange("open_mode")
+		err := sync.UpdateOpenMode(newRaw.(string))
+
+		if err != nil {
+			sync.D.Set("open_mode", oldRaw)
+			return err
+		}
+	}
+
 	if err := tfresource.UpdateResource(d, sync); err != nil {
 		return err
 	}
@@ -434,6 +457,19 @@ func (s *DatabasePluggableDatabaseResourceCrud) CreatedTarget() []string {
 	}
 }
 
+func (s *DatabasePluggableDatabaseResourceCrud) UpdatedPending() []string {
+	return []string{
+		string(oci_database.PluggableDatabaseLifecycleStateUpdating),
+		string(oci_database.PluggableDatabaseLifecycleStateRefreshing),
+	}
+}
+
+func (s *DatabasePluggableDatabaseResourceCrud) UpdatedTarget() []string {
+	return []string{
+		string(oci_database.PluggableDatabaseLifecycleStateAvailable),
+	}
+}
+
 func (s *DatabasePluggableDatabaseResourceCrud) DeletedPending() []string {
 	return []string{
 		string(oci_database.PluggableDatabaseLifecycleStateTerminating),
@@ -719,6 +755,11 @@ func (s *DatabasePluggableDatabaseResourceCrud) ConvertToRegularPluggableDatabas
 		return err
 	}
 
+	s.Res = &response.PluggableDatabase
+	if err := s.waitForPluggableDatabaseWorkRequest(response.OpcWorkRequestId); err != nil {
+		return err
+	}
+
 	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
 		return waitErr
 	}
@@ -726,7 +767,6 @@ func (s *DatabasePluggableDatabaseResourceCrud) ConvertToRegularPluggableDatabas
 	val := s.D.Get("convert_to_regular_trigger")
 	s.D.Set("convert_to_regular_trigger", val)
 
-	s.Res = &response.PluggableDatabase
 	return nil
 }
 
@@ -743,6 +783,11 @@ func (s *DatabasePluggableDatabaseResourceCrud) RefreshPluggableDatabase() error
 		return err
 	}
 
+	s.Res = &response.PluggableDatabase
+	if err := s.waitForPluggableDatabaseWorkRequest(response.OpcWorkRequestId); err != nil {
+		return err
+	}
+
 	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
 		return waitErr
 	}
@@ -750,10 +795,64 @@ func (s *DatabasePluggableDatabaseResourceCrud) RefreshPluggableDatabase() error
 	val := s.D.Get("refresh_trigger")
 	s.D.Set("refresh_trigger", val)
 
-	s.Res = &response.PluggableDatabase
 	return nil
 }
 
+// UpdateOpenMode opens the pluggable database in read/write mode or closes it to the mounted mode
+func (s *DatabasePluggableDatabaseResourceCrud) UpdateOpenMode(openMode string) error {
+	idTmp := s.D.Id()
+	retryPolicy := tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+	var workId *string
+	switch oci_database.PluggableDatabaseOpenModeEnum(strings.ToUpper(openMode)) {
+	case oci_database.PluggableDatabaseOpenModeReadWrite:
+		request := oci_database.StartPluggableDatabaseRequest{
+			PluggableDatabaseId: &idTmp,
+		}
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := s.Client.StartPluggableDatabase(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		s.Res = &response.PluggableDatabase
+		workId = response.OpcWorkRequestId
+	case oci_database.PluggableDatabaseOpenModeMounted:
+		request := oci_database.StopPluggableDatabaseRequest{
+			PluggableDatabaseId: &idTmp,
+		}
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := s.Client.StopPluggableDatabase(context.Background(), request)
+		if err != nil {
+			return err
+		}
+		s.Res = &response.PluggableDatabase
+		workId = response.OpcWorkRequestId
+	default:
+		return fmt.Errorf("open mode '%s' cannot be set on a pluggable database", openMode)
+	}
+
+	if err := s.waitForPluggableDatabaseWorkRequest(workId); err != nil {
+		return err
+	}
+
+	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		return waitErr
+	}
+
+	return s.Get()
+}
+
+func (s *DatabasePluggableDatabaseResourceCrud) waitForPluggableDatabaseWorkRequest(workId *string) error {
+	if workId == nil {
+		return nil
+	}
+
+	_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "pluggableDatabase", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+	return err
+}
+
 func (s *DatabasePluggableDatabaseResourceCrud) RotatePluggableDatabaseEncryptionKey() error {
 	request := oci_database.RotatePluggableDatabaseEncryptionKeyRequest{}
 
@@ -199,7 +199,6 @@ func DatabaseVmClusterResource() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -602,6 +601,13 @@ func (s *DatabaseVmClusterResourceCrud) Update() error {
 			}
 		}
 	}
+	if _, ok := s.D.GetOkExists("db_servers"); ok && s.D.HasChange("db_servers") {
+		err := s.updateDbServers()
+		if err != nil {
+			return err
+		}
+	}
+
 	request := oci_database.UpdateVmClusterRequest{}
 
 	if cloudAutomationUpdateDetails, ok := s.D.GetOkExists("cloud_automation_update_details"); ok {
@@ -1024,6 +1030,79 @@ func (s *DatabaseVmClusterResourceCrud) mapToFileSystemConfigurationDetail(field
 	return result, nil
 }
 
+// updateDbServers adds virtual machines on the DB servers added to db_servers, and removes the virtual machines of the
+// DB servers removed from it
+func (s *DatabaseVmClusterResourceCrud) updateDbServers() error {
+	oldRaw, newRaw := s.D.GetChange("db_servers")
+	oldDbServers := map[string]bool{}
+	for _, dbServer := range oldRaw.([]interface{}) {
+		oldDbServers[dbServer.(string)] = true
+	}
+	newDbServers := map[string]bool{}
+	for _, dbServer := range newRaw.([]interface{}) {
+		newDbServers[dbServer.(string)] = true
+	}
+
+	var addedDbServers, removedDbServers []oci_database.DbServerDetails
+	for _, dbServer := range newRaw.([]interface{}) {
+		if tmp := dbServer.(string); !oldDbServers[tmp] {
+			addedDbServers = append(addedDbServers, oci_database.DbServerDetails{DbServerId: &tmp})
+		}
+	}
+	for _, dbServer := range oldRaw.([]interface{}) {
+		if tmp := dbServer.(string); !newDbServers[tmp] {
+			removedDbServers = append(removedDbServers, oci_database.DbServerDetails{DbServerId: &tmp})
+		}
+	}
+
+	idTmp := s.D.Id()
+
+	if len(addedDbServers) > 0 {
+		request := oci_database.AddVirtualMachineToVmClusterRequest{}
+		request.DbServers = addedDbServers
+		request.VmClusterId = &idTmp
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+		response, err := s.Client.AddVirtualMachineToVmCluster(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		if err := s.waitForDbServersUpdate(response.OpcWorkRequestId); err != nil {
+			return err
+		}
+	}
+
+	if len(removedDbServers) > 0 {
+		request := oci_database.RemoveVirtualMachineFromVmClusterRequest{}
+		request.DbServers = removedDbServers
+		request.VmClusterId = &idTmp
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database")
+
+		response, err := s.Client.RemoveVirtualMachineFromVmCluster(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		if err := s.waitForDbServersUpdate(response.OpcWorkRequestId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *DatabaseVmClusterResourceCrud) waitForDbServersUpdate(workId *string) error {
+	if workId != nil {
+		_, err := tfresource.WaitForWorkRequestWithErrorHandling(s.WorkRequestClient, workId, "vmCluster", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tfresource.WaitForUpdatedState(s.D, s)
+}
+
 func (s *DatabaseVmClusterResourceCrud) updateCompartment(compartment interface{}) error {
 	changeCompartmentRequest := oci_database.ChangeVmClusterCompartmentRequest{}
 
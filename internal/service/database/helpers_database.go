@@ -2,8 +2,12 @@ package database
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_database "github.com/oracle/oci-go-sdk/v65/database"
+
+	tf_client "github.com/oracle/terraform-provider-oci/internal/client"
 )
 
 func fileSystemConfigDiffFunc(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
@@ -46,3 +50,17 @@ func fileSystemConfigDiffFunc(ctx context.Context, diff *schema.ResourceDiff, me
 
 	return nil
 }
+
+// newDatabaseRegionClient returns a client of the region, e.g. to follow the peer of a cross-region Autonomous Data Guard
+func newDatabaseRegionClient(client *oci_database.DatabaseClient, region string) (*oci_database.DatabaseClient, error) {
+	regionClient, err := oci_database.NewDatabaseClientWithConfigurationProvider(*client.ConfigurationProvider())
+	if err != nil {
+		return nil, fmt.Errorf("cannot Create client for the region %s: %v", region, err)
+	}
+	err = tf_client.ConfigureClientVar(&regionClient.BaseClient)
+	if err != nil {
+		return nil, fmt.Errorf("cannot configure client for the region %s: %v", region, err)
+	}
+	regionClient.SetRegion(region)
+	return &regionClient, nil
+}
@@ -13,6 +13,8 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_database_autonomous_database_backup", DatabaseAutonomousDatabaseBackupResource())
 	tfresource.RegisterResource("oci_database_autonomous_database_instance_wallet_management", DatabaseAutonomousDatabaseInstanceWalletManagementResource())
 	tfresource.RegisterResource("oci_database_autonomous_database_regional_wallet_management", DatabaseAutonomousDatabaseRegionalWalletManagementResource())
+	tfresource.RegisterResource("oci_database_autonomous_database_resource_pool", DatabaseAutonomousDatabaseResourcePoolResource())
+	tfresource.RegisterResource("oci_database_autonomous_database_resource_pool_member", DatabaseAutonomousDatabaseResourcePoolMemberResource())
 	tfresource.RegisterResource("oci_database_autonomous_database_saas_admin_user", DatabaseAutonomousDatabaseSaasAdminUserResource())
 	tfresource.RegisterResource("oci_database_autonomous_database_software_image", DatabaseAutonomousDatabaseSoftwareImageResource())
 	tfresource.RegisterResource("oci_database_autonomous_database_wallet", DatabaseAutonomousDatabaseWalletResource())
@@ -29,14 +31,17 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_database_cloud_vm_cluster", DatabaseCloudVmClusterResource())
 	tfresource.RegisterResource("oci_database_cloud_vm_cluster_iorm_config", DatabaseCloudVmClusterIormConfigResource())
 	tfresource.RegisterResource("oci_database_data_guard_association", DatabaseDataGuardAssociationResource())
+	tfresource.RegisterResource("oci_database_data_guard_association_operation", DatabaseDataGuardAssociationOperationResource())
 	tfresource.RegisterResource("oci_database_database", DatabaseDatabaseResource())
 	tfresource.RegisterResource("oci_database_database_software_image", DatabaseDatabaseSoftwareImageResource())
 	tfresource.RegisterResource("oci_database_database_upgrade", DatabaseDatabaseUpgradeResource())
 	tfresource.RegisterResource("oci_database_db_home", DatabaseDbHomeResource())
+	tfresource.RegisterResource("oci_database_db_home_patch_action", DatabaseDbHomePatchActionResource())
 	tfresource.RegisterResource("oci_database_db_node", DatabaseDbNodeResource())
 	tfresource.RegisterResource("oci_database_db_node_console_connection", DatabaseDbNodeConsoleConnectionResource())
 	tfresource.RegisterResource("oci_database_db_node_console_history", DatabaseDbNodeConsoleHistoryResource())
 	tfresource.RegisterResource("oci_database_db_system", DatabaseDbSystemResource())
+	tfresource.RegisterResource("oci_database_db_system_patch_action", DatabaseDbSystemPatchActionResource())
 	tfresource.RegisterResource("oci_database_exadata_infrastructure", DatabaseExadataInfrastructureResource())
 	tfresource.RegisterResource("oci_database_exadata_iorm_config", DatabaseExadataIormConfigResource())
 	tfresource.RegisterResource("oci_database_exadb_vm_cluster", DatabaseExadbVmClusterResource())
@@ -0,0 +1,231 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	oci_database_tools "github.com/oracle/oci-go-sdk/v65/databasetools"
+)
+
+// validationResultCodeOk is the code returned by the service when the connection could be established
+const validationResultCodeOk = "OK"
+
+func DatabaseToolsDatabaseToolsConnectionValidationResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createDatabaseToolsDatabaseToolsConnectionValidation,
+		Read:     readDatabaseToolsDatabaseToolsConnectionValidation,
+		Delete:   deleteDatabaseToolsDatabaseToolsConnectionValidation,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"database_tools_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"validate_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cause": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"database_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDatabaseToolsDatabaseToolsConnectionValidation(d *schema.ResourceData, m interface{}) error {
+	sync := &DatabaseToolsDatabaseToolsConnectionValidationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DatabaseToolsClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+// The result of a validation cannot be read again from the service, it is kept as recorded at creation
+func readDatabaseToolsDatabaseToolsConnectionValidation(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+func deleteDatabaseToolsDatabaseToolsConnectionValidation(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type DatabaseToolsDatabaseToolsConnectionValidationResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_database_tools.DatabaseToolsClient
+	Res                    *oci_database_tools.ValidateDatabaseToolsConnectionResult
+	DisableNotFoundRetries bool
+}
+
+func (s *DatabaseToolsDatabaseToolsConnectionValidationResourceCrud) ID() string {
+	return tfresource.GenerateDataSourceHashID("DatabaseToolsDatabaseToolsConnectionValidationResource-", DatabaseToolsDatabaseToolsConnectionValidationResource(), s.D)
+}
+
+func (s *DatabaseToolsDatabaseToolsConnectionValidationResourceCrud) Create() error {
+	databaseToolsConnectionId := s.D.Get("database_tools_connection_id").(string)
+	retryPolicy := tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "database_tools")
+
+	// the validation details depend on the type of the connection
+	getRequest := oci_database_tools.GetDatabaseToolsConnectionRequest{
+		DatabaseToolsConnectionId: &databaseToolsConnectionId,
+	}
+	getRequest.RequestMetadata.RetryPolicy = retryPolicy
+
+	getResponse, err := s.Client.GetDatabaseToolsConnection(context.Background(), getRequest)
+	if err != nil {
+		return err
+	}
+
+	request := oci_database_tools.ValidateDatabaseToolsConnectionRequest{
+		DatabaseToolsConnectionId: &databaseToolsConnectionId,
+	}
+
+	switch getResponse.DatabaseToolsConnection.(type) {
+	case oci_database_tools.DatabaseToolsConnectionOracleDatabase:
+		request.ValidateDatabaseToolsConnectionDetails = oci_database_tools.ValidateDatabaseToolsConnectionOracleDatabaseDetails{}
+	case oci_database_tools.DatabaseToolsConnectionMySql:
+		request.ValidateDatabaseToolsConnectionDetails = oci_database_tools.ValidateDatabaseToolsConnectionMySqlDetails{}
+	case oci_database_tools.DatabaseToolsConnectionPostgresql:
+		request.ValidateDatabaseToolsConnectionDetails = oci_database_tools.ValidateDatabaseToolsConnectionPostgresqlDetails{}
+	default:
+		return fmt.Errorf("database tools connection %s of type %T cannot be validated", databaseToolsConnectionId, getResponse.DatabaseToolsConnection)
+	}
+
+	request.RequestMetadata.RetryPolicy = retryPolicy
+
+	response, err := s.Client.ValidateDatabaseToolsConnection(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if response.ValidateDatabaseToolsConnectionResult == nil {
+		return fmt.Errorf("no validation result was returned for database tools connection %s", databaseToolsConnectionId)
+	}
+
+	s.Res = &response.ValidateDatabaseToolsConnectionResult
+	result := response.ValidateDatabaseToolsConnectionResult
+	if result.GetCode() == nil || *result.GetCode() != validationResultCodeOk {
+		return fmt.Errorf("validation of database tools connection %s failed: %s", databaseToolsConnectionId, validationResultToString(result))
+	}
+
+	return nil
+}
+
+func (s *DatabaseToolsDatabaseToolsConnectionValidationResourceCrud) SetData() error {
+	if s.Res == nil || *s.Res == nil {
+		return nil
+	}
+
+	result := *s.Res
+
+	if result.GetAction() != nil {
+		s.D.Set("action", *result.GetAction())
+	}
+
+	if result.GetCause() != nil {
+		s.D.Set("cause", *result.GetCause())
+	}
+
+	if result.GetCode() != nil {
+		s.D.Set("code", *result.GetCode())
+	}
+
+	if result.GetMessage() != nil {
+		s.D.Set("message", *result.GetMessage())
+	}
+
+	switch v := result.(type) {
+	case oci_database_tools.ValidateDatabaseToolsConnectionOracleDatabaseResult:
+		s.D.Set("type", "ORACLE_DATABASE")
+
+		if v.DatabaseName != nil {
+			s.D.Set("database_name", *v.DatabaseName)
+		}
+
+		if v.DatabaseVersion != nil {
+			s.D.Set("database_version", *v.DatabaseVersion)
+		}
+	case oci_database_tools.ValidateDatabaseToolsConnectionMySqlResult:
+		s.D.Set("type", "MYSQL")
+
+		if v.DatabaseName != nil {
+			s.D.Set("database_name", *v.DatabaseName)
+		}
+
+		if v.DatabaseVersion != nil {
+			s.D.Set("database_version", *v.DatabaseVersion)
+		}
+	case oci_database_tools.ValidateDatabaseToolsConnectionPostgresqlResult:
+		s.D.Set("type", "POSTGRESQL")
+
+		if v.DatabaseName != nil {
+			s.D.Set("database_name", *v.DatabaseName)
+		}
+
+		if v.DatabaseVersion != nil {
+			s.D.Set("database_version", *v.DatabaseVersion)
+		}
+	}
+
+	return nil
+}
+
+func validationResultToString(result oci_database_tools.ValidateDatabaseToolsConnectionResult) string {
+	message := ""
+	if result.GetCode() != nil {
+		message = *result.GetCode()
+	}
+
+	if result.GetMessage() != nil {
+		message = fmt.Sprintf("%s %s", message, *result.GetMessage())
+	}
+
+	if result.GetCause() != nil {
+		message = fmt.Sprintf("%s, cause: %s", message, *result.GetCause())
+	}
+
+	if result.GetAction() != nil {
+		message = fmt.Sprintf("%s, action: %s", message, *result.GetAction())
+	}
+
+	return message
+}
@@ -7,5 +7,6 @@ import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
 	tfresource.RegisterResource("oci_database_tools_database_tools_connection", DatabaseToolsDatabaseToolsConnectionResource())
+	tfresource.RegisterResource("oci_database_tools_database_tools_connection_validation", DatabaseToolsDatabaseToolsConnectionValidationResource())
 	tfresource.RegisterResource("oci_database_tools_database_tools_private_endpoint", DatabaseToolsDatabaseToolsPrivateEndpointResource())
 }
@@ -125,6 +125,10 @@ func DevopsConnectionResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"validate_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 
 			// Computed
 			"compartment_id": {
@@ -198,6 +202,22 @@ func updateDevopsConnection(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).DevopsClient()
 
+	if _, ok := sync.D.GetOkExists("validate_trigger"); ok && sync.D.HasChange("validate_trigger") {
+		oldRaw, newRaw := sync.D.GetChange("validate_trigger")
+		oldValue := oldRaw.(int)
+		newValue := newRaw.(int)
+		if oldValue < newValue {
+			err := sync.ValidateConnection()
+
+			if err != nil {
+				return err
+			}
+		} else {
+			sync.D.Set("validate_trigger", oldRaw)
+			return fmt.Errorf("new value of trigger should be greater than the old value")
+		}
+	}
+
 	return tfresource.UpdateResource(d, sync)
 }
 
@@ -413,6 +433,23 @@ func (s *DevopsConnectionResourceCrud) Update() error {
 	return s.getConnectionFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate))
 }
 
+func (s *DevopsConnectionResourceCrud) ValidateConnection() error {
+	request := oci_devops.ValidateConnectionRequest{}
+
+	tmp := s.D.Id()
+	request.ConnectionId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+
+	response, err := s.Client.ValidateConnection(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Connection
+	return nil
+}
+
 func (s *DevopsConnectionResourceCrud) Delete() error {
 	request := oci_devops.DeleteConnectionRequest{}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,6 +34,35 @@ func DevopsDeployStageResource() *schema.Resource {
 		Read:     readDevopsDeployStage,
 		Update:   updateDevopsDeployStage,
 		Delete:   deleteDevopsDeployStage,
+		CustomizeDiff: customdiff.All(
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "OKE_BLUE_GREEN_DEPLOYMENT"), "deploy_stage_type is OKE_BLUE_GREEN_DEPLOYMENT", "blue_green_strategy"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "OKE_CANARY_DEPLOYMENT"), "deploy_stage_type is OKE_CANARY_DEPLOYMENT", "canary_strategy"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "OKE_BLUE_GREEN_DEPLOYMENT", "OKE_CANARY_DEPLOYMENT"), "an OKE blue-green or canary strategy is used", "oke_cluster_deploy_environment_id"),
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("deploy_stage_type", "OKE_BLUE_GREEN_DEPLOYMENT"), "deploy_stage_type is not OKE_BLUE_GREEN_DEPLOYMENT", "blue_green_strategy"),
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("deploy_stage_type", "OKE_CANARY_DEPLOYMENT"), "deploy_stage_type is not OKE_CANARY_DEPLOYMENT", "canary_strategy"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT"), "deploy_stage_type is COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT", "deploy_environment_id_a"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT"), "deploy_stage_type is COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT", "deploy_environment_id_b"),
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("deploy_stage_type", "COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT"), "deploy_stage_type is not COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT",
+				"deploy_environment_id_a",
+				"deploy_environment_id_b"),
+			tfresource.ErrorWhen(tfresource.AttributesEqual("deploy_environment_id_a", "deploy_environment_id_b"), "deploy_environment_id_a and deploy_environment_id_b must reference different environments for a blue-green deployment"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "COMPUTE_INSTANCE_GROUP_CANARY_DEPLOYMENT"), "deploy_stage_type is COMPUTE_INSTANCE_GROUP_CANARY_DEPLOYMENT", "compute_instance_group_deploy_environment_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT", "COMPUTE_INSTANCE_GROUP_CANARY_DEPLOYMENT"), "a compute instance group blue-green or canary strategy is used", "production_load_balancer_config"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type",
+				"COMPUTE_INSTANCE_GROUP_BLUE_GREEN_DEPLOYMENT",
+				"COMPUTE_INSTANCE_GROUP_CANARY_DEPLOYMENT",
+				"COMPUTE_INSTANCE_GROUP_CANARY_TRAFFIC_SHIFT",
+				"LOAD_BALANCER_TRAFFIC_SHIFT",
+				"OKE_CANARY_TRAFFIC_SHIFT"), "the stage shifts traffic in batches", "rollout_policy"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "COMPUTE_INSTANCE_GROUP_BLUE_GREEN_TRAFFIC_SHIFT"), "deploy_stage_type is COMPUTE_INSTANCE_GROUP_BLUE_GREEN_TRAFFIC_SHIFT", "compute_instance_group_blue_green_deployment_deploy_stage_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "COMPUTE_INSTANCE_GROUP_CANARY_TRAFFIC_SHIFT"), "deploy_stage_type is COMPUTE_INSTANCE_GROUP_CANARY_TRAFFIC_SHIFT", "compute_instance_group_canary_deploy_stage_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "OKE_BLUE_GREEN_TRAFFIC_SHIFT"), "deploy_stage_type is OKE_BLUE_GREEN_TRAFFIC_SHIFT", "oke_blue_green_deploy_stage_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "OKE_CANARY_TRAFFIC_SHIFT"), "deploy_stage_type is OKE_CANARY_TRAFFIC_SHIFT", "oke_canary_deploy_stage_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "LOAD_BALANCER_TRAFFIC_SHIFT"), "deploy_stage_type is LOAD_BALANCER_TRAFFIC_SHIFT", "blue_backend_ips"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "LOAD_BALANCER_TRAFFIC_SHIFT"), "deploy_stage_type is LOAD_BALANCER_TRAFFIC_SHIFT", "green_backend_ips"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "LOAD_BALANCER_TRAFFIC_SHIFT"), "deploy_stage_type is LOAD_BALANCER_TRAFFIC_SHIFT", "load_balancer_config"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("deploy_stage_type", "LOAD_BALANCER_TRAFFIC_SHIFT"), "deploy_stage_type is LOAD_BALANCER_TRAFFIC_SHIFT", "traffic_shift_target"),
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"deploy_pipeline_id": {
@@ -675,9 +705,11 @@ func DevopsDeployStageResource() *schema.Resource {
 
 						// Optional
 						"policy_type": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+							ValidateFunc:     validation.StringInSlice(oci_devops.GetDeployStageRollbackPolicyPolicyTypeEnumStringValues(), true),
 						},
 
 						// Computed
@@ -720,9 +752,10 @@ func DevopsDeployStageResource() *schema.Resource {
 							Computed: true,
 						},
 						"ramp_limit_percent": {
-							Type:     schema.TypeFloat,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
 						},
 
 						// Computed
@@ -881,9 +914,10 @@ func DevopsDeployStageResource() *schema.Resource {
 				Computed: true,
 			},
 			"traffic_shift_target": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(oci_devops.GetLoadBalancerTrafficShiftDeployStageTrafficShiftTargetEnumStringValues(), false),
 			},
 			"values_artifact_ids": {
 				Type:     schema.TypeList,
@@ -0,0 +1,220 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package devops
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_devops "github.com/oracle/oci-go-sdk/v65/devops"
+)
+
+func DevopsDeploymentApprovalResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createDevopsDeploymentApproval,
+		Read:     readDevopsDeploymentApproval,
+		Delete:   deleteDevopsDeploymentApproval,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc:     validation.StringInSlice(oci_devops.GetApproveDeploymentDetailsActionEnumStringValues(), true),
+			},
+			"deploy_stage_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"approval_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"deploy_stage_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createDevopsDeploymentApproval(d *schema.ResourceData, m interface{}) error {
+	sync := &DevopsDeploymentApprovalResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DevopsClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readDevopsDeploymentApproval(d *schema.ResourceData, m interface{}) error {
+	sync := &DevopsDeploymentApprovalResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).DevopsClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+// An approval cannot be withdrawn once submitted, destroying the resource only removes it from the state
+func deleteDevopsDeploymentApproval(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type DevopsDeploymentApprovalResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_devops.DevopsClient
+	Res                    *oci_devops.Deployment
+	DisableNotFoundRetries bool
+}
+
+func (s *DevopsDeploymentApprovalResourceCrud) ID() string {
+	return tfresource.GenerateDataSourceHashID("DevopsDeploymentApprovalResource-", DevopsDeploymentApprovalResource(), s.D)
+}
+
+func (s *DevopsDeploymentApprovalResourceCrud) Create() error {
+	request := oci_devops.ApproveDeploymentRequest{}
+
+	if action, ok := s.D.GetOkExists("action"); ok {
+		request.Action = oci_devops.ApproveDeploymentDetailsActionEnum(strings.ToUpper(action.(string)))
+	}
+
+	if deployStageId, ok := s.D.GetOkExists("deploy_stage_id"); ok {
+		tmp := deployStageId.(string)
+		request.DeployStageId = &tmp
+	}
+
+	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
+		tmp := deploymentId.(string)
+		request.DeploymentId = &tmp
+	}
+
+	if reason, ok := s.D.GetOkExists("reason"); ok {
+		tmp := reason.(string)
+		request.Reason = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+
+	response, err := s.Client.ApproveDeployment(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Deployment
+	return nil
+}
+
+func (s *DevopsDeploymentApprovalResourceCrud) Get() error {
+	request := oci_devops.GetDeploymentRequest{}
+
+	if deploymentId, ok := s.D.GetOkExists("deployment_id"); ok {
+		tmp := deploymentId.(string)
+		request.DeploymentId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops")
+
+	response, err := s.Client.GetDeployment(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Deployment
+	return nil
+}
+
+func (s *DevopsDeploymentApprovalResourceCrud) SetData() error {
+	deployment := *s.Res
+
+	s.D.Set("state", deployment.GetLifecycleState())
+
+	stageProgress := s.deployStageExecutionProgress()
+	if stageProgress == nil {
+		return nil
+	}
+
+	s.D.Set("deploy_stage_status", stageProgress.GetStatus())
+
+	// only approval stages record who approved or rejected them
+	var approvalActions []oci_devops.ApprovalAction
+	switch v := stageProgress.(type) {
+	case oci_devops.ManualApprovalDeployStageExecutionProgress:
+		approvalActions = v.ApprovalActions
+	case oci_devops.ComputeInstanceGroupCanaryApprovalDeployStageExecutionProgress:
+		approvalActions = v.ApprovalActions
+	case oci_devops.OkeCanaryApprovalDeployStageExecutionProgress:
+		approvalActions = v.ApprovalActions
+	}
+
+	actions := []interface{}{}
+	for _, item := range approvalActions {
+		actions = append(actions, ApprovalActionToMap(item))
+	}
+	s.D.Set("approval_actions", actions)
+
+	return nil
+}
+
+func (s *DevopsDeploymentApprovalResourceCrud) deployStageExecutionProgress() oci_devops.DeployStageExecutionProgress {
+	executionProgress := (*s.Res).GetDeploymentExecutionProgress()
+	if executionProgress == nil {
+		return nil
+	}
+
+	deployStageId := s.D.Get("deploy_stage_id").(string)
+	for _, stageProgress := range executionProgress.DeployStageExecutionProgress {
+		if stageProgress.GetDeployStageId() != nil && *stageProgress.GetDeployStageId() == deployStageId {
+			return stageProgress
+		}
+	}
+
+	return nil
+}
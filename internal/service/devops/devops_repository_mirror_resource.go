@@ -37,6 +37,11 @@ func DevopsRepositoryMirrorResource() *schema.Resource {
 			},
 
 			// Optional
+			"mirror_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
 
 			// Computed
 		},
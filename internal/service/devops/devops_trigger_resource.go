@@ -255,6 +255,11 @@ func DevopsTriggerResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -357,6 +362,11 @@ func (s *DevopsTriggerResourceCrud) Create() error {
 		return err
 	}
 
+	// the webhook secret is only returned when the trigger is created
+	if secret := triggerCreateResultSecret(response.TriggerCreateResult); secret != nil {
+		s.D.Set("secret", *secret)
+	}
+
 	workId := response.OpcWorkRequestId
 	var identifier *string
 	identifier = response.GetId()
@@ -366,6 +376,22 @@ func (s *DevopsTriggerResourceCrud) Create() error {
 	return s.getTriggerFromWorkRequest(workId, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "devops"), oci_devops.ActionTypeCreated, s.D.Timeout(schema.TimeoutCreate))
 }
 
+func triggerCreateResultSecret(result oci_devops.TriggerCreateResult) *string {
+	switch v := result.(type) {
+	case oci_devops.BitbucketServerTriggerCreateResult:
+		return v.Secret
+	case oci_devops.GithubTriggerCreateResult:
+		return v.Secret
+	case oci_devops.GitlabServerTriggerCreateResult:
+		return v.Secret
+	case oci_devops.GitlabTriggerCreateResult:
+		return v.Secret
+	case oci_devops.VbsTriggerCreateResult:
+		return v.Secret
+	}
+	return nil
+}
+
 func (s *DevopsTriggerResourceCrud) getTriggerFromWorkRequest(workId *string, retryPolicy *oci_common.RetryPolicy,
 	actionTypeEnum oci_devops.ActionTypeEnum, timeout time.Duration) error {
 
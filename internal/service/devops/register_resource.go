@@ -15,6 +15,7 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_devops_deploy_pipeline", DevopsDeployPipelineResource())
 	tfresource.RegisterResource("oci_devops_deploy_stage", DevopsDeployStageResource())
 	tfresource.RegisterResource("oci_devops_deployment", DevopsDeploymentResource())
+	tfresource.RegisterResource("oci_devops_deployment_approval", DevopsDeploymentApprovalResource())
 	tfresource.RegisterResource("oci_devops_project", DevopsProjectResource())
 	tfresource.RegisterResource("oci_devops_project_repository_setting", DevopsProjectRepositorySettingResource())
 	tfresource.RegisterResource("oci_devops_repository", DevopsRepositoryResource())
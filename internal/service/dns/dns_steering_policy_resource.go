@@ -48,24 +48,20 @@ func DnsSteeringPolicyResource() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
 						"name": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"rdata": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"rtype": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 
 						// Optional
@@ -73,13 +69,11 @@ func DnsSteeringPolicyResource() *schema.Resource {
 							Type:     schema.TypeBool,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 						"pool": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						// Computed
@@ -108,14 +102,12 @@ func DnsSteeringPolicyResource() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Required
 						"rule_type": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ForceNew:         true,
 							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 							ValidateFunc: validation.StringInSlice([]string{
 								"FILTER",
@@ -131,7 +123,6 @@ func DnsSteeringPolicyResource() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									// Required
@@ -141,7 +132,6 @@ func DnsSteeringPolicyResource() *schema.Resource {
 										Type:     schema.TypeList,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												// Required
@@ -151,19 +141,16 @@ func DnsSteeringPolicyResource() *schema.Resource {
 													Type:     schema.TypeString,
 													Optional: true,
 													Computed: true,
-													ForceNew: true,
 												},
 												"should_keep": {
 													Type:     schema.TypeBool,
 													Optional: true,
 													Computed: true,
-													ForceNew: true,
 												},
 												"value": {
 													Type:     schema.TypeInt,
 													Optional: true,
 													Computed: true,
-													ForceNew: true,
 												},
 
 												// Computed
@@ -174,13 +161,11 @@ func DnsSteeringPolicyResource() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"count": {
 										Type:     schema.TypeInt,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 
 									// Computed
@@ -191,7 +176,6 @@ func DnsSteeringPolicyResource() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									// Required
@@ -201,19 +185,16 @@ func DnsSteeringPolicyResource() *schema.Resource {
 										Type:     schema.TypeString,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"should_keep": {
 										Type:     schema.TypeBool,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"value": {
 										Type:     schema.TypeInt,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 
 									// Computed
@@ -224,13 +205,11 @@ func DnsSteeringPolicyResource() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 						"description": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 
 						// Computed
@@ -446,6 +425,23 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 	}
 	request := oci_dns.UpdateSteeringPolicyRequest{}
 
+	if answers, ok := s.D.GetOkExists("answers"); ok {
+		interfaces := answers.([]interface{})
+		tmp := make([]oci_dns.SteeringPolicyAnswer, len(interfaces))
+		for i := range interfaces {
+			stateDataIndex := i
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "answers", stateDataIndex)
+			converted, err := s.mapToSteeringPolicyAnswer(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			tmp[i] = converted
+		}
+		if len(tmp) != 0 || s.D.HasChange("answers") {
+			request.Answers = tmp
+		}
+	}
+
 	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
 		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
 		if err != nil {
@@ -468,6 +464,23 @@ func (s *DnsSteeringPolicyResourceCrud) Update() error {
 		request.HealthCheckMonitorId = &tmp
 	}
 
+	if rules, ok := s.D.GetOkExists("rules"); ok {
+		interfaces := rules.([]interface{})
+		tmp := make([]oci_dns.SteeringPolicyRule, len(interfaces))
+		for i := range interfaces {
+			stateDataIndex := i
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "rules", stateDataIndex)
+			converted, err := s.mapToSteeringPolicyRule(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			tmp[i] = converted
+		}
+		if len(tmp) != 0 || s.D.HasChange("rules") {
+			request.Rules = tmp
+		}
+	}
+
 	tmp := s.D.Id()
 	request.SteeringPolicyId = &tmp
 
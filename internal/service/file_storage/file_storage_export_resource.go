@@ -7,12 +7,15 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_file_storage "github.com/oracle/oci-go-sdk/v65/filestorage"
@@ -23,11 +26,12 @@ func FileStorageExportResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createFileStorageExport,
-		Read:     readFileStorageExport,
-		Update:   updateFileStorageExport,
-		Delete:   deleteFileStorageExport,
+		Timeouts:      tfresource.DefaultTimeout,
+		Create:        createFileStorageExport,
+		Read:          readFileStorageExport,
+		Update:        updateFileStorageExport,
+		Delete:        deleteFileStorageExport,
+		CustomizeDiff: validateFileStorageExportOptions,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"export_set_id": {
@@ -55,22 +59,25 @@ func FileStorageExportResource() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						// Required
 						"source": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
 						},
 
 						// Optional
 						"access": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(oci_file_storage.GetClientOptionsAccessEnumStringValues(), false),
 						},
 						"allowed_auth": {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(oci_file_storage.GetClientOptionsAllowedAuthEnumStringValues(), false),
 							},
 						},
 						"anonymous_gid": {
@@ -88,9 +95,10 @@ func FileStorageExportResource() *schema.Resource {
 							DiffSuppressFunc: tfresource.Int64StringDiffSuppressFunction,
 						},
 						"identity_squash": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(oci_file_storage.GetClientOptionsIdentitySquashEnumStringValues(), false),
 						},
 						"is_anonymous_access_allowed": {
 							Type:     schema.TypeBool,
@@ -545,3 +553,50 @@ func (s *FileStorageExportResourceCrud) mapToResourceLock(fieldKeyFormat string)
 
 	return result, nil
 }
+
+// validateFileStorageExportOptions checks the export options at plan time instead of letting the service reject them
+// when the export is created or updated. Options whose values are not known yet are skipped.
+func validateFileStorageExportOptions(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	exportOptions := rawConfig.GetAttr("export_options")
+	if exportOptions.IsNull() || !exportOptions.IsKnown() {
+		return nil
+	}
+
+	for i, exportOption := range exportOptions.AsValueSlice() {
+		if exportOption.IsNull() || !exportOption.IsKnown() {
+			continue
+		}
+
+		// anonymous access only applies to Kerberos clients whose user is not found by the LDAP ID mapping
+		isAnonymousAccessAllowed := exportOption.GetAttr("is_anonymous_access_allowed")
+		if isAnonymousAccessAllowed.IsNull() || !isAnonymousAccessAllowed.IsKnown() || isAnonymousAccessAllowed.False() {
+			continue
+		}
+		allowedAuth := exportOption.GetAttr("allowed_auth")
+		if !allowedAuth.IsKnown() || isKerberosAuthAllowed(allowedAuth) {
+			continue
+		}
+		return fmt.Errorf("export_options.%d: is_anonymous_access_allowed requires allowed_auth to include KRB5, KRB5I or KRB5P", i)
+	}
+
+	return nil
+}
+
+func isKerberosAuthAllowed(allowedAuth cty.Value) bool {
+	if allowedAuth.IsNull() {
+		return false
+	}
+	for _, auth := range allowedAuth.AsValueSlice() {
+		if !auth.IsKnown() {
+			return true
+		}
+		if !auth.IsNull() && strings.HasPrefix(auth.AsString(), string(oci_file_storage.ClientOptionsAllowedAuthKrb5)) {
+			return true
+		}
+	}
+	return false
+}
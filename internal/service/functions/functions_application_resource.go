@@ -13,6 +13,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/oracle/terraform-provider-oci/httpreplay"
 
@@ -29,6 +30,8 @@ func FunctionsApplicationResource() *schema.Resource {
 		Read:     readFunctionsApplication,
 		Update:   updateFunctionsApplication,
 		Delete:   deleteFunctionsApplication,
+		// signatures of the images can only be verified against the configured keys
+		CustomizeDiff: tfresource.RequiredWhen(tfresource.AttributeIsTrue("image_policy_config.0.is_policy_enabled"), "is_policy_enabled is true", "image_policy_config.0.key_details"),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"compartment_id": {
@@ -117,10 +120,11 @@ func FunctionsApplicationResource() *schema.Resource {
 				},
 			},
 			"shape": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(oci_functions.GetCreateApplicationDetailsShapeEnumStringValues(), false),
 			},
 			"syslog_url": {
 				Type:     schema.TypeString,
@@ -82,6 +82,8 @@ func FunctionsFunctionResource() *schema.Resource {
 					d.SetNewComputed("image_digest")
 					return nil
 				}),
+			tfresource.RequiredWhen(tfresource.AttributeIn("provisioned_concurrency_config.0.strategy", "CONSTANT"), "strategy is CONSTANT", "provisioned_concurrency_config.0.count"),
+			tfresource.ConflictsWhen(tfresource.AttributeIn("provisioned_concurrency_config.0.strategy", "NONE"), "strategy is NONE", "provisioned_concurrency_config.0.count"),
 		),
 		Schema: map[string]*schema.Schema{
 			// Required
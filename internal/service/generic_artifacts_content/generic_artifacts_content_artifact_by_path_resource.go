@@ -9,12 +9,15 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_artifacts "github.com/oracle/oci-go-sdk/v65/artifacts"
 	oci_generic_artifacts_content "github.com/oracle/oci-go-sdk/v65/genericartifactscontent"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -114,6 +117,7 @@ func createGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m inter
 	sync := &GenericArtifactsContentArtifactByPathResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).GenericArtifactsContentClient()
+	sync.ArtifactsClient = m.(*client.OracleClients).ArtifactsClient()
 
 	return tfresource.CreateResource(d, sync)
 }
@@ -133,6 +137,7 @@ func deleteGenericArtifactsContentArtifactByPath(d *schema.ResourceData, m inter
 type GenericArtifactsContentArtifactByPathResourceCrud struct {
 	tfresource.BaseCrud
 	Client                 *oci_generic_artifacts_content.GenericArtifactsContentClient
+	ArtifactsClient        *oci_artifacts.ArtifactsClient
 	Res                    *oci_generic_artifacts_content.PutGenericArtifactContentByPathResponse
 	Content                *oci_generic_artifacts_content.GetGenericArtifactContentByPathResponse
 	DisableNotFoundRetries bool
@@ -209,8 +214,11 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) isSourceCreate() boo
 func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByContent() error {
 	request := oci_generic_artifacts_content.PutGenericArtifactContentByPathRequest{}
 
+	var contentSha256 string
 	if genericArtifactContentBody, ok := s.D.GetOkExists("content"); ok {
 		tmp := []byte(genericArtifactContentBody.(string))
+		h := sha256.Sum256(tmp)
+		contentSha256 = hex.EncodeToString(h[:])
 		request.GenericArtifactContentBody = ioutil.NopCloser(bytes.NewBuffer(tmp))
 	}
 
@@ -236,7 +244,7 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactByCont
 		return err
 	}
 	s.Res = &response
-	return nil
+	return s.verifyArtifactSha256(contentSha256)
 }
 
 func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySource() error {
@@ -254,6 +262,16 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySour
 
 	defer tfresource.SafeClose(sourceFile, &err)
 
+	// the file is read twice, once to compute its SHA256 and once to stream it to the registry
+	hash := sha256.New()
+	if _, err = io.Copy(hash, sourceFile); err != nil {
+		return fmt.Errorf("unable to read the source %s: %q", sourcePath, err)
+	}
+	sourceSha256 := hex.EncodeToString(hash.Sum(nil))
+	if _, err = sourceFile.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("unable to read the source %s: %q", sourcePath, err)
+	}
+
 	request.GenericArtifactContentBody = sourceFile
 
 	if artifactPath, ok := s.D.GetOkExists("artifact_path"); ok {
@@ -278,7 +296,29 @@ func (s *GenericArtifactsContentArtifactByPathResourceCrud) createArtifactBySour
 		return err
 	}
 	s.Res = &response
-	return nil
+	return s.verifyArtifactSha256(sourceSha256)
+}
+
+// verifyArtifactSha256 makes sure the registry stored exactly the content that was uploaded. A mismatching artifact
+// is deleted again, so that it is not left published without being tracked in the state.
+func (s *GenericArtifactsContentArtifactByPathResourceCrud) verifyArtifactSha256(expected string) error {
+	artifact := s.Res.GenericArtifact
+	if expected == "" || artifact.Sha256 == nil || strings.EqualFold(expected, *artifact.Sha256) {
+		return nil
+	}
+
+	mismatchErr := fmt.Errorf("the SHA256 of the uploaded artifact %s does not match the SHA256 of the content %s", *artifact.Sha256, expected)
+
+	request := oci_artifacts.DeleteGenericArtifactRequest{
+		ArtifactId: artifact.Id,
+	}
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "artifacts")
+
+	if _, err := s.ArtifactsClient.DeleteGenericArtifact(context.Background(), request); err != nil {
+		return fmt.Errorf("%v, deleting the artifact %s failed: %v", mismatchErr, *artifact.Id, err)
+	}
+
+	return mismatchErr
 }
 
 func (s *GenericArtifactsContentArtifactByPathResourceCrud) SetData() error {
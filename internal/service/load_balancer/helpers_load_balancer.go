@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 
@@ -20,9 +22,13 @@ import (
 
 var lbBackendSetMutexes SafeMutexMap
 
+// TLS versions accepted in the protocols of listener and backend set SSL configurations.
+var sslConfigurationProtocols = []string{"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"}
+
 type SafeMutexMap struct {
-	mutexes map[string]*sync.Mutex
-	m       sync.Mutex // Controls access to this map
+	mutexes   map[string]*sync.Mutex
+	lbMutexes map[string]*sync.RWMutex
+	m         sync.Mutex // Controls access to this map
 }
 
 // Given a load balancer ID and backend set name, finds a mutex. If a mutex doesn't exist, then Create one for that backend set.
@@ -51,6 +57,52 @@ func (safeMap *SafeMutexMap) GetOrCreateBackendSetMutex(lbId string, backendSetN
 	return m
 }
 
+// Given a load balancer ID, finds a read/write mutex. If a mutex doesn't exist, then Create one for that load balancer.
+// Shape updates of the load balancer take the write lock, while backend set and backend changes take the read lock, so
+// that they keep running concurrently with each other but never overlap with a shape update work request.
+func (safeMap *SafeMutexMap) GetOrCreateLoadBalancerMutex(lbId string) *sync.RWMutex {
+	if lbId == "" {
+		return nil
+	}
+
+	safeMap.m.Lock()
+	defer safeMap.m.Unlock()
+
+	if safeMap.lbMutexes == nil {
+		safeMap.lbMutexes = map[string]*sync.RWMutex{}
+	}
+
+	m, exists := safeMap.lbMutexes[lbId]
+	if !exists {
+		m = &sync.RWMutex{}
+		safeMap.lbMutexes[lbId] = m
+	}
+
+	return m
+}
+
+// Takes the read lock of the load balancer mutex and returns the function releasing it.
+func (safeMap *SafeMutexMap) RLockLoadBalancer(lbId string) func() {
+	m := safeMap.GetOrCreateLoadBalancerMutex(lbId)
+	if m == nil {
+		return func() {}
+	}
+
+	m.RLock()
+	return m.RUnlock
+}
+
+// Takes the write lock of the load balancer mutex and returns the function releasing it.
+func (safeMap *SafeMutexMap) LockLoadBalancer(lbId string) func() {
+	m := safeMap.GetOrCreateLoadBalancerMutex(lbId)
+	if m == nil {
+		return func() {}
+	}
+
+	m.Lock()
+	return m.Unlock
+}
+
 func loadBalancerResourceID(res interface{}, workReq *oci_load_balancer.WorkRequest) (id *string, workReqSucceeded bool) {
 	v := reflect.ValueOf(res).Elem()
 	if v.IsValid() {
@@ -109,7 +161,7 @@ func loadBalancerResourceGet(client *oci_load_balancer.LoadBalancerClient, d *sc
 				return "", false, nil
 			}
 			if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-				return "", false, fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+				return "", false, loadBalancerWorkRequestError(wr)
 			}
 		}
 		return "", true, nil
@@ -132,8 +184,12 @@ func loadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 			getWorkRequestRequest.WorkRequestId = wr.Id
 			getWorkRequestRequest.RequestMetadata.RetryPolicy = retryPolicy
 			workRequestResponse, err := client.GetWorkRequest(context.Background(), getWorkRequestRequest)
-			wr = &workRequestResponse.WorkRequest
-			return wr, string(wr.LifecycleState), err
+			if err != nil {
+				return nil, "", err
+			}
+			// Update the caller's work request so that its ID() reflects the final state
+			*wr = workRequestResponse.WorkRequest
+			return wr, string(wr.LifecycleState), nil
 		},
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -148,7 +204,67 @@ func loadBalancerWaitForWorkRequest(client *oci_load_balancer.LoadBalancerClient
 	}
 
 	if wr.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
-		return fmt.Errorf("WorkRequest FAILED: %+v", wr.ErrorDetails)
+		return loadBalancerWorkRequestError(wr)
 	}
 	return nil
 }
+
+// loadBalancerWorkRequestError builds the error returned for a failed work request from the error details reported
+// by the service, so that the reason of the failure is surfaced in the apply output.
+func loadBalancerWorkRequestError(wr *oci_load_balancer.WorkRequest) error {
+	messages := []string{}
+	for _, errorDetail := range wr.ErrorDetails {
+		if errorDetail.Message != nil {
+			messages = append(messages, fmt.Sprintf("%s: %s", errorDetail.ErrorCode, *errorDetail.Message))
+		} else if errorDetail.ErrorCode != "" {
+			messages = append(messages, string(errorDetail.ErrorCode))
+		}
+	}
+	if len(messages) == 0 && wr.Message != nil {
+		messages = append(messages, *wr.Message)
+	}
+
+	workRequestId := ""
+	if wr.Id != nil {
+		workRequestId = *wr.Id
+	}
+	operationType := ""
+	if wr.Type != nil {
+		operationType = *wr.Type
+	}
+	return fmt.Errorf("work request %s (%s) did not succeed, state %s: %s", workRequestId, operationType, wr.LifecycleState, strings.Join(messages, "; "))
+}
+
+// getLoadBalancerCertificateVersionNumbers returns the current version number of each certificate of the Certificates
+// service referenced by an SSL configuration, keyed by certificate OCID.
+func getLoadBalancerCertificateVersionNumbers(client *oci_certificates_management.CertificatesManagementClient, certificateIds []string, retryPolicy *oci_common.RetryPolicy) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	for _, certificateId := range certificateIds {
+		tmp := certificateId
+		request := oci_certificates_management.GetCertificateRequest{}
+		request.CertificateId = &tmp
+		request.RequestMetadata.RetryPolicy = retryPolicy
+
+		response, err := client.GetCertificate(context.Background(), request)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the current version of certificate %s: %v", certificateId, err)
+		}
+		if response.CurrentVersion != nil && response.CurrentVersion.VersionNumber != nil {
+			result[certificateId] = strconv.FormatInt(*response.CurrentVersion.VersionNumber, 10)
+		}
+	}
+	return result, nil
+}
+
+// sslConfigurationCertificateIds returns the certificate_ids of the ssl_configuration of the resource.
+func sslConfigurationCertificateIds(certificateIds interface{}) []string {
+	result := []string{}
+	if interfaces, ok := certificateIds.([]interface{}); ok {
+		for _, certificateId := range interfaces {
+			if certificateId != nil && certificateId.(string) != "" {
+				result = append(result, certificateId.(string))
+			}
+		}
+	}
+	return result
+}
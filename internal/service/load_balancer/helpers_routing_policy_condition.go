@@ -0,0 +1,221 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package load_balancer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Attributes of the routing policy condition language (V1) and whether they must be indexed with a key,
+// e.g. http.request.headers['User-Agent'].
+var routingPolicyConditionAttributes = map[string]bool{
+	"http.request.cookies":      true,
+	"http.request.headers":      true,
+	"http.request.http_version": false,
+	"http.request.url.path":     false,
+	"http.request.url.query":    true,
+}
+
+var routingPolicyConditionOperators = []string{"eq", "ew", "in", "sw"}
+
+// validateRoutingPolicyCondition is a ValidateFunc for the condition of a routing policy rule. It parses the condition
+// with the grammar of the V1 condition language, so that typos are reported during plan instead of failing the work
+// request on apply:
+//
+//	condition  := ("all" | "any") "(" condition ("," condition)* ")" | "not" condition | comparison
+//	comparison := attribute ["not"] operator value
+//	value      := string | "(" "i" string ")" | "(" value ("," value)* ")"
+func validateRoutingPolicyCondition(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	p := &routingPolicyConditionParser{input: v}
+	if err := p.parseCondition(); err != nil {
+		return nil, []error{fmt.Errorf("invalid %s %q: %v", k, v, err)}
+	}
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, []error{fmt.Errorf("invalid %s %q: unexpected %q at position %d", k, v, p.input[p.pos:], p.pos)}
+	}
+
+	return nil, nil
+}
+
+type routingPolicyConditionParser struct {
+	input string
+	pos   int
+}
+
+func (p *routingPolicyConditionParser) skipSpaces() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+// peekWord returns the identifier at the current position without consuming it.
+func (p *routingPolicyConditionParser) peekWord() string {
+	p.skipSpaces()
+	end := p.pos
+	for end < len(p.input) && (isRoutingPolicyIdentifierChar(p.input[end]) || p.input[end] == '.') {
+		end++
+	}
+	return p.input[p.pos:end]
+}
+
+func (p *routingPolicyConditionParser) consume(token string) bool {
+	p.skipSpaces()
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *routingPolicyConditionParser) expect(token string) error {
+	if !p.consume(token) {
+		return p.errorf("expected %q", token)
+	}
+	return nil
+}
+
+func (p *routingPolicyConditionParser) errorf(format string, args ...interface{}) error {
+	if p.pos >= len(p.input) {
+		return fmt.Errorf(format+" at end of condition", args...)
+	}
+	return fmt.Errorf(format+" at position %d", append(args, p.pos)...)
+}
+
+func (p *routingPolicyConditionParser) parseCondition() error {
+	switch word := p.peekWord(); word {
+	case "all", "any":
+		p.pos += len(word)
+		if err := p.expect("("); err != nil {
+			return err
+		}
+		for {
+			if err := p.parseCondition(); err != nil {
+				return err
+			}
+			if !p.consume(",") {
+				break
+			}
+		}
+		return p.expect(")")
+	case "not":
+		p.pos += len(word)
+		return p.parseCondition()
+	case "":
+		return p.errorf("expected a condition")
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *routingPolicyConditionParser) parseComparison() error {
+	start := p.pos
+	attribute := p.peekWord()
+	indexed, ok := routingPolicyConditionAttributes[attribute]
+	if !ok {
+		return p.errorf("unknown attribute %q, expected one of %s", attribute, strings.Join(routingPolicyConditionAttributeNames(), ", "))
+	}
+	p.pos += len(attribute)
+
+	if indexed {
+		if err := p.expect("["); err != nil {
+			return fmt.Errorf("attribute %s must be indexed with a key, e.g. %s['name']", attribute, attribute)
+		}
+		if err := p.parseValue(false); err != nil {
+			return err
+		}
+		if err := p.expect("]"); err != nil {
+			return err
+		}
+	} else if p.consume("[") {
+		p.pos = start
+		return p.errorf("attribute %s cannot be indexed", attribute)
+	}
+
+	operator := p.peekWord()
+	if operator == "not" {
+		p.pos += len(operator)
+		operator = p.peekWord()
+	}
+	if !isStringInSlice(operator, routingPolicyConditionOperators) {
+		return p.errorf("unknown operator %q, expected one of %s", operator, strings.Join(routingPolicyConditionOperators, ", "))
+	}
+	p.pos += len(operator)
+
+	return p.parseValue(operator == "in")
+}
+
+// parseValue parses a string literal, optionally wrapped in the case insensitive (i '...') form. Lists of values are
+// only accepted for the in operator.
+func (p *routingPolicyConditionParser) parseValue(allowList bool) error {
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == '\'' {
+		return p.parseString()
+	}
+
+	if err := p.expect("("); err != nil {
+		return p.errorf("expected a quoted string")
+	}
+	if word := p.peekWord(); word == "i" {
+		p.pos += len(word)
+		if err := p.parseString(); err != nil {
+			return err
+		}
+		return p.expect(")")
+	}
+	if !allowList {
+		return p.errorf("expected a quoted string or (i '...')")
+	}
+	for {
+		if err := p.parseValue(false); err != nil {
+			return err
+		}
+		if !p.consume(",") {
+			break
+		}
+	}
+	return p.expect(")")
+}
+
+func (p *routingPolicyConditionParser) parseString() error {
+	if err := p.expect("'"); err != nil {
+		return err
+	}
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case '\\':
+			p.pos += 2
+		case '\'':
+			p.pos++
+			return nil
+		default:
+			p.pos++
+		}
+	}
+	return fmt.Errorf("unterminated string")
+}
+
+func isRoutingPolicyIdentifierChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func routingPolicyConditionAttributeNames() []string {
+	names := []string{}
+	for name, indexed := range routingPolicyConditionAttributes {
+		if indexed {
+			name += "[...]"
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -138,7 +138,8 @@ type LoadBalancerBackendResourceCrud struct {
 
 // The Create, Update, and delete operations may implicitly modify the associated backend set resource. This
 // may happen concurrently with an Update to oci_loadbalancer_backend_set. Use a per-backend set
-// mutex to synchronize accesses to the backend set.
+// mutex to synchronize accesses to the backend set. Create, Update and Delete also hold the load balancer mutex for
+// reading, so that they never overlap with a shape update of the load balancer.
 func (s *LoadBalancerBackendResourceCrud) GetMutex() *sync.Mutex {
 	return lbBackendSetMutexes.GetOrCreateBackendSetMutex(s.D.Get("load_balancer_id").(string), s.D.Get("backendset_name").(string))
 }
@@ -169,7 +170,6 @@ func (s *LoadBalancerBackendResourceCrud) CreatedPending() []string {
 func (s *LoadBalancerBackendResourceCrud) CreatedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
@@ -183,11 +183,12 @@ func (s *LoadBalancerBackendResourceCrud) DeletedPending() []string {
 func (s *LoadBalancerBackendResourceCrud) DeletedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
 func (s *LoadBalancerBackendResourceCrud) Create() error {
+	defer lbBackendSetMutexes.RLockLoadBalancer(s.D.Get("load_balancer_id").(string))()
+
 	request := oci_load_balancer.CreateBackendRequest{}
 
 	if backendsetName, ok := s.D.GetOkExists("backendset_name"); ok {
@@ -256,6 +257,10 @@ func (s *LoadBalancerBackendResourceCrud) Create() error {
 	s.WorkRequest = &workRequestResponse.WorkRequest
 	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
+		// The resource was not created, do not leave it in the state
+		if s.WorkRequest.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
+			s.D.SetId("")
+		}
 		return err
 	}
 	return nil
@@ -307,6 +312,8 @@ func (s *LoadBalancerBackendResourceCrud) Get() error {
 }
 
 func (s *LoadBalancerBackendResourceCrud) Update() error {
+	defer lbBackendSetMutexes.RLockLoadBalancer(s.D.Get("load_balancer_id").(string))()
+
 	request := oci_load_balancer.UpdateBackendRequest{}
 
 	if backendName, ok := s.D.GetOkExists("name"); ok {
@@ -374,6 +381,8 @@ func (s *LoadBalancerBackendResourceCrud) Update() error {
 }
 
 func (s *LoadBalancerBackendResourceCrud) Delete() error {
+	defer lbBackendSetMutexes.RLockLoadBalancer(s.D.Get("load_balancer_id").(string))()
+
 	request := oci_load_balancer.DeleteBackendRequest{}
 
 	if backendName, ok := s.D.GetOkExists("name"); ok {
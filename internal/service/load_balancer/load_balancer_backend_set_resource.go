@@ -17,9 +17,11 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 )
 
@@ -33,6 +35,9 @@ func LoadBalancerBackendSetResource() *schema.Resource {
 		Read:     readLoadBalancerBackendSet,
 		Update:   updateLoadBalancerBackendSet,
 		Delete:   deleteLoadBalancerBackendSet,
+		CustomizeDiff: customdiff.ComputedIf("certificate_version_numbers", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+			return diff.HasChange("ssl_configuration.0.certificate_ids")
+		}),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"health_checker": {
@@ -115,6 +120,62 @@ func LoadBalancerBackendSetResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"manage_backends": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"managed_backend": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      managedBackendHashCodeForSets,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"ip_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						// Optional
+						"backup": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"drain": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"max_connections": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"offline": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						// Computed
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"lb_cookie_session_persistence_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -226,13 +287,18 @@ func LoadBalancerBackendSetResource() *schema.Resource {
 							Optional: true,
 							Computed: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sslConfigurationProtocols, false),
 							},
 						},
 						"server_order_preference": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_load_balancer.SslConfigurationDetailsServerOrderPreferenceEnabled),
+								string(oci_load_balancer.SslConfigurationDetailsServerOrderPreferenceDisabled),
+							}, false),
 						},
 						"trusted_certificate_authority_ids": {
 							Type:     schema.TypeList,
@@ -309,6 +375,13 @@ func LoadBalancerBackendSetResource() *schema.Resource {
 					},
 				},
 			},
+			"certificate_version_numbers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			// internal for work request access
 			"state": {
 				Type:     schema.TypeString,
@@ -322,6 +395,7 @@ func createLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerBackendSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+	sync.CertificatesManagementClient = m.(*client.OracleClients).CertificatesManagementClient()
 
 	return tfresource.CreateResource(d, sync)
 }
@@ -330,6 +404,7 @@ func readLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerBackendSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+	sync.CertificatesManagementClient = m.(*client.OracleClients).CertificatesManagementClient()
 
 	return tfresource.ReadResource(sync)
 }
@@ -338,6 +413,7 @@ func updateLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerBackendSetResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+	sync.CertificatesManagementClient = m.(*client.OracleClients).CertificatesManagementClient()
 
 	return tfresource.UpdateResource(d, sync)
 }
@@ -353,14 +429,16 @@ func deleteLoadBalancerBackendSet(d *schema.ResourceData, m interface{}) error {
 
 type LoadBalancerBackendSetResourceCrud struct {
 	tfresource.BaseCrud
-	Client                 *oci_load_balancer.LoadBalancerClient
-	Res                    *oci_load_balancer.BackendSet
-	DisableNotFoundRetries bool
-	WorkRequest            *oci_load_balancer.WorkRequest
+	Client                       *oci_load_balancer.LoadBalancerClient
+	CertificatesManagementClient *oci_certificates_management.CertificatesManagementClient
+	Res                          *oci_load_balancer.BackendSet
+	DisableNotFoundRetries       bool
+	WorkRequest                  *oci_load_balancer.WorkRequest
 }
 
 // The oci_loadbalancer_backend resource may implicitly modify this backend set and this could happen concurrently.
-// Use a per-backend set mutex to synchronize accesses to the backend set.
+// Use a per-backend set mutex to synchronize accesses to the backend set. Create, Update and Delete also hold the
+// load balancer mutex for reading, so that they never overlap with a shape update of the load balancer.
 func (s *LoadBalancerBackendSetResourceCrud) GetMutex() *sync.Mutex {
 	return lbBackendSetMutexes.GetOrCreateBackendSetMutex(s.D.Get("load_balancer_id").(string), s.D.Get("name").(string))
 }
@@ -386,7 +464,6 @@ func (s *LoadBalancerBackendSetResourceCrud) CreatedPending() []string {
 func (s *LoadBalancerBackendSetResourceCrud) CreatedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
@@ -400,13 +477,24 @@ func (s *LoadBalancerBackendSetResourceCrud) DeletedPending() []string {
 func (s *LoadBalancerBackendSetResourceCrud) DeletedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
 func (s *LoadBalancerBackendSetResourceCrud) Create() error {
+	defer lbBackendSetMutexes.RLockLoadBalancer(s.D.Get("load_balancer_id").(string))()
+
 	request := oci_load_balancer.CreateBackendSetRequest{}
 
+	if s.isManagingBackends() {
+		backends, err := s.configuredBackendDetails()
+		if err != nil {
+			return err
+		}
+		request.Backends = backends
+	} else if _, ok := s.D.GetOk("managed_backend"); ok {
+		return fmt.Errorf("managed_backend can only be set when manage_backends is true, use oci_load_balancer_backend resources otherwise")
+	}
+
 	if backendMaxConnections, ok := s.D.GetOkExists("backend_max_connections"); ok {
 		tmp := backendMaxConnections.(int)
 		request.BackendMaxConnections = &tmp
@@ -492,6 +580,10 @@ func (s *LoadBalancerBackendSetResourceCrud) Create() error {
 	s.WorkRequest = &workRequestResponse.WorkRequest
 	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
+		// The resource was not created, do not leave it in the state
+		if s.WorkRequest.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
+			s.D.SetId("")
+		}
 		return err
 	}
 	return nil
@@ -539,33 +631,34 @@ func (s *LoadBalancerBackendSetResourceCrud) Get() error {
 }
 
 func (s *LoadBalancerBackendSetResourceCrud) Update() error {
-	request := oci_load_balancer.UpdateBackendSetRequest{}
-
-	// @CODEGEN: Backends are marked computed in this resource, so will do a GET and include the results in the UPDATE, although they are not a required parameter
-	// Side-note: There is a potential for a race condition if the backend are added at the same time outside Terraform
-	err := s.Get()
-	if err != nil {
-		return err
-	}
+	defer lbBackendSetMutexes.RLockLoadBalancer(s.D.Get("load_balancer_id").(string))()
 
-	backends := []interface{}{}
-	for _, item := range s.Res.Backends {
-		backends = append(backends, BackendToMap(item))
-	}
+	request := oci_load_balancer.UpdateBackendSetRequest{}
 
-	set := schema.NewSet(backendHashCodeForSets, backends)
-	interfaces := set.List()
-	tmp := make([]oci_load_balancer.BackendDetails, len(interfaces))
-	for i := range interfaces {
-		stateDataIndex := backendHashCodeForSets(interfaces[i])
-		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "backend", stateDataIndex)
-		converted, err := s.mapToBackendDetails(fieldKeyFormat)
+	if s.isManagingBackends() {
+		// The configured backends replace the complete backend list in the same work request as the other changes
+		backends, err := s.configuredBackendDetails()
+		if err != nil {
+			return err
+		}
+		request.Backends = backends
+	} else if _, ok := s.D.GetOk("managed_backend"); ok {
+		return fmt.Errorf("managed_backend can only be set when manage_backends is true, use oci_load_balancer_backend resources otherwise")
+	} else {
+		// @CODEGEN: Backends are marked computed in this resource, so will do a GET and include the results in the UPDATE, although they are not a required parameter
+		// Side-note: There is a potential for a race condition if the backend are added at the same time outside Terraform
+		err := s.Get()
 		if err != nil {
 			return err
 		}
-		tmp[i] = converted
+
+		// The backends are owned by oci_load_balancer_backend resources, so they are sent back as returned by the service
+		tmp := make([]oci_load_balancer.BackendDetails, len(s.Res.Backends))
+		for i, item := range s.Res.Backends {
+			tmp[i] = BackendToBackendDetails(item)
+		}
+		request.Backends = tmp
 	}
-	request.Backends = tmp
 
 	if backendMaxConnections, ok := s.D.GetOkExists("backend_max_connections"); ok {
 		tmp := backendMaxConnections.(int)
@@ -667,10 +760,15 @@ func (s *LoadBalancerBackendSetResourceCrud) Update() error {
 		return err
 	}
 
+	// The backend set now uses the current versions of its certificates
+	s.setCertificateVersionNumbers()
+
 	return s.Get()
 }
 
 func (s *LoadBalancerBackendSetResourceCrud) Delete() error {
+	defer lbBackendSetMutexes.RLockLoadBalancer(s.D.Get("load_balancer_id").(string))()
+
 	request := oci_load_balancer.DeleteBackendSetRequest{}
 
 	if backendSetName, ok := s.D.GetOkExists("name"); ok {
@@ -725,6 +823,14 @@ func (s *LoadBalancerBackendSetResourceCrud) SetData() error {
 	}
 	s.D.Set("backend", schema.NewSet(backendHashCodeForSets, backend))
 
+	// Backends owned by oci_load_balancer_backend resources are not part of the backend set configuration, keeping
+	// them in managed_backend would plan their removal
+	if s.isManagingBackends() {
+		s.D.Set("managed_backend", schema.NewSet(managedBackendHashCodeForSets, backend))
+	} else {
+		s.D.Set("managed_backend", nil)
+	}
+
 	if s.Res.BackendMaxConnections != nil {
 		s.D.Set("backend_max_connections", *s.Res.BackendMaxConnections)
 	}
@@ -761,9 +867,59 @@ func (s *LoadBalancerBackendSetResourceCrud) SetData() error {
 		s.D.Set("ssl_configuration", nil)
 	}
 
+	// The version numbers are only refreshed when the backend set is created, updated or imported
+	if certificateVersionNumbers, ok := s.D.Get("certificate_version_numbers").(map[string]interface{}); !ok || len(certificateVersionNumbers) == 0 {
+		s.setCertificateVersionNumbers()
+	}
+
 	return nil
 }
 
+func (s *LoadBalancerBackendSetResourceCrud) setCertificateVersionNumbers() {
+	certificateIds := sslConfigurationCertificateIds(s.D.Get("ssl_configuration.0.certificate_ids"))
+	if len(certificateIds) == 0 || s.CertificatesManagementClient == nil {
+		s.D.Set("certificate_version_numbers", map[string]interface{}{})
+		return
+	}
+
+	certificateVersionNumbers, err := getLoadBalancerCertificateVersionNumbers(s.CertificatesManagementClient, certificateIds, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	if err != nil {
+		log.Printf("[WARN] unable to track the certificate versions of backend set %s: %v", s.D.Id(), err)
+		return
+	}
+	s.D.Set("certificate_version_numbers", certificateVersionNumbers)
+}
+
+// isManagingBackends returns whether the backend set owns its backend list. The backends are then taken from the
+// managed_backend blocks of the configuration instead of being preserved from the service.
+func (s *LoadBalancerBackendSetResourceCrud) isManagingBackends() bool {
+	manageBackends, ok := s.D.GetOkExists("manage_backends")
+	return ok && manageBackends.(bool)
+}
+
+func (s *LoadBalancerBackendSetResourceCrud) configuredBackendDetails() ([]oci_load_balancer.BackendDetails, error) {
+	result := []oci_load_balancer.BackendDetails{}
+
+	managedBackend, ok := s.D.GetOkExists("managed_backend")
+	if !ok {
+		return result, nil
+	}
+
+	set := managedBackend.(*schema.Set)
+	interfaces := set.List()
+	for i := range interfaces {
+		stateDataIndex := managedBackendHashCodeForSets(interfaces[i])
+		fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "managed_backend", stateDataIndex)
+		converted, err := s.mapToBackendDetails(fieldKeyFormat)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
 func GetBackendSetCompositeId(backendSetName string, loadBalancerId string) string {
 	backendSetName = url.PathEscape(backendSetName)
 	loadBalancerId = url.PathEscape(loadBalancerId)
@@ -825,6 +981,18 @@ func (s *LoadBalancerBackendSetResourceCrud) mapToBackendDetails(fieldKeyFormat
 	return result, nil
 }
 
+func BackendToBackendDetails(obj oci_load_balancer.Backend) oci_load_balancer.BackendDetails {
+	return oci_load_balancer.BackendDetails{
+		IpAddress:      obj.IpAddress,
+		Port:           obj.Port,
+		Weight:         obj.Weight,
+		MaxConnections: obj.MaxConnections,
+		Backup:         obj.Backup,
+		Drain:          obj.Drain,
+		Offline:        obj.Offline,
+	}
+}
+
 func BackendToMap(obj oci_load_balancer.Backend) map[string]interface{} {
 	result := map[string]interface{}{}
 
@@ -1208,3 +1376,17 @@ func backendHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
+
+// managedBackendHashCodeForSets identifies a backend by its address, the other attributes are updated in place. weight
+// and max_connections default on the service, so hashing them would differ between the config and the state.
+func managedBackendHashCodeForSets(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if ipAddress, ok := m["ip_address"]; ok && ipAddress != "" {
+		buf.WriteString(fmt.Sprintf("%v-", ipAddress))
+	}
+	if port, ok := m["port"]; ok {
+		buf.WriteString(fmt.Sprintf("%v-", port))
+	}
+	return utils.GetStringHashcode(buf.String())
+}
@@ -136,6 +136,9 @@ func processLoadBalancerBackendSets(ctx *tf_export.ResourceDiscoveryContext, res
 		backendSetName := backendSet.SourceAttributes["name"].(string)
 		backendSet.Id = GetBackendSetCompositeId(backendSetName, backendSet.Parent.Id)
 		backendSet.SourceAttributes["load_balancer_id"] = backendSet.Parent.Id
+
+		// Backends are exported as oci_load_balancer_backend resources
+		delete(backendSet.SourceAttributes, "managed_backend")
 	}
 
 	return resources, nil
@@ -120,7 +120,6 @@ func (s *LoadBalancerHostnameResourceCrud) CreatedPending() []string {
 func (s *LoadBalancerHostnameResourceCrud) CreatedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
@@ -134,7 +133,6 @@ func (s *LoadBalancerHostnameResourceCrud) DeletedPending() []string {
 func (s *LoadBalancerHostnameResourceCrud) DeletedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
@@ -177,6 +175,10 @@ func (s *LoadBalancerHostnameResourceCrud) Create() error {
 	s.WorkRequest = &workRequestResponse.WorkRequest
 	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
+		// The resource was not created, do not leave it in the state
+		if s.WorkRequest.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
+			s.D.SetId("")
+		}
 		return err
 	}
 	return nil
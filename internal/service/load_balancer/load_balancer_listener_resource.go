@@ -8,18 +8,26 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	oci_certificates_management "github.com/oracle/oci-go-sdk/v65/certificatesmanagement"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
 )
 
+// The maximum idle timeout of a listener allowed by the service limits.
+const listenerMaxIdleTimeoutInSeconds = 7200
+
 func LoadBalancerListenerResource() *schema.Resource {
 	return &schema.Resource{
 		Importer: &schema.ResourceImporter{
@@ -30,6 +38,11 @@ func LoadBalancerListenerResource() *schema.Resource {
 		Read:     readLoadBalancerListener,
 		Update:   updateLoadBalancerListener,
 		Delete:   deleteLoadBalancerListener,
+		CustomizeDiff: customdiff.All(
+			validateLoadBalancerListenerConnectionConfiguration,
+			// Plans an update of the listener when a certificate of the Certificates service is rotated
+			loadBalancerListenerCertificateVersionNumbersDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"default_backend_set_name": {
@@ -68,7 +81,7 @@ func LoadBalancerListenerResource() *schema.Resource {
 						"idle_timeout_in_seconds": {
 							Type:             schema.TypeString,
 							Required:         true,
-							ValidateFunc:     tfresource.ValidateInt64TypeString,
+							ValidateFunc:     tfresource.ValidateInt64TypeStringInRange(1, listenerMaxIdleTimeoutInSeconds),
 							DiffSuppressFunc: tfresource.Int64StringDiffSuppressFunction,
 						},
 
@@ -78,14 +91,16 @@ func LoadBalancerListenerResource() *schema.Resource {
 							Optional: true,
 							Computed: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(oci_load_balancer.GetConnectionConfigurationBackendTcpProxyProtocolOptionsEnumStringValues(), false),
 							},
 						},
 
 						// Optional
 						"backend_tcp_proxy_protocol_version": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 2}),
 						},
 
 						// Computed
@@ -150,13 +165,23 @@ func LoadBalancerListenerResource() *schema.Resource {
 							Optional: true,
 							Computed: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(sslConfigurationProtocols, false),
 							},
 						},
+						"refresh_on_certificate_rotation": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"server_order_preference": {
 							Type:     schema.TypeString,
 							Optional: true,
 							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_load_balancer.SslConfigurationDetailsServerOrderPreferenceEnabled),
+								string(oci_load_balancer.SslConfigurationDetailsServerOrderPreferenceDisabled),
+							}, false),
 						},
 						"trusted_certificate_authority_ids": {
 							Type:     schema.TypeList,
@@ -182,6 +207,13 @@ func LoadBalancerListenerResource() *schema.Resource {
 			},
 
 			// Computed
+			"certificate_version_numbers": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			// internal for work request access
 			"state": {
 				Type:     schema.TypeString,
@@ -195,6 +227,7 @@ func createLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+	sync.CertificatesManagementClient = m.(*client.OracleClients).CertificatesManagementClient()
 
 	return tfresource.CreateResource(d, sync)
 }
@@ -203,6 +236,7 @@ func readLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+	sync.CertificatesManagementClient = m.(*client.OracleClients).CertificatesManagementClient()
 	return tfresource.ReadResource(sync)
 }
 
@@ -210,6 +244,7 @@ func updateLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 	sync := &LoadBalancerListenerResourceCrud{}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+	sync.CertificatesManagementClient = m.(*client.OracleClients).CertificatesManagementClient()
 
 	return tfresource.UpdateResource(d, sync)
 }
@@ -225,10 +260,11 @@ func deleteLoadBalancerListener(d *schema.ResourceData, m interface{}) error {
 
 type LoadBalancerListenerResourceCrud struct {
 	tfresource.BaseCrud
-	Client                 *oci_load_balancer.LoadBalancerClient
-	Res                    *oci_load_balancer.Listener
-	DisableNotFoundRetries bool
-	WorkRequest            *oci_load_balancer.WorkRequest
+	Client                       *oci_load_balancer.LoadBalancerClient
+	CertificatesManagementClient *oci_certificates_management.CertificatesManagementClient
+	Res                          *oci_load_balancer.Listener
+	DisableNotFoundRetries       bool
+	WorkRequest                  *oci_load_balancer.WorkRequest
 }
 
 func (s *LoadBalancerListenerResourceCrud) ID() string {
@@ -252,7 +288,6 @@ func (s *LoadBalancerListenerResourceCrud) CreatedPending() []string {
 func (s *LoadBalancerListenerResourceCrud) CreatedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
@@ -266,7 +301,6 @@ func (s *LoadBalancerListenerResourceCrud) DeletedPending() []string {
 func (s *LoadBalancerListenerResourceCrud) DeletedTarget() []string {
 	return []string{
 		string(oci_load_balancer.WorkRequestLifecycleStateSucceeded),
-		string(oci_load_balancer.WorkRequestLifecycleStateFailed),
 	}
 }
 
@@ -377,6 +411,10 @@ func (s *LoadBalancerListenerResourceCrud) Create() error {
 	s.WorkRequest = &workRequestResponse.WorkRequest
 	err = loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
 	if err != nil {
+		// The resource was not created, do not leave it in the state
+		if s.WorkRequest.LifecycleState == oci_load_balancer.WorkRequestLifecycleStateFailed {
+			s.D.SetId("")
+		}
 		return err
 	}
 	return nil
@@ -544,6 +582,9 @@ func (s *LoadBalancerListenerResourceCrud) Update() error {
 		return err
 	}
 
+	// The listener now serves the current versions of its certificates
+	s.setCertificateVersionNumbers()
+
 	return s.Get()
 }
 
@@ -625,11 +666,91 @@ func (s *LoadBalancerListenerResourceCrud) SetData() error {
 		s.D.Set("protocol", *s.Res.Protocol)
 	}
 	if s.Res.SslConfiguration != nil {
-		s.D.Set("ssl_configuration", []interface{}{SSLConfigurationToMap(s.Res.SslConfiguration)})
+		sslConfiguration := SSLConfigurationToMap(s.Res.SslConfiguration)
+		// refresh_on_certificate_rotation is not returned by the service, keep the configured value
+		sslConfiguration["refresh_on_certificate_rotation"] = s.D.Get("ssl_configuration.0.refresh_on_certificate_rotation")
+		s.D.Set("ssl_configuration", []interface{}{sslConfiguration})
 	} else {
 		s.D.Set("ssl_configuration", []interface{}{})
 	}
 
+	// The version numbers are only refreshed when the listener is created, updated or imported, so that a rotation of
+	// the certificates shows up as a difference with the current versions at plan time.
+	if certificateVersionNumbers, ok := s.D.Get("certificate_version_numbers").(map[string]interface{}); !ok || len(certificateVersionNumbers) == 0 {
+		s.setCertificateVersionNumbers()
+	}
+
+	return nil
+}
+
+func (s *LoadBalancerListenerResourceCrud) setCertificateVersionNumbers() {
+	certificateIds := sslConfigurationCertificateIds(s.D.Get("ssl_configuration.0.certificate_ids"))
+	if len(certificateIds) == 0 || s.CertificatesManagementClient == nil {
+		s.D.Set("certificate_version_numbers", map[string]interface{}{})
+		return
+	}
+
+	certificateVersionNumbers, err := getLoadBalancerCertificateVersionNumbers(s.CertificatesManagementClient, certificateIds, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+	if err != nil {
+		log.Printf("[WARN] unable to track the certificate versions of listener %s: %v", s.D.Id(), err)
+		return
+	}
+	s.D.Set("certificate_version_numbers", certificateVersionNumbers)
+}
+
+// validateLoadBalancerListenerConnectionConfiguration fails the plan when TCP proxy protocol options are set without
+// proxy protocol version 2, which is the only version they apply to.
+func validateLoadBalancerListenerConnectionConfiguration(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	connectionConfigurations := rawConfig.GetAttr("connection_configuration")
+	if connectionConfigurations.IsNull() || !connectionConfigurations.IsKnown() || connectionConfigurations.LengthInt() == 0 {
+		return nil
+	}
+
+	connectionConfiguration := connectionConfigurations.AsValueSlice()[0]
+	if connectionConfiguration.IsNull() || !connectionConfiguration.IsKnown() {
+		return nil
+	}
+	options := connectionConfiguration.GetAttr("backend_tcp_proxy_protocol_options")
+	if options.IsNull() || !options.IsKnown() || options.LengthInt() == 0 {
+		return nil
+	}
+	version := connectionConfiguration.GetAttr("backend_tcp_proxy_protocol_version")
+	if !version.IsKnown() {
+		return nil
+	}
+	if version.IsNull() || !version.Equals(cty.NumberIntVal(2)).True() {
+		return fmt.Errorf("connection_configuration.0.backend_tcp_proxy_protocol_options can only be set with backend_tcp_proxy_protocol_version 2")
+	}
+	return nil
+}
+
+// loadBalancerListenerCertificateVersionNumbersDiff plans a new value of certificate_version_numbers when the
+// certificates of the listener change or, if refresh_on_certificate_rotation is set, when a new version of one of them
+// has become current in the Certificates service, so that the listener gets updated to serve it.
+func loadBalancerListenerCertificateVersionNumbersDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.HasChange("ssl_configuration.0.certificate_ids") {
+		return d.SetNewComputed("certificate_version_numbers")
+	}
+	if d.Id() == "" || !d.Get("ssl_configuration.0.refresh_on_certificate_rotation").(bool) {
+		return nil
+	}
+
+	certificateIds := sslConfigurationCertificateIds(d.Get("ssl_configuration.0.certificate_ids"))
+	if len(certificateIds) == 0 {
+		return nil
+	}
+
+	certificateVersionNumbers, err := getLoadBalancerCertificateVersionNumbers(m.(*client.OracleClients).CertificatesManagementClient(), certificateIds, tfresource.GetRetryPolicy(false, "load_balancer"))
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(certificateVersionNumbers, d.Get("certificate_version_numbers")) {
+		return d.SetNew("certificate_version_numbers", certificateVersionNumbers)
+	}
 	return nil
 }
 
@@ -0,0 +1,238 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package load_balancer
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
+)
+
+// LoadBalancerListenerRuleSetAssociationResource manages the complete list of rule sets applied by a listener. The
+// listener is updated with its current configuration and the configured rule sets, so rule sets can be attached and
+// detached without the listener being managed in the same configuration. Deleting the association detaches all the
+// rule sets from the listener.
+func LoadBalancerListenerRuleSetAssociationResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createLoadBalancerListenerRuleSetAssociation,
+		Read:     readLoadBalancerListenerRuleSetAssociation,
+		Update:   updateLoadBalancerListenerRuleSetAssociation,
+		Delete:   deleteLoadBalancerListenerRuleSetAssociation,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"listener_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_set_names": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// Optional
+
+			// Computed
+		},
+	}
+}
+
+func createLoadBalancerListenerRuleSetAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &LoadBalancerListenerRuleSetAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readLoadBalancerListenerRuleSetAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &LoadBalancerListenerRuleSetAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateLoadBalancerListenerRuleSetAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &LoadBalancerListenerRuleSetAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteLoadBalancerListenerRuleSetAssociation(d *schema.ResourceData, m interface{}) error {
+	sync := &LoadBalancerListenerRuleSetAssociationResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoadBalancerClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type LoadBalancerListenerRuleSetAssociationResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_load_balancer.LoadBalancerClient
+	Res                    *oci_load_balancer.Listener
+	DisableNotFoundRetries bool
+	WorkRequest            *oci_load_balancer.WorkRequest
+}
+
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) ID() string {
+	return GetListenerCompositeId(s.D.Get("listener_name").(string), s.D.Get("load_balancer_id").(string))
+}
+
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) Create() error {
+	if err := s.updateRuleSetNames(s.configuredRuleSetNames()); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) Get() error {
+	loadBalancerId := s.D.Get("load_balancer_id").(string)
+	listenerName := s.D.Get("listener_name").(string)
+	if s.D.Id() != "" {
+		var err error
+		listenerName, loadBalancerId, err = parseListenerCompositeId(s.D.Id())
+		if err != nil {
+			log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
+			return err
+		}
+	}
+
+	request := oci_load_balancer.GetLoadBalancerRequest{}
+	request.LoadBalancerId = &loadBalancerId
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+
+	response, err := s.Client.GetLoadBalancer(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	listener, ok := response.LoadBalancer.Listeners[listenerName]
+	if !ok {
+		return fmt.Errorf("Listener %s on load balancer %s does not exist", listenerName, loadBalancerId)
+	}
+
+	s.D.Set("load_balancer_id", loadBalancerId)
+	s.Res = &listener
+	return nil
+}
+
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) Update() error {
+	if err := s.updateRuleSetNames(s.configuredRuleSetNames()); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) Delete() error {
+	return s.updateRuleSetNames([]string{})
+}
+
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	if s.Res.Name != nil {
+		s.D.Set("listener_name", *s.Res.Name)
+	}
+
+	s.D.Set("rule_set_names", s.Res.RuleSetNames)
+
+	return nil
+}
+
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) configuredRuleSetNames() []string {
+	interfaces := s.D.Get("rule_set_names").([]interface{})
+	ruleSetNames := make([]string, len(interfaces))
+	for i := range interfaces {
+		if interfaces[i] != nil {
+			ruleSetNames[i] = interfaces[i].(string)
+		}
+	}
+	return ruleSetNames
+}
+
+// updateRuleSetNames replaces the rule sets of the listener. UpdateListener replaces the whole listener configuration,
+// so the current configuration of the listener is sent along with the new rule sets. The load balancer is locked from
+// the read until the work request completes, so that concurrent changes made by the provider are not overwritten.
+func (s *LoadBalancerListenerRuleSetAssociationResourceCrud) updateRuleSetNames(ruleSetNames []string) error {
+	defer lbBackendSetMutexes.LockLoadBalancer(s.D.Get("load_balancer_id").(string))()
+
+	if err := s.Get(); err != nil {
+		return err
+	}
+
+	loadBalancerId := s.D.Get("load_balancer_id").(string)
+
+	request := oci_load_balancer.UpdateListenerRequest{}
+	request.LoadBalancerId = &loadBalancerId
+	request.ListenerName = s.Res.Name
+	request.DefaultBackendSetName = s.Res.DefaultBackendSetName
+	request.Port = s.Res.Port
+	request.Protocol = s.Res.Protocol
+	request.HostnameNames = s.Res.HostnameNames
+	request.PathRouteSetName = s.Res.PathRouteSetName
+	request.RoutingPolicyName = s.Res.RoutingPolicyName
+	request.ConnectionConfiguration = s.Res.ConnectionConfiguration
+	if s.Res.SslConfiguration != nil {
+		request.SslConfiguration = sslConfigurationToDetails(s.Res.SslConfiguration)
+	}
+	request.RuleSetNames = ruleSetNames
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+
+	response, err := s.Client.UpdateListener(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	getWorkRequestRequest := oci_load_balancer.GetWorkRequestRequest{}
+	getWorkRequestRequest.WorkRequestId = response.OpcWorkRequestId
+	getWorkRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	workRequestResponse, err := s.Client.GetWorkRequest(context.Background(), getWorkRequestRequest)
+	if err != nil {
+		return err
+	}
+	s.WorkRequest = &workRequestResponse.WorkRequest
+	return loadBalancerWaitForWorkRequest(s.Client, s.D, s.WorkRequest, tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer"))
+}
+
+func sslConfigurationToDetails(obj *oci_load_balancer.SslConfiguration) *oci_load_balancer.SslConfigurationDetails {
+	return &oci_load_balancer.SslConfigurationDetails{
+		CertificateIds:                 obj.CertificateIds,
+		CertificateName:                obj.CertificateName,
+		CipherSuiteName:                obj.CipherSuiteName,
+		HasSessionResumption:           obj.HasSessionResumption,
+		Protocols:                      obj.Protocols,
+		ServerOrderPreference:          oci_load_balancer.SslConfigurationDetailsServerOrderPreferenceEnum(obj.ServerOrderPreference),
+		TrustedCertificateAuthorityIds: obj.TrustedCertificateAuthorityIds,
+		VerifyDepth:                    obj.VerifyDepth,
+		VerifyPeerCertificate:          obj.VerifyPeerCertificate,
+	}
+}
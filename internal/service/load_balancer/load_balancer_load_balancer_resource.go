@@ -811,7 +811,12 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateNetworkSecurityGroups() err
 	return nil
 }
 
+// updateShape changes the shape of the load balancer. Shape updates hold the load balancer mutex exclusively, so that
+// backend set and backend changes of the same apply wait for the shape work request instead of conflicting with it,
+// and the request is retried on conflict with work requests started outside of this apply.
 func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(shape interface{}) error {
+	defer lbBackendSetMutexes.LockLoadBalancer(s.D.Id())()
+
 	changeShapeRequest := oci_load_balancer.UpdateLoadBalancerShapeRequest{}
 
 	shapeTmp := shape.(string)
@@ -820,7 +825,8 @@ func (s *LoadBalancerLoadBalancerResourceCrud) updateShape(shape interface{}) er
 	idTmp := s.D.Id()
 	changeShapeRequest.LoadBalancerId = &idTmp
 
-	changeShapeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer")
+	conflictRetryDurationFn := tfresource.GetConflictRetryDurationFunction(s.D.Timeout(schema.TimeoutUpdate))
+	changeShapeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "load_balancer", conflictRetryDurationFn)
 
 	if shapeDetails, ok := s.D.GetOkExists("shape_details"); ok {
 		if tmpList := shapeDetails.([]interface{}); len(tmpList) > 0 {
@@ -33,8 +33,9 @@ func LoadBalancerLoadBalancerRoutingPolicyResource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required
 			"condition_language_version": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"V1"}, false),
 			},
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -78,8 +79,9 @@ func LoadBalancerLoadBalancerRoutingPolicyResource() *schema.Resource {
 							},
 						},
 						"condition": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateRoutingPolicyCondition,
 						},
 						"name": {
 							Type:     schema.TypeString,
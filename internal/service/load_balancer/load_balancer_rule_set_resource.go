@@ -10,10 +10,12 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/oracle/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -28,11 +30,12 @@ func LoadBalancerRuleSetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Timeouts: tfresource.DefaultTimeout,
-		Create:   createLoadBalancerRuleSet,
-		Read:     readLoadBalancerRuleSet,
-		Update:   updateLoadBalancerRuleSet,
-		Delete:   deleteLoadBalancerRuleSet,
+		Timeouts:      tfresource.DefaultTimeout,
+		Create:        createLoadBalancerRuleSet,
+		Read:          readLoadBalancerRuleSet,
+		Update:        updateLoadBalancerRuleSet,
+		Delete:        deleteLoadBalancerRuleSet,
+		CustomizeDiff: validateLoadBalancerRuleSetItems,
 		Schema: map[string]*schema.Schema{
 			// Required
 			"items": {
@@ -1159,3 +1162,151 @@ func itemsHashCodeForSets(v interface{}) int {
 	}
 	return utils.GetStringHashcode(buf.String())
 }
+
+// ruleSetItemRequiredAttributes lists, for each rule action, the groups of attributes of which at least one must be set
+var ruleSetItemRequiredAttributes = map[string][][]string{
+	"ADD_HTTP_REQUEST_HEADER":           {{"header"}, {"value"}},
+	"ADD_HTTP_RESPONSE_HEADER":          {{"header"}, {"value"}},
+	"ALLOW":                             {{"conditions"}},
+	"CONTROL_ACCESS_USING_HTTP_METHODS": {{"allowed_methods"}},
+	"EXTEND_HTTP_REQUEST_HEADER_VALUE":  {{"header"}, {"prefix", "suffix"}},
+	"EXTEND_HTTP_RESPONSE_HEADER_VALUE": {{"header"}, {"prefix", "suffix"}},
+	"HTTP_HEADER":                       {{"are_invalid_characters_allowed", "http_large_header_size_in_kb"}},
+	"IP_BASED_MAX_CONNECTIONS":          {{"default_max_connections", "ip_max_connections"}},
+	"REDIRECT":                          {{"conditions"}, {"redirect_uri"}},
+	"REMOVE_HTTP_REQUEST_HEADER":        {{"header"}},
+	"REMOVE_HTTP_RESPONSE_HEADER":       {{"header"}},
+}
+
+// ruleSetItemAttributeActions lists the actions that accept each action specific attribute. Setting one of them on a
+// rule of another action usually means two rules were merged into one item, e.g. a redirect_uri on an ALLOW rule.
+var ruleSetItemAttributeActions = map[string][]string{
+	"allowed_methods":                {"CONTROL_ACCESS_USING_HTTP_METHODS"},
+	"are_invalid_characters_allowed": {"HTTP_HEADER"},
+	"conditions":                     {"ALLOW", "REDIRECT"},
+	"default_max_connections":        {"IP_BASED_MAX_CONNECTIONS"},
+	"http_large_header_size_in_kb":   {"HTTP_HEADER"},
+	"ip_max_connections":             {"IP_BASED_MAX_CONNECTIONS"},
+	"redirect_uri":                   {"REDIRECT"},
+	"response_code":                  {"REDIRECT"},
+	"status_code":                    {"CONTROL_ACCESS_USING_HTTP_METHODS"},
+}
+
+// ruleSetItemAttributeNames holds the keys of ruleSetItemAttributeActions in order, so that the same error is reported
+// on every plan
+var ruleSetItemAttributeNames = func() []string {
+	names := make([]string, 0, len(ruleSetItemAttributeActions))
+	for name := range ruleSetItemAttributeActions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}()
+
+// ruleSetConditionAttributes lists the condition attributes accepted by the rules that take conditions
+var ruleSetConditionAttributes = map[string][]string{
+	"ALLOW":    {"SOURCE_IP_ADDRESS", "SOURCE_VCN_ID", "SOURCE_VCN_IP_ADDRESS"},
+	"REDIRECT": {"PATH"},
+}
+
+// validateLoadBalancerRuleSetItems checks the combination of attributes set on each rule of the rule set at plan time
+// instead of letting the service reject it, or the provider silently drop the attributes the action does not use.
+// Rules whose values are not known yet are skipped.
+func validateLoadBalancerRuleSetItems(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	items := rawConfig.GetAttr("items")
+	if items.IsNull() || !items.IsKnown() {
+		return nil
+	}
+
+	for _, item := range items.AsValueSlice() {
+		if item.IsNull() || !item.IsKnown() {
+			continue
+		}
+		actionValue := item.GetAttr("action")
+		if actionValue.IsNull() || !actionValue.IsKnown() {
+			continue
+		}
+		action := strings.ToUpper(actionValue.AsString())
+
+		for _, group := range ruleSetItemRequiredAttributes[action] {
+			if !isRuleAttributeConfigured(item, group...) {
+				return fmt.Errorf("%s rules require %s", action, strings.Join(group, " or "))
+			}
+		}
+
+		for _, attribute := range ruleSetItemAttributeNames {
+			actions := ruleSetItemAttributeActions[attribute]
+			if isRuleAttributeConfigured(item, attribute) && !isStringInSlice(action, actions) {
+				return fmt.Errorf("%s cannot be set on %s rules, it only applies to %s rules", attribute, action, strings.Join(actions, " and "))
+			}
+		}
+
+		if conditionAttributes, ok := ruleSetConditionAttributes[action]; ok {
+			if err := validateRuleConditions(action, item.GetAttr("conditions"), conditionAttributes); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// isRuleAttributeConfigured is true if any of the attributes is set on the rule, values that are not known yet count
+// as set
+func isRuleAttributeConfigured(item cty.Value, names ...string) bool {
+	for _, name := range names {
+		value := item.GetAttr(name)
+		if !value.IsKnown() {
+			return true
+		}
+		if value.IsNull() {
+			continue
+		}
+		if value.Type() == cty.String {
+			if value.AsString() != "" {
+				return true
+			}
+			continue
+		}
+		if value.Type().IsListType() || value.Type().IsSetType() {
+			if value.LengthInt() > 0 {
+				return true
+			}
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func validateRuleConditions(action string, conditions cty.Value, allowed []string) error {
+	if conditions.IsNull() || !conditions.IsKnown() {
+		return nil
+	}
+	for _, condition := range conditions.AsValueSlice() {
+		if condition.IsNull() || !condition.IsKnown() {
+			continue
+		}
+		attributeName := condition.GetAttr("attribute_name")
+		if attributeName.IsNull() || !attributeName.IsKnown() {
+			continue
+		}
+		if !isStringInSlice(strings.ToUpper(attributeName.AsString()), allowed) {
+			return fmt.Errorf("%s rules only accept conditions on %s, got %s", action, strings.Join(allowed, ", "), attributeName.AsString())
+		}
+	}
+	return nil
+}
+
+func isStringInSlice(value string, values []string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
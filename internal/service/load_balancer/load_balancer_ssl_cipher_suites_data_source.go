@@ -5,6 +5,7 @@ package load_balancer
 
 import (
 	"context"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_load_balancer "github.com/oracle/oci-go-sdk/v65/loadbalancer"
@@ -13,11 +14,23 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 )
 
+const (
+	// Names starting with this prefix are reserved for the cipher suites managed by the service.
+	predefinedSslCipherSuitePrefix = "oci-"
+	// Returned as the cipher suite name of SSL configurations created with an explicit cipher list, it does not
+	// reference a suite that can be assigned.
+	customizedSslCipherSuiteName = "oci-customized-ssl-cipher-suite"
+)
+
 func LoadBalancerSslCipherSuitesDataSource() *schema.Resource {
 	return &schema.Resource{
 		Read: readLoadBalancerSslCipherSuites,
 		Schema: map[string]*schema.Schema{
 			"filter": tfresource.DataSourceFiltersSchema(),
+			"is_predefined": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"load_balancer_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -76,7 +89,12 @@ func (s *LoadBalancerSslCipherSuitesDataSourceCrud) SetData() error {
 	s.D.SetId(tfresource.GenerateDataSourceHashID("LoadBalancerSslCipherSuitesDataSource-", LoadBalancerSslCipherSuitesDataSource(), s.D))
 	resources := []map[string]interface{}{}
 
+	isPredefined, isPredefinedOk := s.D.GetOkExists("is_predefined")
 	for _, r := range s.Res.Items {
+		if isPredefinedOk && (r.Name != nil && isPredefinedSslCipherSuiteName(*r.Name)) != isPredefined.(bool) {
+			continue
+		}
+
 		sslCipherSuite := map[string]interface{}{}
 
 		sslCipherSuite["ciphers"] = r.Ciphers
@@ -98,3 +116,7 @@ func (s *LoadBalancerSslCipherSuitesDataSourceCrud) SetData() error {
 
 	return nil
 }
+
+func isPredefinedSslCipherSuiteName(name string) bool {
+	return strings.HasPrefix(name, predefinedSslCipherSuitePrefix) && name != customizedSslCipherSuiteName
+}
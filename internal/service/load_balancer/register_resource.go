@@ -11,6 +11,7 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_load_balancer_certificate", LoadBalancerCertificateResource())
 	tfresource.RegisterResource("oci_load_balancer_hostname", LoadBalancerHostnameResource())
 	tfresource.RegisterResource("oci_load_balancer_listener", LoadBalancerListenerResource())
+	tfresource.RegisterResource("oci_load_balancer_listener_rule_set_association", LoadBalancerListenerRuleSetAssociationResource())
 	tfresource.RegisterResource("oci_load_balancer_load_balancer", LoadBalancerLoadBalancerResource())
 	tfresource.RegisterResource("oci_load_balancer_load_balancer_routing_policy", LoadBalancerLoadBalancerRoutingPolicyResource())
 	tfresource.RegisterResource("oci_load_balancer_path_route_set", LoadBalancerPathRouteSetResource())
@@ -0,0 +1,618 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package logging
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+	oci_logging "github.com/oracle/oci-go-sdk/v65/logging"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+const (
+	flowLogService               = "flowlogs"
+	flowLogCategory              = "all"
+	flowLogCaptureFilterParamKey = "capture_filter"
+)
+
+// LoggingFlowLogResource enables VCN flow logs for a subnet, VNIC or VCN in one step. It creates the flow log
+// capture filter, the log group when no existing one is given, and the service log that ties them together.
+func LoggingFlowLogResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createLoggingFlowLog,
+		Read:     readLoggingFlowLog,
+		Update:   updateLoggingFlowLog,
+		Delete:   deleteLoggingFlowLog,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"flow_log_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(oci_core.FlowLogCaptureFilterRuleDetailsFlowLogTypeAll),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_core.FlowLogCaptureFilterRuleDetailsFlowLogTypeAll),
+					string(oci_core.FlowLogCaptureFilterRuleDetailsFlowLogTypeAccept),
+					string(oci_core.FlowLogCaptureFilterRuleDetailsFlowLogTypeReject),
+				}, false),
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"log_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"retention_duration": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"sampling_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 100000),
+			},
+
+			// Computed
+			"capture_filter_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_capture_filter_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_log_group_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"log_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createLoggingFlowLog(d *schema.ResourceData, m interface{}) error {
+	sync := &LoggingFlowLogResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
+	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readLoggingFlowLog(d *schema.ResourceData, m interface{}) error {
+	sync := &LoggingFlowLogResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
+	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateLoggingFlowLog(d *schema.ResourceData, m interface{}) error {
+	sync := &LoggingFlowLogResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
+	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteLoggingFlowLog(d *schema.ResourceData, m interface{}) error {
+	sync := &LoggingFlowLogResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).LoggingManagementClient()
+	sync.VirtualNetworkClient = m.(*client.OracleClients).VirtualNetworkClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type LoggingFlowLogResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_logging.LoggingManagementClient
+	VirtualNetworkClient   *oci_core.VirtualNetworkClient
+	Res                    *oci_logging.Log
+	CaptureFilter          *oci_core.CaptureFilter
+	DisableNotFoundRetries bool
+}
+
+func (s *LoggingFlowLogResourceCrud) ID() string {
+	return GetLogCompositeId(*s.Res.LogGroupId, *s.Res.Id)
+}
+
+func (s *LoggingFlowLogResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_logging.LogLifecycleStateCreating),
+	}
+}
+
+func (s *LoggingFlowLogResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_logging.LogLifecycleStateActive),
+	}
+}
+
+func (s *LoggingFlowLogResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_logging.LogLifecycleStateDeleting),
+	}
+}
+
+func (s *LoggingFlowLogResourceCrud) DeletedTarget() []string {
+	return []string{}
+}
+
+func (s *LoggingFlowLogResourceCrud) Create() error {
+	captureFilterId, err := s.createCaptureFilter()
+	if err != nil {
+		return err
+	}
+
+	logGroupId, logGroupCreated, err := s.createLogGroupIfMissing()
+	if err != nil {
+		s.cleanupAfterFailedCreate(captureFilterId, nil)
+		return err
+	}
+
+	request := oci_logging.CreateLogRequest{}
+	request.LogGroupId = logGroupId
+	request.LogType = oci_logging.CreateLogDetailsLogTypeService
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if isEnabled, ok := s.D.GetOkExists("is_enabled"); ok {
+		tmp := isEnabled.(bool)
+		request.IsEnabled = &tmp
+	}
+
+	if retentionDuration, ok := s.D.GetOkExists("retention_duration"); ok {
+		tmp := retentionDuration.(int)
+		request.RetentionDuration = &tmp
+	}
+
+	service := flowLogService
+	category := flowLogCategory
+	source := oci_logging.OciService{
+		Service:    &service,
+		Category:   &category,
+		Parameters: map[string]string{flowLogCaptureFilterParamKey: *captureFilterId},
+	}
+	if resourceId, ok := s.D.GetOkExists("resource_id"); ok {
+		tmp := resourceId.(string)
+		source.Resource = &tmp
+	}
+	request.Configuration = &oci_logging.Configuration{Source: source}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+
+	response, err := s.Client.CreateLog(context.Background(), request)
+	if err != nil {
+		s.cleanupAfterFailedCreate(captureFilterId, logGroupCreated)
+		return err
+	}
+
+	logId, err := logWaitForWorkRequest(response.OpcWorkRequestId, "log",
+		oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	if err != nil {
+		s.cleanupAfterFailedCreate(captureFilterId, logGroupCreated)
+		return err
+	}
+
+	s.D.Set("is_capture_filter_managed", true)
+	s.D.Set("is_log_group_managed", logGroupCreated != nil)
+	s.D.SetId(GetLogCompositeId(*logGroupId, *logId))
+
+	return s.Get()
+}
+
+func (s *LoggingFlowLogResourceCrud) Get() error {
+	request := oci_logging.GetLogRequest{}
+
+	logGroupId, logId, err := parseLogsCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+	request.LogGroupId = &logGroupId
+	request.LogId = &logId
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+
+	response, err := s.Client.GetLog(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Log
+
+	captureFilterId := flowLogCaptureFilterId(s.Res)
+	if captureFilterId == nil {
+		s.CaptureFilter = nil
+		return nil
+	}
+
+	captureFilterResponse, err := s.VirtualNetworkClient.GetCaptureFilter(context.Background(), oci_core.GetCaptureFilterRequest{
+		CaptureFilterId: captureFilterId,
+		RequestMetadata: oci_common.RequestMetadata{RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")},
+	})
+	if err != nil {
+		return err
+	}
+
+	s.CaptureFilter = &captureFilterResponse.CaptureFilter
+	return nil
+}
+
+func (s *LoggingFlowLogResourceCrud) Update() error {
+	if s.D.HasChange("flow_log_type") || s.D.HasChange("sampling_rate") {
+		captureFilterId := s.D.Get("capture_filter_id").(string)
+
+		request := oci_core.UpdateCaptureFilterRequest{}
+		request.CaptureFilterId = &captureFilterId
+		request.FlowLogCaptureFilterRules = []oci_core.FlowLogCaptureFilterRuleDetails{s.flowLogCaptureFilterRule()}
+		request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+		if _, err := s.VirtualNetworkClient.UpdateCaptureFilter(context.Background(), request); err != nil {
+			return err
+		}
+
+		if err := s.waitForCaptureFilterAvailable(&captureFilterId, s.D.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if !s.D.HasChange("display_name") && !s.D.HasChange("is_enabled") && !s.D.HasChange("retention_duration") {
+		return s.Get()
+	}
+
+	logGroupId, logId, err := parseLogsCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+
+	request := oci_logging.UpdateLogRequest{}
+	request.LogGroupId = &logGroupId
+	request.LogId = &logId
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if isEnabled, ok := s.D.GetOkExists("is_enabled"); ok {
+		tmp := isEnabled.(bool)
+		request.IsEnabled = &tmp
+	}
+
+	if retentionDuration, ok := s.D.GetOkExists("retention_duration"); ok {
+		tmp := retentionDuration.(int)
+		request.RetentionDuration = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+
+	response, err := s.Client.UpdateLog(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if _, err := logWaitForWorkRequest(response.OpcWorkRequestId, "log",
+		oci_logging.ActionTypesUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client); err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *LoggingFlowLogResourceCrud) Delete() error {
+	logGroupId, logId, err := parseLogsCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+
+	request := oci_logging.DeleteLogRequest{}
+	request.LogGroupId = &logGroupId
+	request.LogId = &logId
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+
+	response, err := s.Client.DeleteLog(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if _, err := logWaitForWorkRequest(response.OpcWorkRequestId, "log",
+		oci_logging.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client); err != nil {
+		return err
+	}
+
+	// The log group and the capture filter can only be removed once no log refers to them anymore
+	if isLogGroupManaged, ok := s.D.GetOkExists("is_log_group_managed"); ok && isLogGroupManaged.(bool) {
+		if err := s.deleteLogGroup(&logGroupId); err != nil {
+			return err
+		}
+	}
+
+	if isCaptureFilterManaged, ok := s.D.GetOkExists("is_capture_filter_managed"); ok && isCaptureFilterManaged.(bool) {
+		captureFilterId := s.D.Get("capture_filter_id").(string)
+		if err := s.deleteCaptureFilter(&captureFilterId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *LoggingFlowLogResourceCrud) SetData() error {
+	if s.Res.Id != nil {
+		s.D.Set("log_id", *s.Res.Id)
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	if s.Res.IsEnabled != nil {
+		s.D.Set("is_enabled", *s.Res.IsEnabled)
+	}
+
+	if s.Res.LogGroupId != nil {
+		s.D.Set("log_group_id", *s.Res.LogGroupId)
+	}
+
+	if s.Res.RetentionDuration != nil {
+		s.D.Set("retention_duration", *s.Res.RetentionDuration)
+	}
+
+	if s.Res.Configuration != nil {
+		if source, ok := s.Res.Configuration.Source.(oci_logging.OciService); ok && source.Resource != nil {
+			s.D.Set("resource_id", *source.Resource)
+		}
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.CaptureFilter != nil {
+		if s.CaptureFilter.Id != nil {
+			s.D.Set("capture_filter_id", *s.CaptureFilter.Id)
+		}
+
+		if s.CaptureFilter.CompartmentId != nil {
+			s.D.Set("compartment_id", *s.CaptureFilter.CompartmentId)
+		}
+
+		if len(s.CaptureFilter.FlowLogCaptureFilterRules) > 0 {
+			rule := s.CaptureFilter.FlowLogCaptureFilterRules[0]
+
+			s.D.Set("flow_log_type", rule.FlowLogType)
+
+			if rule.SamplingRate != nil {
+				s.D.Set("sampling_rate", *rule.SamplingRate)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *LoggingFlowLogResourceCrud) flowLogCaptureFilterRule() oci_core.FlowLogCaptureFilterRuleDetails {
+	isEnabled := true
+	rule := oci_core.FlowLogCaptureFilterRuleDetails{
+		IsEnabled:  &isEnabled,
+		RuleAction: oci_core.FlowLogCaptureFilterRuleDetailsRuleActionInclude,
+	}
+
+	if flowLogType, ok := s.D.GetOkExists("flow_log_type"); ok {
+		rule.FlowLogType = oci_core.FlowLogCaptureFilterRuleDetailsFlowLogTypeEnum(flowLogType.(string))
+	}
+
+	if samplingRate, ok := s.D.GetOkExists("sampling_rate"); ok {
+		tmp := samplingRate.(int)
+		rule.SamplingRate = &tmp
+	}
+
+	return rule
+}
+
+func (s *LoggingFlowLogResourceCrud) createCaptureFilter() (*string, error) {
+	request := oci_core.CreateCaptureFilterRequest{}
+	request.FilterType = oci_core.CreateCaptureFilterDetailsFilterTypeFlowlog
+	request.FlowLogCaptureFilterRules = []oci_core.FlowLogCaptureFilterRuleDetails{s.flowLogCaptureFilterRule()}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	response, err := s.VirtualNetworkClient.CreateCaptureFilter(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.waitForCaptureFilterAvailable(response.Id, s.D.Timeout(schema.TimeoutCreate)); err != nil {
+		s.cleanupAfterFailedCreate(response.Id, nil)
+		return nil, err
+	}
+
+	return response.Id, nil
+}
+
+// createLogGroupIfMissing returns the log group to put the flow log in. When no log_group_id is configured a new log
+// group is created, its ID is returned a second time so that the caller knows it owns it.
+func (s *LoggingFlowLogResourceCrud) createLogGroupIfMissing() (*string, *string, error) {
+	if logGroupId, ok := s.D.GetOkExists("log_group_id"); ok {
+		tmp := logGroupId.(string)
+		return &tmp, nil, nil
+	}
+
+	request := oci_logging.CreateLogGroupRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+
+	response, err := s.Client.CreateLogGroup(context.Background(), request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logGroupId, err := logGroupWaitForWorkRequest(response.OpcWorkRequestId, "loggroup",
+		oci_logging.ActionTypesCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return logGroupId, logGroupId, nil
+}
+
+func (s *LoggingFlowLogResourceCrud) waitForCaptureFilterAvailable(captureFilterId *string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(oci_core.CaptureFilterLifecycleStateProvisioning),
+			string(oci_core.CaptureFilterLifecycleStateUpdating),
+		},
+		Target: []string{
+			string(oci_core.CaptureFilterLifecycleStateAvailable),
+		},
+		Refresh: func() (interface{}, string, error) {
+			response, err := s.VirtualNetworkClient.GetCaptureFilter(context.Background(), oci_core.GetCaptureFilterRequest{
+				CaptureFilterId: captureFilterId,
+				RequestMetadata: oci_common.RequestMetadata{RetryPolicy: tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")},
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			return &response.CaptureFilter, string(response.LifecycleState), nil
+		},
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func (s *LoggingFlowLogResourceCrud) deleteLogGroup(logGroupId *string) error {
+	request := oci_logging.DeleteLogGroupRequest{}
+	request.LogGroupId = logGroupId
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "logging")
+
+	response, err := s.Client.DeleteLogGroup(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	_, err = logGroupWaitForWorkRequest(response.OpcWorkRequestId, "loggroup",
+		oci_logging.ActionTypesDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client)
+	return err
+}
+
+func (s *LoggingFlowLogResourceCrud) deleteCaptureFilter(captureFilterId *string) error {
+	request := oci_core.DeleteCaptureFilterRequest{}
+	request.CaptureFilterId = captureFilterId
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "core")
+
+	_, err := s.VirtualNetworkClient.DeleteCaptureFilter(context.Background(), request)
+	return err
+}
+
+// cleanupAfterFailedCreate removes the resources created before a later step of Create failed, so that a retry does
+// not leave orphaned capture filters and log groups behind.
+func (s *LoggingFlowLogResourceCrud) cleanupAfterFailedCreate(captureFilterId *string, logGroupId *string) {
+	if logGroupId != nil {
+		if err := s.deleteLogGroup(logGroupId); err != nil {
+			log.Printf("[DEBUG] cleanup of log group %s failed with the error: %v\n", *logGroupId, err)
+		}
+	}
+
+	if captureFilterId != nil {
+		if err := s.deleteCaptureFilter(captureFilterId); err != nil {
+			log.Printf("[DEBUG] cleanup of capture filter %s failed with the error: %v\n", *captureFilterId, err)
+		}
+	}
+}
+
+func flowLogCaptureFilterId(obj *oci_logging.Log) *string {
+	if obj.Configuration == nil {
+		return nil
+	}
+
+	source, ok := obj.Configuration.Source.(oci_logging.OciService)
+	if !ok {
+		return nil
+	}
+
+	captureFilterId, ok := source.Parameters[flowLogCaptureFilterParamKey]
+	if !ok || captureFilterId == "" {
+		return nil
+	}
+
+	return &captureFilterId
+}
@@ -6,6 +6,7 @@ package logging
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
+	tfresource.RegisterResource("oci_logging_flow_log", LoggingFlowLogResource())
 	tfresource.RegisterResource("oci_logging_log", LoggingLogResource())
 	tfresource.RegisterResource("oci_logging_log_group", LoggingLogGroupResource())
 	tfresource.RegisterResource("oci_logging_log_saved_search", LoggingLogSavedSearchResource())
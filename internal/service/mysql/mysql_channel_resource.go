@@ -257,6 +257,14 @@ func MysqlChannelResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"reset_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"resume_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
 
 			// Computed
 			"lifecycle_details": {
@@ -300,7 +308,36 @@ func updateMysqlChannel(d *schema.ResourceData, m interface{}) error {
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ChannelsClient()
 
-	return tfresource.UpdateResource(d, sync)
+	if err := tfresource.UpdateResource(d, sync); err != nil {
+		return err
+	}
+
+	// a channel is reset after it was disabled by the update, and resumed after it was enabled
+	if _, ok := sync.D.GetOkExists("reset_trigger"); ok && sync.D.HasChange("reset_trigger") {
+		oldRaw, newRaw := sync.D.GetChange("reset_trigger")
+		if oldRaw.(int) < newRaw.(int) {
+			if err := sync.ResetChannel(); err != nil {
+				return err
+			}
+		} else {
+			sync.D.Set("reset_trigger", oldRaw)
+			return fmt.Errorf("new value of trigger should be greater than the old value")
+		}
+	}
+
+	if _, ok := sync.D.GetOkExists("resume_trigger"); ok && sync.D.HasChange("resume_trigger") {
+		oldRaw, newRaw := sync.D.GetChange("resume_trigger")
+		if oldRaw.(int) < newRaw.(int) {
+			if err := sync.ResumeChannel(); err != nil {
+				return err
+			}
+		} else {
+			sync.D.Set("resume_trigger", oldRaw)
+			return fmt.Errorf("new value of trigger should be greater than the old value")
+		}
+	}
+
+	return nil
 }
 
 func deleteMysqlChannel(d *schema.ResourceData, m interface{}) error {
@@ -513,6 +550,46 @@ func (s *MysqlChannelResourceCrud) Update() error {
 	return s.Get()
 }
 
+func (s *MysqlChannelResourceCrud) ResetChannel() error {
+	request := oci_mysql.ResetChannelRequest{}
+
+	tmp := s.D.Id()
+	request.ChannelId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+
+	_, err := s.Client.ResetChannel(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		return waitErr
+	}
+
+	return s.SetData()
+}
+
+func (s *MysqlChannelResourceCrud) ResumeChannel() error {
+	request := oci_mysql.ResumeChannelRequest{}
+
+	tmp := s.D.Id()
+	request.ChannelId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+
+	_, err := s.Client.ResumeChannel(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		return waitErr
+	}
+
+	return s.SetData()
+}
+
 func (s *MysqlChannelResourceCrud) Delete() error {
 	request := oci_mysql.DeleteChannelRequest{}
 
@@ -165,9 +165,14 @@ func updateMysqlHeatWaveCluster(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	// switch to power on
+	// switch to power on, a failed cluster is recovered by restarting it
 	if powerOn {
-		if err := sync.Start(); err != nil {
+		oldState, _ := sync.D.GetChange("state")
+		if oci_mysql.HeatWaveClusterLifecycleStateFailed == oci_mysql.HeatWaveClusterLifecycleStateEnum(strings.ToUpper(oldState.(string))) {
+			if err := sync.Restart(); err != nil {
+				return err
+			}
+		} else if err := sync.Start(); err != nil {
 			return err
 		}
 		sync.D.Set("state", oci_mysql.HeatWaveClusterLifecycleStateActive)
@@ -241,6 +246,7 @@ func (s *MysqlHeatWaveClusterResourceCrud) UpdatedPending() []string {
 func (s *MysqlHeatWaveClusterResourceCrud) UpdatedTarget() []string {
 	return []string{
 		string(oci_mysql.HeatWaveClusterLifecycleStateActive),
+		string(oci_mysql.HeatWaveClusterLifecycleStateInactive),
 	}
 }
 
@@ -305,6 +311,10 @@ func (s *MysqlHeatWaveClusterResourceCrud) Get() error {
 }
 
 func (s *MysqlHeatWaveClusterResourceCrud) Update() error {
+	if !s.D.HasChange("cluster_size") && !s.D.HasChange("is_lakehouse_enabled") && !s.D.HasChange("shape_name") {
+		return s.Get()
+	}
+
 	request := oci_mysql.UpdateHeatWaveClusterRequest{}
 
 	if clusterSize, ok := s.D.GetOkExists("cluster_size"); ok {
@@ -334,7 +344,40 @@ func (s *MysqlHeatWaveClusterResourceCrud) Update() error {
 		return err
 	}
 
-	return s.Get()
+	// the nodes are resized asynchronously and the cluster can still be reported with its previous size while the
+	// update is starting, wait until the requested configuration is applied
+	err = tfresource.WaitForResourceCondition(s, func() bool {
+		return s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateFailed || s.isUpdateApplied(request)
+	}, s.D.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateFailed {
+		lifecycleDetails := ""
+		if s.Res.LifecycleDetails != nil {
+			lifecycleDetails = *s.Res.LifecycleDetails
+		}
+		return fmt.Errorf("the update of the HeatWave cluster of DB system %s failed: %s, set state to ACTIVE to restart the cluster", *request.DbSystemId, lifecycleDetails)
+	}
+
+	return nil
+}
+
+func (s *MysqlHeatWaveClusterResourceCrud) isUpdateApplied(request oci_mysql.UpdateHeatWaveClusterRequest) bool {
+	if s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateUpdating {
+		return false
+	}
+	if request.ClusterSize != nil && (s.Res.ClusterSize == nil || *s.Res.ClusterSize != *request.ClusterSize) {
+		return false
+	}
+	if request.ShapeName != nil && (s.Res.ShapeName == nil || *s.Res.ShapeName != *request.ShapeName) {
+		return false
+	}
+	if request.IsLakehouseEnabled != nil && (s.Res.IsLakehouseEnabled == nil || *s.Res.IsLakehouseEnabled != *request.IsLakehouseEnabled) {
+		return false
+	}
+	return true
 }
 
 func (s *MysqlHeatWaveClusterResourceCrud) Delete() error {
@@ -474,3 +517,22 @@ func (s *MysqlHeatWaveClusterResourceCrud) Start() error {
 	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateActive }
 	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
 }
+
+func (s *MysqlHeatWaveClusterResourceCrud) Restart() error {
+	request := oci_mysql.RestartHeatWaveClusterRequest{}
+
+	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
+		tmp := dbSystemId.(string)
+		request.DbSystemId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "mysql")
+
+	_, err := s.Client.RestartHeatWaveCluster(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	retentionPolicyFunc := func() bool { return s.Res.LifecycleState == oci_mysql.HeatWaveClusterLifecycleStateActive }
+	return tfresource.WaitForResourceCondition(s, retentionPolicyFunc, s.D.Timeout(schema.TimeoutUpdate))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/client"
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -35,6 +36,15 @@ func MysqlMysqlDbSystemResource() *schema.Resource {
 		Read:   readMysqlMysqlDbSystem,
 		Update: updateMysqlMysqlDbSystem,
 		Delete: deleteMysqlMysqlDbSystem,
+		CustomizeDiff: customdiff.All(
+			tfresource.RequiredWhen(tfresource.AllOf(tfresource.AttributeIsConfigured("source.0.source_type"), tfresource.AttributeIn("source.0.source_type", "BACKUP")), "source_type is BACKUP", "source.0.backup_id"),
+			tfresource.RequiredWhen(tfresource.AllOf(tfresource.AttributeIsConfigured("source.0.source_type"), tfresource.AttributeIn("source.0.source_type", "IMPORTURL")), "source_type is IMPORTURL", "source.0.source_url"),
+			tfresource.RequiredWhen(tfresource.AllOf(tfresource.AttributeIsConfigured("source.0.source_type"), tfresource.AttributeIn("source.0.source_type", "PITR")), "source_type is PITR", "source.0.db_system_id"),
+			tfresource.ConflictsWhen(tfresource.AllOf(tfresource.AttributeIsConfigured("source.0.source_type"), tfresource.AttributeNotIn("source.0.source_type", "PITR")), "source_type is not PITR", "source.0.recovery_point"),
+			tfresource.ErrorWhen(
+				tfresource.AllOf(tfresource.AttributeIsTrue("backup_policy.0.pitr_policy.0.is_enabled"), tfresource.AttributeIsFalse("backup_policy.0.is_enabled")),
+				"backup_policy.0.pitr_policy.0.is_enabled cannot be true when automatic backups are disabled, point-in-time recovery requires backup_policy.0.is_enabled to be true"),
+		),
 		Schema: map[string]*schema.Schema{
 			// Required
 			"availability_domain": {
@@ -118,9 +128,10 @@ func MysqlMysqlDbSystemResource() *schema.Resource {
 							},
 						},
 						"retention_in_days": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 35),
 						},
 						"window_start_time": {
 							Type:     schema.TypeString,
@@ -388,6 +399,7 @@ func MysqlMysqlDbSystemResource() *schema.Resource {
 							Computed:         true,
 							ForceNew:         true,
 							DiffSuppressFunc: tfresource.TimeDiffSuppressFunction,
+							ValidateFunc:     validation.IsRFC3339Time,
 						},
 						"source_url": {
 							Type:      schema.TypeString,
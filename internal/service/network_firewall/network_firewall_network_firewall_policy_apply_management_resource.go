@@ -0,0 +1,175 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package network_firewall
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	oci_network_firewall "github.com/oracle/oci-go-sdk/v65/networkfirewall"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+// NetworkFirewallNetworkFirewallPolicyApplyManagementResource applies the pending changes of the policy components,
+// address lists, applications, security rules and so on, to the firewalls that use the policy. It is a separate
+// resource so that it can depend on the component resources and run after all of them have been changed. The etag of
+// the policy is recorded once applied, a policy changed since then is removed from the state so that it is applied again.
+func NetworkFirewallNetworkFirewallPolicyApplyManagementResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createNetworkFirewallNetworkFirewallPolicyApplyManagement,
+		Read:     readNetworkFirewallNetworkFirewallPolicyApplyManagement,
+		Delete:   deleteNetworkFirewallNetworkFirewallPolicyApplyManagement,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"network_firewall_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"firewalls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// Computed
+			"policy_etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createNetworkFirewallNetworkFirewallPolicyApplyManagement(d *schema.ResourceData, m interface{}) error {
+	sync := &NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readNetworkFirewallNetworkFirewallPolicyApplyManagement(d *schema.ResourceData, m interface{}) error {
+	sync := &NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).NetworkFirewallClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func deleteNetworkFirewallNetworkFirewallPolicyApplyManagement(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_network_firewall.NetworkFirewallClient
+	Res                    *oci_network_firewall.NetworkFirewallPolicy
+	Etag                   *string
+	DisableNotFoundRetries bool
+}
+
+func (s *NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud) ID() string {
+	return tfresource.GenerateDataSourceHashID("NetworkFirewallNetworkFirewallPolicyApplyManagementResource-", NetworkFirewallNetworkFirewallPolicyApplyManagementResource(), s.D)
+}
+
+func (s *NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud) Create() error {
+	request := oci_network_firewall.ApplyNetworkFirewallPolicyRequest{}
+
+	if firewalls, ok := s.D.GetOkExists("firewalls"); ok {
+		interfaces := firewalls.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		if len(tmp) != 0 {
+			request.Firewalls = tmp
+		}
+	}
+
+	if networkFirewallPolicyId, ok := s.D.GetOkExists("network_firewall_policy_id"); ok {
+		tmp := networkFirewallPolicyId.(string)
+		request.NetworkFirewallPolicyId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
+
+	response, err := s.Client.ApplyNetworkFirewallPolicy(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workId := response.OpcWorkRequestId
+	_, err = networkFirewallPolicyWaitForWorkRequest(workId, "networkfirewallpolicy",
+		oci_network_firewall.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	if err != nil {
+		return err
+	}
+
+	return s.getNetworkFirewallPolicy(*request.NetworkFirewallPolicyId)
+}
+
+func (s *NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud) Get() error {
+	return s.getNetworkFirewallPolicy(s.D.Get("network_firewall_policy_id").(string))
+}
+
+func (s *NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	if s.Etag != nil {
+		// The policy or its components changed since the policy was applied, remove it from the state so that it
+		// is applied again on next apply
+		if appliedEtag := s.D.Get("policy_etag").(string); appliedEtag != "" && appliedEtag != *s.Etag {
+			s.VoidState()
+			return nil
+		}
+		s.D.Set("policy_etag", *s.Etag)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeUpdated != nil {
+		s.D.Set("time_updated", s.Res.TimeUpdated.String())
+	}
+
+	return nil
+}
+
+func (s *NetworkFirewallNetworkFirewallPolicyApplyManagementResourceCrud) getNetworkFirewallPolicy(networkFirewallPolicyId string) error {
+	request := oci_network_firewall.GetNetworkFirewallPolicyRequest{}
+
+	request.NetworkFirewallPolicyId = &networkFirewallPolicyId
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
+
+	response, err := s.Client.GetNetworkFirewallPolicy(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.NetworkFirewallPolicy
+	s.Etag = response.Etag
+	return nil
+}
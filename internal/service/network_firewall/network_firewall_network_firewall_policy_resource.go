@@ -96,13 +96,6 @@ func createNetworkFirewallNetworkFirewallPolicy(d *schema.ResourceData, m interf
 		return e
 	}
 
-	if _, ok := sync.D.GetOkExists("apply_policy_trigger"); ok {
-		err := sync.ApplyNetworkFirewallPolicy()
-		if err != nil {
-			return err
-		}
-	}
-
 	if _, ok := sync.D.GetOkExists("clone_policy_trigger"); ok {
 		err := sync.CloneNetworkFirewallPolicy()
 		if err != nil {
@@ -469,43 +462,6 @@ func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) SetData() error {
 	return nil
 }
 
-func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) ApplyNetworkFirewallPolicy() error {
-	request := oci_network_firewall.ApplyNetworkFirewallPolicyRequest{}
-
-	if firewalls, ok := s.D.GetOkExists("firewalls"); ok {
-		interfaces := firewalls.([]interface{})
-		tmp := make([]string, len(interfaces))
-		for i := range interfaces {
-			if interfaces[i] != nil {
-				tmp[i] = interfaces[i].(string)
-			}
-		}
-		if len(tmp) != 0 || s.D.HasChange("firewalls") {
-			request.Firewalls = tmp
-		}
-	}
-
-	idTmp := s.D.Id()
-	request.NetworkFirewallPolicyId = &idTmp
-
-	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "network_firewall")
-
-	response, err := s.Client.ApplyNetworkFirewallPolicy(context.Background(), request)
-	if err != nil {
-		return err
-	}
-
-	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
-		return waitErr
-	}
-
-	val := s.D.Get("apply_policy_trigger")
-	s.D.Set("apply_policy_trigger", val)
-
-	s.Res = &response.NetworkFirewallPolicy
-	return nil
-}
-
 func (s *NetworkFirewallNetworkFirewallPolicyResourceCrud) CloneNetworkFirewallPolicy() error {
 	request := oci_network_firewall.CloneNetworkFirewallPolicyRequest{}
 
@@ -11,6 +11,7 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_address_list", NetworkFirewallNetworkFirewallPolicyAddressListResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_application", NetworkFirewallNetworkFirewallPolicyApplicationResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_application_group", NetworkFirewallNetworkFirewallPolicyApplicationGroupResource())
+	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_apply_management", NetworkFirewallNetworkFirewallPolicyApplyManagementResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_decryption_profile", NetworkFirewallNetworkFirewallPolicyDecryptionProfileResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_decryption_rule", NetworkFirewallNetworkFirewallPolicyDecryptionRuleResource())
 	tfresource.RegisterResource("oci_network_firewall_network_firewall_policy_mapped_secret", NetworkFirewallNetworkFirewallPolicyMappedSecretResource())
@@ -15,6 +15,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
@@ -97,9 +98,10 @@ func NetworkLoadBalancerNetworkLoadBalancerResource() *schema.Resource {
 				},
 			},
 			"nlb_ip_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(oci_network_load_balancer.GetNlbIpVersionEnumStringValues(), false),
 			},
 			"reserved_ips": {
 				Type:     schema.TypeList,
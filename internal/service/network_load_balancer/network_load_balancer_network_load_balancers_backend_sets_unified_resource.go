@@ -16,6 +16,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
@@ -215,9 +216,10 @@ func NetworkLoadBalancerNetworkLoadBalancersBackendSetsUnifiedResource() *schema
 				},
 			},
 			"ip_version": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(oci_network_load_balancer.GetIpVersionEnumStringValues(), false),
 			},
 			"is_fail_open": {
 				Type:     schema.TypeBool,
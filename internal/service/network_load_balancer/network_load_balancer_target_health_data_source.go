@@ -0,0 +1,258 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package network_load_balancer
+
+import (
+	"context"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_network_load_balancer "github.com/oracle/oci-go-sdk/v65/networkloadbalancer"
+)
+
+// NetworkLoadBalancerTargetHealthDataSource reports the health of a target in every backend set of a network load
+// balancer, so that the health of an instance can be read without knowing the backend sets and backend names it is
+// registered under.
+func NetworkLoadBalancerTargetHealthDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readNetworkLoadBalancerTargetHealth,
+		Schema: map[string]*schema.Schema{
+			"network_load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ip_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"ip_address", "target_id"},
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"ip_address", "target_id"},
+			},
+			// Computed
+			"backend_healths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"backend_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backend_set_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"health_check_results": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+
+									// Optional
+
+									// Computed
+									"health_check_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"timestamp": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"is_backup": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_drain": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_offline": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func readNetworkLoadBalancerTargetHealth(d *schema.ResourceData, m interface{}) error {
+	sync := &NetworkLoadBalancerTargetHealthDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).NetworkLoadBalancerClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type NetworkLoadBalancerTargetHealthDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_network_load_balancer.NetworkLoadBalancerClient
+	Res    []nlbTargetBackendHealth
+}
+
+type nlbTargetBackendHealth struct {
+	BackendSetName string
+	Backend        oci_network_load_balancer.Backend
+	Health         oci_network_load_balancer.BackendHealth
+}
+
+func (s *NetworkLoadBalancerTargetHealthDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *NetworkLoadBalancerTargetHealthDataSourceCrud) Get() error {
+	networkLoadBalancerId := s.D.Get("network_load_balancer_id").(string)
+	retryPolicy := tfresource.GetRetryPolicy(false, "network_load_balancer")
+
+	request := oci_network_load_balancer.ListBackendSetsRequest{}
+	request.NetworkLoadBalancerId = &networkLoadBalancerId
+	request.RequestMetadata.RetryPolicy = retryPolicy
+
+	backendSets := []oci_network_load_balancer.BackendSetSummary{}
+	for {
+		listResponse, err := s.Client.ListBackendSets(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		backendSets = append(backendSets, listResponse.Items...)
+		if request.Page = listResponse.OpcNextPage; request.Page == nil {
+			break
+		}
+	}
+
+	s.Res = []nlbTargetBackendHealth{}
+	for _, backendSet := range backendSets {
+		if backendSet.Name == nil {
+			continue
+		}
+		for _, backend := range backendSet.Backends {
+			if backend.Name == nil || !s.isTargetBackend(backend) {
+				continue
+			}
+
+			healthRequest := oci_network_load_balancer.GetBackendHealthRequest{}
+			healthRequest.NetworkLoadBalancerId = &networkLoadBalancerId
+			healthRequest.BackendSetName = backendSet.Name
+			healthRequest.BackendName = backend.Name
+			healthRequest.RequestMetadata.RetryPolicy = retryPolicy
+
+			healthResponse, err := s.Client.GetBackendHealth(context.Background(), healthRequest)
+			if err != nil {
+				return err
+			}
+
+			s.Res = append(s.Res, nlbTargetBackendHealth{
+				BackendSetName: *backendSet.Name,
+				Backend:        backend,
+				Health:         healthResponse.BackendHealth,
+			})
+		}
+	}
+
+	return nil
+}
+
+// isTargetBackend returns whether the backend matches all the target attributes set in the configuration.
+func (s *NetworkLoadBalancerTargetHealthDataSourceCrud) isTargetBackend(backend oci_network_load_balancer.Backend) bool {
+	if targetId, ok := s.D.GetOkExists("target_id"); ok {
+		if backend.TargetId == nil || *backend.TargetId != targetId.(string) {
+			return false
+		}
+	}
+
+	if ipAddress, ok := s.D.GetOkExists("ip_address"); ok {
+		if backend.IpAddress == nil || *backend.IpAddress != ipAddress.(string) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *NetworkLoadBalancerTargetHealthDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(tfresource.GenerateDataSourceHashID("NetworkLoadBalancerTargetHealthDataSource-", NetworkLoadBalancerTargetHealthDataSource(), s.D))
+
+	backendHealths := []interface{}{}
+	for _, item := range s.Res {
+		backendHealths = append(backendHealths, nlbTargetBackendHealthToMap(item))
+	}
+	if err := s.D.Set("backend_healths", backendHealths); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func nlbTargetBackendHealthToMap(obj nlbTargetBackendHealth) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.Backend.Name != nil {
+		result["backend_name"] = string(*obj.Backend.Name)
+	}
+
+	result["backend_set_name"] = obj.BackendSetName
+
+	healthCheckResults := []interface{}{}
+	for _, item := range obj.Health.HealthCheckResults {
+		healthCheckResults = append(healthCheckResults, NlbHealthCheckResultToMap(item))
+	}
+	result["health_check_results"] = healthCheckResults
+
+	if obj.Backend.IsBackup != nil {
+		result["is_backup"] = bool(*obj.Backend.IsBackup)
+	}
+
+	if obj.Backend.IsDrain != nil {
+		result["is_drain"] = bool(*obj.Backend.IsDrain)
+	}
+
+	if obj.Backend.IsOffline != nil {
+		result["is_offline"] = bool(*obj.Backend.IsOffline)
+	}
+
+	if obj.Backend.Port != nil {
+		result["port"] = int(*obj.Backend.Port)
+	}
+
+	result["status"] = string(obj.Health.Status)
+
+	if obj.Backend.Weight != nil {
+		result["weight"] = int(*obj.Backend.Weight)
+	}
+
+	return result
+}
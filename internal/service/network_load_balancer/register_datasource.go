@@ -18,4 +18,5 @@ func RegisterDatasource() {
 	tfresource.RegisterDatasource("oci_network_load_balancer_network_load_balancers", NetworkLoadBalancerNetworkLoadBalancersDataSource())
 	tfresource.RegisterDatasource("oci_network_load_balancer_network_load_balancers_policies", NetworkLoadBalancerNetworkLoadBalancersPoliciesDataSource())
 	tfresource.RegisterDatasource("oci_network_load_balancer_network_load_balancers_protocols", NetworkLoadBalancerNetworkLoadBalancersProtocolsDataSource())
+	tfresource.RegisterDatasource("oci_network_load_balancer_target_health", NetworkLoadBalancerTargetHealthDataSource())
 }
@@ -4,13 +4,15 @@
 package objectstorage
 
 import (
-	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -29,8 +31,10 @@ import (
 
 const DefaultFilePartSize int64 = 128 * 1024 * 1024 // 128MB
 const defaultNumberOfGoroutines = 10
+const MinPartSize int64 = 10 * 1024 * 1024
 const MaxPartSize int64 = 50 * 1024 * 1024 * 1024
 const MaxCount int64 = 10000
+const maxPartUploadAttempts = 3
 
 type MultipartUploadData struct {
 	NamespaceName       *string                                 `mandatory:"true"`
@@ -50,21 +54,26 @@ type MultipartUploadData struct {
 	Metadata            map[string]interface{}
 	OpcClientRequestID  *string
 	RequestMetadata     common.RequestMetadata
+	// Size of the parts of a multipart upload, DefaultFilePartSize if not set
+	PartSize int64
+	// Number of parts uploaded in parallel, defaultNumberOfGoroutines if not set
+	Parallelism int
 }
 
 type objectStorageUploadPartResponse struct {
-	response   oci_object_storage.UploadPartResponse
+	etag       *string
+	md5        []byte
 	partNumber *int
 	error      error
 }
 
 type objectStorageMultiPartUploadContext struct {
-	client                  oci_object_storage.ObjectStorageClient
-	sourceBlocks            chan objectStorageSourceBlock
-	osUploadPartResponses   chan objectStorageUploadPartResponse
-	wg                      *sync.WaitGroup
-	multipartUploadResponse oci_object_storage.CreateMultipartUploadResponse
-	multipartUploadRequest  oci_object_storage.CreateMultipartUploadRequest
+	client                oci_object_storage.ObjectStorageClient
+	sourceBlocks          chan objectStorageSourceBlock
+	osUploadPartResponses chan objectStorageUploadPartResponse
+	wg                    *sync.WaitGroup
+	multipartUpload       oci_object_storage.MultipartUpload
+	requestMetadata       common.RequestMetadata
 }
 
 type objectStorageSourceBlock struct {
@@ -125,6 +134,15 @@ func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
 
 	defer tfresource.SafeClose(sourceFile, &err)
 
+	contentMd5, err := objectStorageReaderMd5(sourceFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute the MD5 hash of the source %q: %s", sourcePath, err)
+	}
+	if _, err = sourceFile.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	contentMd5B64 := base64.StdEncoding.EncodeToString(contentMd5)
+
 	tmpSize := sourceInfo.Size()
 
 	putObjectRequest := &oci_object_storage.PutObjectRequest{
@@ -135,6 +153,7 @@ func singlePartUpload(multipartUploadData MultipartUploadData) (string, error) {
 		ContentType:        multipartUploadData.ContentType,
 		BucketName:         multipartUploadData.BucketName,
 		ContentLength:      &tmpSize,
+		ContentMD5:         &contentMd5B64,
 		PutObjectBody:      ioutil.NopCloser(sourceFile),
 		OpcMeta:            resourceObjectStorageMapToMetadata(multipartUploadData.Metadata),
 		NamespaceName:      multipartUploadData.NamespaceName,
@@ -183,18 +202,35 @@ func MultiPartUpload(multipartUploadData MultipartUploadData) (string, error) {
 
 	sourceInfo := *multipartUploadData.SourceInfo
 
-	if sourceInfo.Size() > DefaultFilePartSize {
+	if sourceInfo.Size() > multipartUploadData.partSize() {
 		return multiPartUploadImpl(multipartUploadData)
 	}
 
 	return singlePartUpload(multipartUploadData)
 }
 
+func (multipartUploadData MultipartUploadData) partSize() int64 {
+	if multipartUploadData.PartSize > 0 {
+		return multipartUploadData.PartSize
+	}
+	return DefaultFilePartSize
+}
+
+func (multipartUploadData MultipartUploadData) parallelism() int {
+	if multipartUploadData.Parallelism > 0 {
+		return multipartUploadData.Parallelism
+	}
+	return defaultNumberOfGoroutines
+}
+
+// multiPartUploadImpl uploads the source in parts. A failed part is uploaded again, up to maxPartUploadAttempts times,
+// without restarting the upload. If the upload still fails it is aborted, so that no uncommitted parts are left behind.
 func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error) {
 
 	multipartUploadRequest := &oci_object_storage.CreateMultipartUploadRequest{
 		NamespaceName:   multipartUploadData.NamespaceName,
 		BucketName:      multipartUploadData.BucketName,
+		OpcSseKmsKeyId:  multipartUploadData.OpcSseKmsKeyId,
 		RequestMetadata: multipartUploadData.RequestMetadata,
 		CreateMultipartUploadDetails: oci_object_storage.CreateMultipartUploadDetails{
 			CacheControl:       multipartUploadData.CacheControl,
@@ -216,7 +252,7 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	}
 	defer tfresource.SafeClose(file, &err)
 
-	sourceBlocks, err := objectMultiPartSplit(file)
+	sourceBlocks, err := objectMultiPartSplit(file, multipartUploadData.partSize())
 	if err != nil {
 		return "", fmt.Errorf("error splitting source file for upload \"%v\": %s", source, err)
 	}
@@ -225,8 +261,9 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	if err != nil {
 		return "", fmt.Errorf("error creating object in the Oracle cloud \"%v\": %s", source, err)
 	}
+	multipartUpload := &multipartUploadResponse.MultipartUpload
 
-	workerCount := defaultNumberOfGoroutines
+	workerCount := multipartUploadData.parallelism()
 
 	osUploadPartResponses := make(chan objectStorageUploadPartResponse, len(sourceBlocks))
 	sourceBlocksChan := make(chan objectStorageSourceBlock, len(sourceBlocks))
@@ -241,12 +278,12 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 
 	for i := 0; i < workerCount; i++ {
 		go uploadPartsWorker(objectStorageMultiPartUploadContext{
-			client:                  *client,
-			wg:                      wg,
-			multipartUploadResponse: multipartUploadResponse,
-			multipartUploadRequest:  *multipartUploadRequest,
-			sourceBlocks:            sourceBlocksChan,
-			osUploadPartResponses:   osUploadPartResponses,
+			client:                *client,
+			wg:                    wg,
+			multipartUpload:       *multipartUpload,
+			requestMetadata:       multipartUploadRequest.RequestMetadata,
+			sourceBlocks:          sourceBlocksChan,
+			osUploadPartResponses: osUploadPartResponses,
 		})
 	}
 
@@ -254,9 +291,9 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 
 	close(osUploadPartResponses)
 
-	commitMultipartUploadPartDetails := make([]oci_object_storage.CommitMultipartUploadPartDetails, len(sourceBlocks))
+	commitMultipartUploadPartDetails := make([]oci_object_storage.CommitMultipartUploadPartDetails, 0, len(sourceBlocks))
+	partMd5s := map[int][]byte{}
 
-	osResponseIndex := 0
 	var uploadPartRespErr error
 	for osUploadPartResponse := range osUploadPartResponses {
 		if osUploadPartResponse.error != nil {
@@ -264,45 +301,41 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 			break
 		}
 
-		commitMultipartUploadPartDetails[osResponseIndex] = oci_object_storage.CommitMultipartUploadPartDetails{
+		commitMultipartUploadPartDetails = append(commitMultipartUploadPartDetails, oci_object_storage.CommitMultipartUploadPartDetails{
 			PartNum: osUploadPartResponse.partNumber,
-			Etag:    osUploadPartResponse.response.ETag,
-		}
-		osResponseIndex++
+			Etag:    osUploadPartResponse.etag,
+		})
+		partMd5s[*osUploadPartResponse.partNumber] = osUploadPartResponse.md5
 	}
 
-	if uploadPartRespErr != nil {
-		// just aborting the multi upload for now; but the service itself will handle the same request again
-		abortMultipartUploadRequest := oci_object_storage.AbortMultipartUploadRequest{
-			NamespaceName:      multipartUploadResponse.Namespace,
-			BucketName:         multipartUploadResponse.Bucket,
-			ObjectName:         multipartUploadResponse.Object,
-			UploadId:           multipartUploadResponse.MultipartUpload.UploadId,
-			OpcClientRequestId: multipartUploadResponse.OpcClientRequestId,
-			RequestMetadata:    multipartUploadRequest.RequestMetadata,
-		}
-
-		_, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest)
+	if uploadPartRespErr == nil {
+		// the parts are checked before the commit, which replaces the existing object
+		uploadPartRespErr = verifyMultipartUploadParts(client, *multipartUpload, partMd5s, multipartUploadRequest.RequestMetadata)
+	}
 
-		if err != nil {
-			log.Println("[WARN] Aborting the multi part upload failed")
-		}
+	if uploadPartRespErr != nil {
+		abortMultipartUpload(client, *multipartUpload, multipartUploadRequest.RequestMetadata)
 
-		return "", fmt.Errorf("failed to upload object parts of \"%v\" to the Oracle cloud: %s", source, uploadPartRespErr)
+		return "", fmt.Errorf("failed to upload object parts of \"%v\" to the Oracle cloud: %s", *source, uploadPartRespErr)
 	}
 
+	sort.Slice(commitMultipartUploadPartDetails, func(i, j int) bool {
+		return *commitMultipartUploadPartDetails[i].PartNum < *commitMultipartUploadPartDetails[j].PartNum
+	})
+
 	commitMultipartUploadRequest := oci_object_storage.CommitMultipartUploadRequest{
-		UploadId:           multipartUploadResponse.MultipartUpload.UploadId,
-		NamespaceName:      multipartUploadResponse.Namespace,
-		BucketName:         multipartUploadResponse.Bucket,
-		ObjectName:         multipartUploadResponse.Object,
-		OpcClientRequestId: multipartUploadResponse.OpcClientRequestId,
-		RequestMetadata:    multipartUploadRequest.RequestMetadata,
+		UploadId:        multipartUpload.UploadId,
+		NamespaceName:   multipartUpload.Namespace,
+		BucketName:      multipartUpload.Bucket,
+		ObjectName:      multipartUpload.Object,
+		RequestMetadata: multipartUploadRequest.RequestMetadata,
 	}
 	commitMultipartUploadRequest.PartsToCommit = commitMultipartUploadPartDetails
 
 	_, err = client.CommitMultipartUpload(context.Background(), commitMultipartUploadRequest)
 	if err != nil {
+		abortMultipartUpload(client, *multipartUpload, multipartUploadRequest.RequestMetadata)
+
 		return "", fmt.Errorf("failed to commit multi part upload of \"%v\" to the service: %s", source, err)
 	}
 
@@ -311,14 +344,88 @@ func multiPartUploadImpl(multipartUploadData MultipartUploadData) (string, error
 	return id, nil
 }
 
-func objectMultiPartSplit(file *os.File) ([]objectStorageSourceBlock, error) {
+func listMultipartUploadParts(client *oci_object_storage.ObjectStorageClient, upload oci_object_storage.MultipartUpload, requestMetadata common.RequestMetadata) (map[int]oci_object_storage.MultipartUploadPartSummary, error) {
+	uploadedParts := map[int]oci_object_storage.MultipartUploadPartSummary{}
+	partsRequest := oci_object_storage.ListMultipartUploadPartsRequest{
+		NamespaceName:   upload.Namespace,
+		BucketName:      upload.Bucket,
+		ObjectName:      upload.Object,
+		UploadId:        upload.UploadId,
+		RequestMetadata: requestMetadata,
+	}
+	for {
+		response, err := client.ListMultipartUploadParts(context.Background(), partsRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, part := range response.Items {
+			if part.PartNumber != nil {
+				uploadedParts[*part.PartNumber] = part
+			}
+		}
+		if response.OpcNextPage == nil {
+			break
+		}
+		partsRequest.Page = response.OpcNextPage
+	}
+
+	return uploadedParts, nil
+}
+
+// verifyMultipartUploadParts checks that the parts stored by the service are the parts of the source, so that a
+// corrupted or foreign part is never committed over the existing object
+func verifyMultipartUploadParts(client *oci_object_storage.ObjectStorageClient, upload oci_object_storage.MultipartUpload, partMd5s map[int][]byte, requestMetadata common.RequestMetadata) error {
+	uploadedParts, err := listMultipartUploadParts(client, upload, requestMetadata)
+	if err != nil {
+		return err
+	}
+
+	for partNumber, partMd5 := range partMd5s {
+		uploadedPart, ok := uploadedParts[partNumber]
+		if !ok {
+			return fmt.Errorf("the part %d is missing from the upload %s", partNumber, *upload.UploadId)
+		}
+		if expectedMd5 := base64.StdEncoding.EncodeToString(partMd5); uploadedPart.Md5 == nil || *uploadedPart.Md5 != expectedMd5 {
+			return fmt.Errorf("the MD5 hash of the part %d of the upload %s does not match the hash %s of the source", partNumber, *upload.UploadId, expectedMd5)
+		}
+	}
+
+	return nil
+}
+
+func abortMultipartUpload(client *oci_object_storage.ObjectStorageClient, upload oci_object_storage.MultipartUpload, requestMetadata common.RequestMetadata) {
+	abortMultipartUploadRequest := oci_object_storage.AbortMultipartUploadRequest{
+		NamespaceName:   upload.Namespace,
+		BucketName:      upload.Bucket,
+		ObjectName:      upload.Object,
+		UploadId:        upload.UploadId,
+		RequestMetadata: requestMetadata,
+	}
+
+	if _, err := client.AbortMultipartUpload(context.Background(), abortMultipartUploadRequest); err != nil {
+		log.Printf("[WARN] Aborting the multi part upload %s failed: %s", *upload.UploadId, err)
+	}
+}
+
+func objectStorageReaderMd5(reader io.Reader) ([]byte, error) {
+	hash := md5.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
+func objectMultiPartSplit(file *os.File, partSize int64) ([]objectStorageSourceBlock, error) {
 
 	info, err := os.Stat(file.Name())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get FileInfo for the source %q: %s", file.Name(), err)
 	}
 
-	offsets, limits, err := SplitSizeToOffsetsAndLimits(info.Size())
+	offsets, limits, err := SplitSizeToOffsetsAndLimitsWithPartSize(info.Size(), partSize)
+	if err != nil {
+		return nil, err
+	}
 	sourceBlocks := make([]objectStorageSourceBlock, len(offsets))
 	for index := 0; index < len(offsets); index++ {
 		tmpIndex := index + 1
@@ -332,7 +439,12 @@ func objectMultiPartSplit(file *os.File) ([]objectStorageSourceBlock, error) {
 }
 
 func SplitSizeToOffsetsAndLimits(infoSize int64) ([]int64, []int64, error) {
-	partSize := DefaultFilePartSize
+	return SplitSizeToOffsetsAndLimitsWithPartSize(infoSize, DefaultFilePartSize)
+}
+
+// SplitSizeToOffsetsAndLimitsWithPartSize splits a source of infoSize bytes in parts of partSize bytes. The part size
+// is increased if the source would otherwise need more than MaxCount parts.
+func SplitSizeToOffsetsAndLimitsWithPartSize(infoSize int64, partSize int64) ([]int64, []int64, error) {
 	remainingPart := int64(0)
 
 	totalNumber := infoSize / partSize
@@ -377,9 +489,9 @@ func SplitSizeToOffsetsAndLimits(infoSize int64) ([]int64, []int64, error) {
 func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 	for sourceBlock := range ctx.sourceBlocks {
 
-		block := make([]byte, sourceBlock.section.Size())
-		_, err := sourceBlock.section.Read(block)
-		if err != nil && err != io.EOF {
+		// the part is hashed and uploaded from the file, without reading it in memory
+		blockMd5, err := objectStorageReaderMd5(io.NewSectionReader(sourceBlock.section, 0, sourceBlock.section.Size()))
+		if err != nil {
 			if sourceBlock.blockNumber != nil {
 				log.Printf("[ERROR] failed to read source file section %v: %s\n", *sourceBlock.blockNumber, err)
 			}
@@ -391,24 +503,35 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 			ctx.wg.Done()
 			continue
 		}
-		tmpLength := int64(len(block))
-		uploadPartRequest := &oci_object_storage.UploadPartRequest{
-			UploadId:        ctx.multipartUploadResponse.UploadId,
-			ObjectName:      ctx.multipartUploadResponse.Object,
-			NamespaceName:   ctx.multipartUploadResponse.Namespace,
-			BucketName:      ctx.multipartUploadResponse.Bucket,
-			RequestMetadata: ctx.multipartUploadRequest.RequestMetadata,
-			ContentLength:   &tmpLength,
-			UploadPartBody:  ioutil.NopCloser(bytes.NewBuffer(block)),
-			UploadPartNum:   sourceBlock.blockNumber,
-		}
+		blockMd5B64 := base64.StdEncoding.EncodeToString(blockMd5)
+		tmpLength := sourceBlock.section.Size()
+
+		var uploadPartResponse oci_object_storage.UploadPartResponse
+		for attempt := 1; attempt <= maxPartUploadAttempts; attempt++ {
+			uploadPartRequest := oci_object_storage.UploadPartRequest{
+				UploadId:        ctx.multipartUpload.UploadId,
+				ObjectName:      ctx.multipartUpload.Object,
+				NamespaceName:   ctx.multipartUpload.Namespace,
+				BucketName:      ctx.multipartUpload.Bucket,
+				RequestMetadata: ctx.requestMetadata,
+				ContentLength:   &tmpLength,
+				ContentMD5:      &blockMd5B64,
+				UploadPartBody:  ioutil.NopCloser(io.NewSectionReader(sourceBlock.section, 0, tmpLength)),
+				UploadPartNum:   sourceBlock.blockNumber,
+			}
 
-		uploadPartResponse, err := ctx.client.UploadPart(context.Background(), *uploadPartRequest)
+			uploadPartResponse, err = ctx.client.UploadPart(context.Background(), uploadPartRequest)
+			if err == nil {
+				break
+			}
+			log.Printf("[WARN] attempt %d of %d to upload the part %d of the upload %s failed: %s", attempt, maxPartUploadAttempts, *sourceBlock.blockNumber, *ctx.multipartUpload.UploadId, err)
+		}
 
 		osUploadPartResponse := &objectStorageUploadPartResponse{
-			response:   uploadPartResponse,
+			etag:       uploadPartResponse.ETag,
+			md5:        blockMd5,
 			error:      err,
-			partNumber: uploadPartRequest.UploadPartNum,
+			partNumber: sourceBlock.blockNumber,
 		}
 
 		ctx.osUploadPartResponses <- *osUploadPartResponse
@@ -418,21 +541,31 @@ func uploadPartsWorker(ctx objectStorageMultiPartUploadContext) {
 
 func (s *ObjectStorageObjectResourceCrud) createSourceRegionClient(region string) error {
 	if s.SourceRegionClient == nil {
-		sourceObjectStorageClient, err := oci_object_storage.NewObjectStorageClientWithConfigurationProvider(*s.Client.ConfigurationProvider())
+		sourceObjectStorageClient, err := newObjectStorageRegionClient(s.Client, "source")
 		if err != nil {
-			return fmt.Errorf("cannot Create client for the source region: %v", err)
-		}
-		err = tf_client.ConfigureClientVar(&sourceObjectStorageClient.BaseClient)
-		if err != nil {
-			return fmt.Errorf("cannot configure client for the source region: %v", err)
+			return err
 		}
-		s.SourceRegionClient = &sourceObjectStorageClient
+		s.SourceRegionClient = sourceObjectStorageClient
 	}
 	s.SourceRegionClient.SetRegion(region)
 
 	return nil
 }
 
+// newObjectStorageRegionClient creates a client with the configuration of the provider client, to be pointed at another
+// region with SetRegion
+func newObjectStorageRegionClient(client *oci_object_storage.ObjectStorageClient, regionRole string) (*oci_object_storage.ObjectStorageClient, error) {
+	regionClient, err := oci_object_storage.NewObjectStorageClientWithConfigurationProvider(*client.ConfigurationProvider())
+	if err != nil {
+		return nil, fmt.Errorf("cannot Create client for the %s region: %v", regionRole, err)
+	}
+	err = tf_client.ConfigureClientVar(&regionClient.BaseClient)
+	if err != nil {
+		return nil, fmt.Errorf("cannot configure client for the %s region: %v", regionRole, err)
+	}
+	return &regionClient, nil
+}
+
 func copyObjectWaitForWorkRequest(wId *string, entityType string, timeout time.Duration, disableFoundRetries bool, client *oci_object_storage.ObjectStorageClient) error {
 
 	retryPolicy := tfresource.GetRetryPolicy(disableFoundRetries, "object_storage")
@@ -0,0 +1,368 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func ObjectStorageObjectCopyResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createObjectStorageObjectCopy,
+		Read:     readObjectStorageObjectCopy,
+		Delete:   deleteObjectStorageObjectCopy,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"destination_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_object": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_object": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"destination_object_if_match_etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_object_if_none_match_etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_object_metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         schema.TypeString,
+				ValidateFunc: validateLowerCaseKeysInMetadata,
+			},
+			"destination_object_storage_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"opc_sse_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_object_if_match_etag": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"source_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"content_length": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_md5": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"work_request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createObjectStorageObjectCopy(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectCopyResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readObjectStorageObjectCopy(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectCopyResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func deleteObjectStorageObjectCopy(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageObjectCopyResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type ObjectStorageObjectCopyResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_object_storage.ObjectStorageClient
+	Res                    *oci_object_storage.HeadObjectResponse
+	DisableNotFoundRetries bool
+}
+
+func (s *ObjectStorageObjectCopyResourceCrud) ID() string {
+	return GetObjectCompositeId(s.D.Get("destination_bucket").(string), s.D.Get("destination_namespace").(string), s.D.Get("destination_object").(string))
+}
+
+func (s *ObjectStorageObjectCopyResourceCrud) Create() error {
+	currentRegion, err := (*s.Client.ConfigurationProvider()).Region()
+	if err != nil {
+		return fmt.Errorf("cannot access Region for the current ConfigurationProvider: %v", err)
+	}
+
+	sourceRegion := currentRegion
+	if region, ok := s.D.GetOkExists("source_region"); ok && region.(string) != "" {
+		sourceRegion = region.(string)
+	}
+	s.D.Set("source_region", sourceRegion)
+
+	destinationRegion := currentRegion
+	if region, ok := s.D.GetOkExists("destination_region"); ok && region.(string) != "" {
+		destinationRegion = region.(string)
+	}
+	s.D.Set("destination_region", destinationRegion)
+
+	// the object is copied by the service in the region of the source object
+	sourceRegionClient, err := s.regionClient(sourceRegion, "source")
+	if err != nil {
+		return err
+	}
+
+	request := oci_object_storage.CopyObjectRequest{}
+	request.DestinationRegion = &destinationRegion
+
+	if destinationBucket, ok := s.D.GetOkExists("destination_bucket"); ok {
+		tmp := destinationBucket.(string)
+		request.DestinationBucket = &tmp
+	}
+
+	if destinationNamespace, ok := s.D.GetOkExists("destination_namespace"); ok {
+		tmp := destinationNamespace.(string)
+		request.DestinationNamespace = &tmp
+	}
+
+	if destinationObject, ok := s.D.GetOkExists("destination_object"); ok {
+		tmp := destinationObject.(string)
+		request.DestinationObjectName = &tmp
+	}
+
+	if destinationObjectIfMatchETag, ok := s.D.GetOkExists("destination_object_if_match_etag"); ok {
+		tmp := destinationObjectIfMatchETag.(string)
+		request.DestinationObjectIfMatchETag = &tmp
+	}
+
+	if destinationObjectIfNoneMatchETag, ok := s.D.GetOkExists("destination_object_if_none_match_etag"); ok {
+		tmp := destinationObjectIfNoneMatchETag.(string)
+		request.DestinationObjectIfNoneMatchETag = &tmp
+	}
+
+	if destinationObjectMetadata, ok := s.D.GetOkExists("destination_object_metadata"); ok {
+		request.DestinationObjectMetadata = resourceObjectStorageMapToOPCMetadata(destinationObjectMetadata.(map[string]interface{}))
+	}
+
+	if destinationObjectStorageTier, ok := s.D.GetOkExists("destination_object_storage_tier"); ok {
+		request.DestinationObjectStorageTier = StorageTierEnumFromString(destinationObjectStorageTier.(string))
+	}
+
+	if opcSseKmsKeyId, ok := s.D.GetOkExists("opc_sse_kms_key_id"); ok {
+		tmp := opcSseKmsKeyId.(string)
+		request.OpcSseKmsKeyId = &tmp
+	}
+
+	if sourceBucket, ok := s.D.GetOkExists("source_bucket"); ok {
+		tmp := sourceBucket.(string)
+		request.BucketName = &tmp
+	}
+
+	if sourceNamespace, ok := s.D.GetOkExists("source_namespace"); ok {
+		tmp := sourceNamespace.(string)
+		request.NamespaceName = &tmp
+	}
+
+	if sourceObject, ok := s.D.GetOkExists("source_object"); ok {
+		tmp := sourceObject.(string)
+		request.SourceObjectName = &tmp
+	}
+
+	if sourceObjectIfMatchETag, ok := s.D.GetOkExists("source_object_if_match_etag"); ok {
+		tmp := sourceObjectIfMatchETag.(string)
+		request.SourceObjectIfMatchETag = &tmp
+	}
+
+	if sourceVersionId, ok := s.D.GetOkExists("source_version_id"); ok {
+		tmp := sourceVersionId.(string)
+		request.SourceVersionId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	response, err := sourceRegionClient.CopyObject(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workRequestId := response.OpcWorkRequestId
+	s.D.Set("work_request_id", *workRequestId)
+
+	err = copyObjectWaitForWorkRequest(workRequestId, "object", s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, sourceRegionClient)
+	if err != nil {
+		return err
+	}
+
+	return s.Get()
+}
+
+func (s *ObjectStorageObjectCopyResourceCrud) Get() error {
+	bucket, namespace, object := s.destinationObject()
+
+	destinationRegionClient, err := s.destinationRegionClient()
+	if err != nil {
+		return err
+	}
+
+	request := oci_object_storage.HeadObjectRequest{
+		BucketName:    &bucket,
+		NamespaceName: &namespace,
+		ObjectName:    &object,
+	}
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	response, err := destinationRegionClient.HeadObject(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	return nil
+}
+
+// Delete removes the copied object from the destination bucket, the source object is not changed
+func (s *ObjectStorageObjectCopyResourceCrud) Delete() error {
+	bucket, namespace, object := s.destinationObject()
+
+	destinationRegionClient, err := s.destinationRegionClient()
+	if err != nil {
+		return err
+	}
+
+	request := oci_object_storage.DeleteObjectRequest{
+		BucketName:    &bucket,
+		NamespaceName: &namespace,
+		ObjectName:    &object,
+	}
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	_, err = destinationRegionClient.DeleteObject(context.Background(), request)
+	return err
+}
+
+func (s *ObjectStorageObjectCopyResourceCrud) SetData() error {
+	if s.Res.ContentLength != nil {
+		s.D.Set("content_length", strconv.FormatInt(*s.Res.ContentLength, 10))
+	}
+
+	if s.Res.ContentMd5 != nil {
+		s.D.Set("content_md5", *s.Res.ContentMd5)
+	} else if s.Res.OpcMultipartMd5 != nil {
+		s.D.Set("content_md5", *s.Res.OpcMultipartMd5)
+	}
+
+	if s.Res.ETag != nil {
+		s.D.Set("etag", *s.Res.ETag)
+	}
+
+	return nil
+}
+
+func (s *ObjectStorageObjectCopyResourceCrud) destinationObject() (string, string, string) {
+	bucket, namespace, object, err := parseObjectCompositeId(s.D.Id())
+	if err != nil {
+		// the ID is only set once the object was copied
+		bucket = s.D.Get("destination_bucket").(string)
+		namespace = s.D.Get("destination_namespace").(string)
+		object = s.D.Get("destination_object").(string)
+	}
+	return bucket, namespace, object
+}
+
+func (s *ObjectStorageObjectCopyResourceCrud) destinationRegionClient() (*oci_object_storage.ObjectStorageClient, error) {
+	if region, ok := s.D.GetOkExists("destination_region"); ok && region.(string) != "" {
+		return s.regionClient(region.(string), "destination")
+	}
+	return s.Client, nil
+}
+
+// regionClient returns a client of the region, the provider client when the region is the region of the provider
+func (s *ObjectStorageObjectCopyResourceCrud) regionClient(region string, regionRole string) (*oci_object_storage.ObjectStorageClient, error) {
+	if currentRegion, err := (*s.Client.ConfigurationProvider()).Region(); err == nil && currentRegion == region {
+		return s.Client, nil
+	}
+
+	regionClient, err := newObjectStorageRegionClient(s.Client, regionRole)
+	if err != nil {
+		return nil, err
+	}
+	regionClient.SetRegion(region)
+	log.Printf("[DEBUG] using an Object Storage client of the %s region %s", regionRole, region)
+
+	return regionClient, nil
+}
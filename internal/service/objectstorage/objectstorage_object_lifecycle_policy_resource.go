@@ -24,7 +24,14 @@ import (
 )
 
 const (
-	multipartUploads = "multipart-uploads"
+	multipartUploads       = "multipart-uploads"
+	objects                = "objects"
+	previousObjectVersions = "previous-object-versions"
+
+	lifecycleActionArchive          = "ARCHIVE"
+	lifecycleActionInfrequentAccess = "INFREQUENT_ACCESS"
+	lifecycleActionDelete           = "DELETE"
+	lifecycleActionAbort            = "ABORT"
 )
 
 func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
@@ -37,6 +44,12 @@ func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
 		Read:     readObjectStorageObjectLifecyclePolicy,
 		Update:   updateObjectStorageObjectLifecyclePolicy,
 		Delete:   deleteObjectStorageObjectLifecyclePolicy,
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			if rules, ok := diff.GetOk("rules"); ok {
+				return validateObjectLifecycleRules(rules.(*schema.Set).List())
+			}
+			return nil
+		},
 		Schema: map[string]*schema.Schema{
 			// Required
 			"bucket": {
@@ -62,6 +75,13 @@ func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
 						"action": {
 							Type:     schema.TypeString,
 							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lifecycleActionArchive,
+								lifecycleActionInfrequentAccess,
+								lifecycleActionDelete,
+								lifecycleActionAbort,
+							}, true),
+							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
 						},
 						"is_enabled": {
 							Type:     schema.TypeBool,
@@ -133,7 +153,12 @@ func ObjectStorageObjectLifecyclePolicyResource() *schema.Resource {
 						"target": {
 							Type:     schema.TypeString,
 							Optional: true,
-							Default:  "objects",
+							Default:  objects,
+							ValidateFunc: validation.StringInSlice([]string{
+								objects,
+								multipartUploads,
+								previousObjectVersions,
+							}, false),
 						},
 
 						// Computed
@@ -351,6 +376,58 @@ func (s *ObjectStorageObjectLifecyclePolicyResourceCrud) SetData() error {
 	return nil
 }
 
+// validateObjectLifecycleRules checks at plan time what the service would reject when the policy is put: rules sharing
+// a name, ABORT rules not targeting multipart uploads, other actions targeting them, and name filters on multipart
+// upload rules. Values not known yet are skipped.
+func validateObjectLifecycleRules(rules []interface{}) error {
+	names := map[string]bool{}
+	for _, rule := range rules {
+		ruleRaw, ok := rule.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := ruleRaw["name"].(string)
+		if name != "" {
+			if names[name] {
+				return fmt.Errorf("the object lifecycle policy has more than one rule named %q, rule names must be unique", name)
+			}
+			names[name] = true
+		}
+
+		action, _ := ruleRaw["action"].(string)
+		target, _ := ruleRaw["target"].(string)
+		if action == "" || target == "" {
+			continue
+		}
+		isAbort := strings.EqualFold(action, lifecycleActionAbort)
+		if isAbort && target != multipartUploads {
+			return fmt.Errorf("the rule %q of the object lifecycle policy uses the action %s, which requires the target %q", name, action, multipartUploads)
+		}
+		if target == multipartUploads && !isAbort {
+			return fmt.Errorf("the rule %q of the object lifecycle policy targets %q, which only supports the action %s", name, target, lifecycleActionAbort)
+		}
+		if target == multipartUploads && isObjectNameFilterSet(ruleRaw["object_name_filter"]) {
+			return fmt.Errorf("the rule %q of the object lifecycle policy targets %q, which does not support an object_name_filter", name, target)
+		}
+	}
+	return nil
+}
+
+func isObjectNameFilterSet(objectNameFilter interface{}) bool {
+	tmpList, ok := objectNameFilter.([]interface{})
+	if !ok || len(tmpList) == 0 || tmpList[0] == nil {
+		return false
+	}
+	objectNameFilterRaw := tmpList[0].(map[string]interface{})
+	for _, key := range []string{"exclusion_patterns", "inclusion_patterns", "inclusion_prefixes"} {
+		if set, ok := objectNameFilterRaw[key].(*schema.Set); ok && set.Len() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func GetObjectLifecyclePolicyCompositeId(bucket string, namespace string) string {
 	bucket = url.PathEscape(bucket)
 	namespace = url.PathEscape(namespace)
@@ -530,7 +607,7 @@ func rulesHashCodeForSets(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
 	if action, ok := m["action"]; ok && action != "" {
-		buf.WriteString(fmt.Sprintf("%v-", action))
+		buf.WriteString(fmt.Sprintf("%v-", strings.ToUpper(action.(string))))
 	}
 	if isEnabled, ok := m["is_enabled"]; ok {
 		buf.WriteString(fmt.Sprintf("%v-", isEnabled))
@@ -23,6 +23,7 @@ import (
 	"github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
 )
 
@@ -137,6 +138,16 @@ func ObjectStorageObjectResource() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"multipart_parallel_upload_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"multipart_part_size_in_mbs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(int(MinPartSize/(1024*1024)), int(MaxPartSize/(1024*1024))),
+			},
 			"metadata": {
 				// @CODEGEN 2/2018: This should be a map[string]string. Spec doesn't specify this correctly and
 				// generates it as a TypeString
@@ -320,6 +331,14 @@ func (s *ObjectStorageObjectResourceCrud) createMultiPartObject() error {
 		multipartUploadData.ObjectName = &tmp
 	}
 
+	if partSizeInMBs, ok := s.D.GetOkExists("multipart_part_size_in_mbs"); ok {
+		multipartUploadData.PartSize = int64(partSizeInMBs.(int)) * 1024 * 1024
+	}
+
+	if parallelUploadCount, ok := s.D.GetOkExists("multipart_parallel_upload_count"); ok {
+		multipartUploadData.Parallelism = parallelUploadCount.(int)
+	}
+
 	multipartUploadData.ObjectStorageClient = s.Client
 	multipartUploadData.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
@@ -492,6 +511,7 @@ func readObjectStorageObject(d *schema.ResourceData, m interface{}) error {
 	}
 	sync.D = d
 	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+	sync.DetectSourceDrift = true
 
 	return tfresource.ReadResource(sync)
 }
@@ -530,6 +550,8 @@ type ObjectStorageObjectResourceCrud struct {
 	Res                    *ObjectStorageObject
 	DisableNotFoundRetries bool
 	WorkRequest            *oci_object_storage.WorkRequest
+	// Set on refresh, to plan a new upload when the object uploaded from the source was changed outside of Terraform
+	DetectSourceDrift bool
 }
 
 func (s *ObjectStorageObjectResourceCrud) ID() string {
@@ -931,12 +953,14 @@ func (s *ObjectStorageObjectResourceCrud) setDataObjectHead() error {
 		s.D.Set("content_length", strconv.FormatInt(*response.ContentLength, 10))
 	}
 
-	if response.OpcMultipartMd5 != nil {
-		s.D.Set("content_md5", *response.OpcMultipartMd5)
+	contentMd5 := response.OpcMultipartMd5
+	if response.ContentMd5 != nil {
+		contentMd5 = response.ContentMd5
 	}
 
-	if response.ContentMd5 != nil {
-		s.D.Set("content_md5", *response.ContentMd5)
+	if contentMd5 != nil {
+		s.detectSourceDrift(*contentMd5)
+		s.D.Set("content_md5", *contentMd5)
 	}
 
 	if response.ContentType != nil {
@@ -958,6 +982,18 @@ func (s *ObjectStorageObjectResourceCrud) setDataObjectHead() error {
 	return nil
 }
 
+// detectSourceDrift clears the source in the state when the hash of the object no longer matches the hash recorded
+// when it was uploaded from the source, so that the next plan uploads the source again.
+func (s *ObjectStorageObjectResourceCrud) detectSourceDrift(contentMd5 string) {
+	if !s.DetectSourceDrift || !s.isMultiPartCreate() {
+		return
+	}
+	if recordedMd5, ok := s.D.GetOk("content_md5"); ok && recordedMd5.(string) != contentMd5 {
+		log.Printf("[WARN] the object %s was modified outside of Terraform, its MD5 hash changed from %s to %s", s.D.Id(), recordedMd5, contentMd5)
+		s.D.Set("source", "")
+	}
+}
+
 // @CODEGEN 2/2018: The existing provider returns a custom Id in following format:
 // "tfobm-object-<namespace_name>/<bucket_name>/<object_name>"
 // Update - Id format updated to "n/tfobm-object-<namespace_name>/b/<bucket_name>/o/<object_name>"
@@ -990,13 +1026,13 @@ func (s *ObjectStorageObjectResourceCrud) setDataObject() error {
 
 	contentReader := s.Res.ObjectResponse.Content
 	if contentReader != nil {
-		contentArray, err := ioutil.ReadAll(contentReader)
+		// only the checksum of the content is stored, so it is computed while streaming the response
+		h, err := objectStorageReaderMd5(contentReader)
 		if err != nil {
 			log.Printf("Unable to read 'content' from response. Error: %q", err)
 			return err
 		}
-		h := md5.Sum(contentArray)
-		s.D.Set("content", hex.EncodeToString(h[:]))
+		s.D.Set("content", hex.EncodeToString(h))
 	}
 
 	if s.Res.ObjectResponse.CacheControl != nil {
@@ -9,6 +9,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -30,6 +31,18 @@ func ObjectStoragePreauthenticatedRequestResource() *schema.Resource {
 		Create:   createObjectStoragePreauthenticatedRequest,
 		Read:     readObjectStoragePreauthenticatedRequest,
 		Delete:   deleteObjectStoragePreauthenticatedRequest,
+		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			// listing objects is only allowed by the PARs reading any object of the bucket
+			bucketListingAction := diff.Get("bucket_listing_action").(string)
+			accessType := strings.ToLower(diff.Get("access_type").(string))
+			if bucketListingAction == string(oci_object_storage.PreauthenticatedRequestBucketListingActionListobjects) && accessType != "" &&
+				accessType != strings.ToLower(string(oci_object_storage.PreauthenticatedRequestAccessTypeAnyobjectread)) &&
+				accessType != strings.ToLower(string(oci_object_storage.PreauthenticatedRequestAccessTypeAnyobjectreadwrite)) {
+				return fmt.Errorf("bucket_listing_action %s requires the access_type %s or %s", bucketListingAction,
+					oci_object_storage.PreauthenticatedRequestAccessTypeAnyobjectread, oci_object_storage.PreauthenticatedRequestAccessTypeAnyobjectreadwrite)
+			}
+			return nil
+		},
 		Schema: map[string]*schema.Schema{
 			// Required
 			"access_type": {
@@ -73,6 +86,10 @@ func ObjectStoragePreauthenticatedRequestResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_object_storage.PreauthenticatedRequestBucketListingActionDeny),
+					string(oci_object_storage.PreauthenticatedRequestBucketListingActionListobjects),
+				}, false),
 			},
 			"object": {
 				Type:          schema.TypeString,
@@ -239,12 +256,13 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) Get() error {
 	// PreauthenticatedRequest
 
 	s.Res = &oci_object_storage.PreauthenticatedRequest{
-		Id:          response.PreauthenticatedRequestSummary.Id,
-		AccessType:  oci_object_storage.PreauthenticatedRequestAccessTypeEnum(string(response.PreauthenticatedRequestSummary.AccessType)),
-		Name:        response.PreauthenticatedRequestSummary.Name,
-		ObjectName:  response.PreauthenticatedRequestSummary.ObjectName,
-		TimeCreated: response.PreauthenticatedRequestSummary.TimeCreated,
-		TimeExpires: response.PreauthenticatedRequestSummary.TimeExpires,
+		Id:                  response.PreauthenticatedRequestSummary.Id,
+		AccessType:          oci_object_storage.PreauthenticatedRequestAccessTypeEnum(string(response.PreauthenticatedRequestSummary.AccessType)),
+		BucketListingAction: response.PreauthenticatedRequestSummary.BucketListingAction,
+		Name:                response.PreauthenticatedRequestSummary.Name,
+		ObjectName:          response.PreauthenticatedRequestSummary.ObjectName,
+		TimeCreated:         response.PreauthenticatedRequestSummary.TimeCreated,
+		TimeExpires:         response.PreauthenticatedRequestSummary.TimeExpires,
 	}
 
 	return nil
@@ -293,8 +311,13 @@ func (s *ObjectStoragePreauthenticatedRequestResourceCrud) SetData() error {
 
 	s.D.Set("bucket_listing_action", s.Res.BucketListingAction)
 
+	// the access URI and the full path are only returned when the PAR is created
 	if s.Res.FullPath != nil {
 		s.D.Set("full_path", *s.Res.FullPath)
+	} else if _, ok := s.D.GetOk("full_path"); !ok && s.Client != nil {
+		if accessUri, ok := s.D.GetOk("access_uri"); ok {
+			s.D.Set("full_path", strings.TrimSuffix(s.Client.Host, "/")+accessUri.(string))
+		}
 	}
 
 	if s.Res.Name != nil {
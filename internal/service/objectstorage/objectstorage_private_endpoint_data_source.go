@@ -5,7 +5,6 @@ package objectstorage
 
 import (
 	"context"
-	"fmt"
 	"log"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -128,53 +127,9 @@ func (s *ObjectStoragePrivateEndpointDataSourceCrud) SetData() error {
 	}
 
 	if s.Res.Fqdns != nil {
-		fqdnsMap := make(map[string]interface{})
-
-		// Check and set prefixFqdns if available
-		if prefixFqdns, ok := s.D.GetOk("fqdns.prefixFqdns"); ok {
-			prefixFqdnsMap := make(map[string]interface{})
-			if m, ok := prefixFqdns.(map[string]interface{}); ok {
-				if v, ok := m["objectStorageApiFqdn"].(string); ok {
-					prefixFqdnsMap["objectStorageApiFqdn"] = v
-				}
-				if v, ok := m["s3CompatibilityApiFqdn"].(string); ok {
-					prefixFqdnsMap["s3CompatibilityApiFqdn"] = v
-				}
-				if v, ok := m["swiftApiFqdn"].(string); ok {
-					prefixFqdnsMap["swiftApiFqdn"] = v
-				}
-			}
-			fqdnsMap["prefixFqdns"] = prefixFqdnsMap
-		}
-
-		// Check and set additionalPrefixesFqdns if available
-		if additionalPrefixesFqdns, ok := s.D.GetOk("fqdns.additionalPrefixesFqdns"); ok {
-			additionalPrefixesFqdnsMap := make(map[string]interface{})
-			if m, ok := additionalPrefixesFqdns.(map[string]interface{}); ok {
-				for key, value := range m {
-					if prefixFqdns, ok := value.(map[string]interface{}); ok {
-						prefixFqdnsMap := make(map[string]interface{})
-						if v, ok := prefixFqdns["objectStorageApiFqdn"].(string); ok {
-							prefixFqdnsMap["objectStorageApiFqdn"] = v
-						}
-						if v, ok := prefixFqdns["s3CompatibilityApiFqdn"].(string); ok {
-							prefixFqdnsMap["s3CompatibilityApiFqdn"] = v
-						}
-						if v, ok := prefixFqdns["swiftApiFqdn"].(string); ok {
-							prefixFqdnsMap["swiftApiFqdn"] = v
-						}
-						additionalPrefixesFqdnsMap[key] = prefixFqdnsMap
-					}
-				}
-			}
-			fqdnsMap["additionalPrefixesFqdns"] = additionalPrefixesFqdnsMap
-		}
-		// Set fqdnsMap in ResourceData
-		if err := s.D.Set("fqdns", fqdnsMap); err != nil {
-			return fmt.Errorf("error setting fqdns attribute: %w", err)
-		}
+		s.D.Set("fqdns", []interface{}{FqdnsToMap(s.Res.Fqdns)})
 	} else {
-		return fmt.Errorf("s.Res.Fqdns is nil")
+		s.D.Set("fqdns", nil)
 	}
 
 	s.D.Set("additional_prefixes", s.Res.AdditionalPrefixes)
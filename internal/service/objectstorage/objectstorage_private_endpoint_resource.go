@@ -9,6 +9,7 @@ import (
 	"log"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 
 	oci_work_requests "github.com/oracle/oci-go-sdk/v65/workrequests"
@@ -34,6 +35,7 @@ func ObjectStoragePrivateEndpointResource() *schema.Resource {
 			"compartment_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"name": {
 				Type:     schema.TypeString,
@@ -43,10 +45,12 @@ func ObjectStoragePrivateEndpointResource() *schema.Resource {
 			"namespace": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"subnet_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"prefix": {
 				Type:     schema.TypeString,
@@ -59,6 +63,7 @@ func ObjectStoragePrivateEndpointResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ForceNew: true,
 			},
 			"state": {
 				Type:     schema.TypeString,
@@ -68,6 +73,7 @@ func ObjectStoragePrivateEndpointResource() *schema.Resource {
 			"additional_prefixes": {
 				Type:     schema.TypeList,
 				Optional: true,
+				ForceNew: true,
 				MaxItems: 10,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -76,6 +82,7 @@ func ObjectStoragePrivateEndpointResource() *schema.Resource {
 			"nsg_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
+				ForceNew: true,
 				MaxItems: 5,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -139,16 +146,68 @@ func ObjectStoragePrivateEndpointResource() *schema.Resource {
 				Computed: true,
 			},
 			"fqdns": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeMap,
-					Elem: &schema.Schema{
-						Type:     schema.TypeMap,
-						Optional: true,
-						Elem: &schema.Schema{
-							Type:     schema.TypeString,
-							Optional: true,
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"additional_prefixes_fqdns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+
+									// Optional
+
+									// Computed
+									"object_storage_api_fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"prefix": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"s3compatibility_api_fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"swift_api_fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"prefix_fqdns": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+
+									// Optional
+
+									// Computed
+									"object_storage_api_fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"s3compatibility_api_fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"swift_api_fqdn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
 						},
 					},
 				},
@@ -267,53 +326,9 @@ func (s *ObjectStoragePrivateEndpointResourceCrud) SetData() error {
 	}
 
 	if s.Res.Fqdns != nil {
-		fqdnsMap := make(map[string]interface{})
-
-		// Check and set prefixFqdns if available
-		if prefixFqdns, ok := s.D.GetOk("fqdns.prefixFqdns"); ok {
-			prefixFqdnsMap := make(map[string]interface{})
-			if m, ok := prefixFqdns.(map[string]interface{}); ok {
-				if v, ok := m["objectStorageApiFqdn"].(string); ok {
-					prefixFqdnsMap["objectStorageApiFqdn"] = v
-				}
-				if v, ok := m["s3CompatibilityApiFqdn"].(string); ok {
-					prefixFqdnsMap["s3CompatibilityApiFqdn"] = v
-				}
-				if v, ok := m["swiftApiFqdn"].(string); ok {
-					prefixFqdnsMap["swiftApiFqdn"] = v
-				}
-			}
-			fqdnsMap["prefixFqdns"] = prefixFqdnsMap
-		}
-
-		// Check and set additionalPrefixesFqdns if available
-		if additionalPrefixesFqdns, ok := s.D.GetOk("fqdns.additionalPrefixesFqdns"); ok {
-			additionalPrefixesFqdnsMap := make(map[string]interface{})
-			if m, ok := additionalPrefixesFqdns.(map[string]interface{}); ok {
-				for key, value := range m {
-					if prefixFqdns, ok := value.(map[string]interface{}); ok {
-						prefixFqdnsMap := make(map[string]interface{})
-						if v, ok := prefixFqdns["objectStorageApiFqdn"].(string); ok {
-							prefixFqdnsMap["objectStorageApiFqdn"] = v
-						}
-						if v, ok := prefixFqdns["s3CompatibilityApiFqdn"].(string); ok {
-							prefixFqdnsMap["s3CompatibilityApiFqdn"] = v
-						}
-						if v, ok := prefixFqdns["swiftApiFqdn"].(string); ok {
-							prefixFqdnsMap["swiftApiFqdn"] = v
-						}
-						additionalPrefixesFqdnsMap[key] = prefixFqdnsMap
-					}
-				}
-			}
-			fqdnsMap["additionalPrefixesFqdns"] = additionalPrefixesFqdnsMap
-		}
-		// Set fqdnsMap in ResourceData
-		if err := s.D.Set("fqdns", fqdnsMap); err != nil {
-			return fmt.Errorf("error setting fqdns attribute: %w", err)
-		}
+		s.D.Set("fqdns", []interface{}{FqdnsToMap(s.Res.Fqdns)})
 	} else {
-		return fmt.Errorf("s.Res.Fqdns is nil")
+		s.D.Set("fqdns", nil)
 	}
 
 	s.D.Set("additional_prefixes", s.Res.AdditionalPrefixes)
@@ -558,6 +573,19 @@ func (s *ObjectStoragePrivateEndpointResourceCrud) Update() error {
 		request.NamespaceName = &tmp
 	}
 
+	if accessTargets, ok := s.D.GetOkExists("access_targets"); ok && s.D.HasChange("access_targets") {
+		interfaces := accessTargets.([]interface{})
+		tmp := make([]oci_object_storage.AccessTargetDetails, len(interfaces))
+		for i := range interfaces {
+			converted, err := s.mapToAccessTargets(interfaces[i].(map[string]interface{}))
+			if err != nil {
+				return err
+			}
+			tmp[i] = converted
+		}
+		request.AccessTargets = tmp
+	}
+
 	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
 
 	response, err := s.Client.UpdatePrivateEndpoint(context.Background(), request)
@@ -567,14 +595,14 @@ func (s *ObjectStoragePrivateEndpointResourceCrud) Update() error {
 
 	workRequestId := response.OpcWorkRequestId
 
-	workRequestErr := tfresource.ResourceRefreshForHybridPollingPreserveStateOnFailures(s.WorkRequestClient, workRequestId, "instance", oci_work_requests.WorkRequestResourceActionTypeCreated, s.DisableNotFoundRetries, s.D, s)
+	workRequestErr := tfresource.ResourceRefreshForHybridPollingPreserveStateOnFailures(s.WorkRequestClient, workRequestId, "instance", oci_work_requests.WorkRequestResourceActionTypeUpdated, s.DisableNotFoundRetries, s.D, s)
 	if workRequestErr != nil {
 		return workRequestErr
 	}
 
 	getReq := oci_object_storage.GetPrivateEndpointRequest{}
 	getReq.NamespaceName = request.NamespaceName
-	getReq.PeName = request.Name
+	getReq.PeName = request.PeName
 	getResp, err := s.Client.GetPrivateEndpoint(context.Background(), getReq)
 	s.Res = &getResp.PrivateEndpoint
 
@@ -640,7 +668,7 @@ func (s *ObjectStoragePrivateEndpointResourceCrud) mapToAccessTargets(accessTarg
 		details.Namespace = &ns
 	}
 
-	if tmp, ok := accessTargets["compartmentId"]; ok {
+	if tmp, ok := accessTargets["compartment_id"]; ok {
 		comp := tmp.(string)
 		details.CompartmentId = &comp
 	}
@@ -652,3 +680,43 @@ func (s *ObjectStoragePrivateEndpointResourceCrud) mapToAccessTargets(accessTarg
 
 	return details, nil
 }
+
+func FqdnsToMap(obj *oci_object_storage.Fqdns) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	additionalPrefixesFqdns := []interface{}{}
+	for prefix, item := range obj.AdditionalPrefixesFqdns {
+		prefixFqdns := PrefixFqdnsToMap(&item)
+		prefixFqdns["prefix"] = prefix
+		additionalPrefixesFqdns = append(additionalPrefixesFqdns, prefixFqdns)
+	}
+	// map iteration order is random, keep the list stable between refreshes
+	sort.Slice(additionalPrefixesFqdns, func(i, j int) bool {
+		return additionalPrefixesFqdns[i].(map[string]interface{})["prefix"].(string) < additionalPrefixesFqdns[j].(map[string]interface{})["prefix"].(string)
+	})
+	result["additional_prefixes_fqdns"] = additionalPrefixesFqdns
+
+	if obj.PrefixFqdns != nil {
+		result["prefix_fqdns"] = []interface{}{PrefixFqdnsToMap(obj.PrefixFqdns)}
+	}
+
+	return result
+}
+
+func PrefixFqdnsToMap(obj *oci_object_storage.PrefixFqdns) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.ObjectStorageApiFqdn != nil {
+		result["object_storage_api_fqdn"] = string(*obj.ObjectStorageApiFqdn)
+	}
+
+	if obj.S3CompatibilityApiFqdn != nil {
+		result["s3compatibility_api_fqdn"] = string(*obj.S3CompatibilityApiFqdn)
+	}
+
+	if obj.SwiftApiFqdn != nil {
+		result["swift_api_fqdn"] = string(*obj.SwiftApiFqdn)
+	}
+
+	return result
+}
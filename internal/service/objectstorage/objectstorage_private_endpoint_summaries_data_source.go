@@ -130,48 +130,7 @@ func (s *ObjectStoragePrivateEndpointsDataSourceCrud) SetData() error {
 		}
 
 		if r.Fqdns != nil {
-			fqdnsMap := make(map[string]interface{})
-
-			// Check and set prefixFqdns if available
-			if prefixFqdns, ok := s.D.GetOk("fqdns.prefixFqdns"); ok {
-				prefixFqdnsMap := make(map[string]interface{})
-				if m, ok := prefixFqdns.(map[string]interface{}); ok {
-					if v, ok := m["objectStorageApiFqdn"].(string); ok {
-						prefixFqdnsMap["objectStorageApiFqdn"] = v
-					}
-					if v, ok := m["s3CompatibilityApiFqdn"].(string); ok {
-						prefixFqdnsMap["s3CompatibilityApiFqdn"] = v
-					}
-					if v, ok := m["swiftApiFqdn"].(string); ok {
-						prefixFqdnsMap["swiftApiFqdn"] = v
-					}
-				}
-				fqdnsMap["prefixFqdns"] = prefixFqdnsMap
-			}
-
-			// Check and set additionalPrefixesFqdns if available
-			if additionalPrefixesFqdns, ok := s.D.GetOk("fqdns.additionalPrefixesFqdns"); ok {
-				additionalPrefixesFqdnsMap := make(map[string]interface{})
-				if m, ok := additionalPrefixesFqdns.(map[string]interface{}); ok {
-					for key, value := range m {
-						if prefixFqdns, ok := value.(map[string]interface{}); ok {
-							prefixFqdnsMap := make(map[string]interface{})
-							if v, ok := prefixFqdns["objectStorageApiFqdn"].(string); ok {
-								prefixFqdnsMap["objectStorageApiFqdn"] = v
-							}
-							if v, ok := prefixFqdns["s3CompatibilityApiFqdn"].(string); ok {
-								prefixFqdnsMap["s3CompatibilityApiFqdn"] = v
-							}
-							if v, ok := prefixFqdns["swiftApiFqdn"].(string); ok {
-								prefixFqdnsMap["swiftApiFqdn"] = v
-							}
-							additionalPrefixesFqdnsMap[key] = prefixFqdnsMap
-						}
-					}
-				}
-				fqdnsMap["additionalPrefixesFqdns"] = additionalPrefixesFqdnsMap
-			}
-			pe["fqdns"] = fqdnsMap
+			pe["fqdns"] = []interface{}{FqdnsToMap(r.Fqdns)}
 		}
 
 		resources = append(resources, pe)
@@ -0,0 +1,447 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_object_storage "github.com/oracle/oci-go-sdk/v65/objectstorage"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+)
+
+func ObjectStorageRetentionRuleResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createObjectStorageRetentionRule,
+		Read:     readObjectStorageRetentionRule,
+		Update:   updateObjectStorageRetentionRule,
+		Delete:   deleteObjectStorageRetentionRule,
+		CustomizeDiff: customdiff.All(
+			validateLockedRetentionRuleDiff,
+		),
+		Schema: map[string]*schema.Schema{
+			// Required
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"namespace": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"duration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"time_amount": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     tfresource.ValidateInt64TypeString,
+							DiffSuppressFunc: tfresource.Int64StringDiffSuppressFunction,
+						},
+						"time_unit": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(oci_object_storage.DurationTimeUnitDays),
+								string(oci_object_storage.DurationTimeUnitYears),
+							}, false),
+						},
+
+						// Optional
+
+						// Computed
+					},
+				},
+			},
+			"time_rule_locked": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: tfresource.TimeDiffSuppressFunction,
+			},
+
+			// Computed
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retention_rule_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createObjectStorageRetentionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageRetentionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readObjectStorageRetentionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageRetentionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateObjectStorageRetentionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageRetentionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteObjectStorageRetentionRule(d *schema.ResourceData, m interface{}) error {
+	sync := &ObjectStorageRetentionRuleResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ObjectStorageClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type ObjectStorageRetentionRuleResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_object_storage.ObjectStorageClient
+	Res                    *oci_object_storage.RetentionRule
+	DisableNotFoundRetries bool
+}
+
+func (s *ObjectStorageRetentionRuleResourceCrud) ID() string {
+	return GetRetentionRuleCompositeId(s.D.Get("bucket").(string), s.D.Get("namespace").(string), *s.Res.Id)
+}
+
+func (s *ObjectStorageRetentionRuleResourceCrud) Create() error {
+	request := oci_object_storage.CreateRetentionRuleRequest{}
+
+	if bucket, ok := s.D.GetOkExists("bucket"); ok {
+		tmp := bucket.(string)
+		request.BucketName = &tmp
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if duration, ok := s.D.GetOkExists("duration"); ok {
+		if tmpList := duration.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "duration", 0)
+			tmp, err := s.mapToDuration(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.Duration = &tmp
+		}
+	}
+
+	if namespace, ok := s.D.GetOkExists("namespace"); ok {
+		tmp := namespace.(string)
+		request.NamespaceName = &tmp
+	}
+
+	if timeRuleLocked, ok := s.D.GetOkExists("time_rule_locked"); ok {
+		tmp, err := time.Parse(time.RFC3339, timeRuleLocked.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeRuleLocked = &oci_common.SDKTime{Time: tmp}
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	response, err := s.Client.CreateRetentionRule(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RetentionRule
+	return nil
+}
+
+func (s *ObjectStorageRetentionRuleResourceCrud) Get() error {
+	request := oci_object_storage.GetRetentionRuleRequest{}
+
+	bucket, namespace, retentionRuleId, err := ParseRetentionRuleCompositeId(s.D.Id())
+	if err == nil {
+		request.BucketName = &bucket
+		request.NamespaceName = &namespace
+		request.RetentionRuleId = &retentionRuleId
+	} else {
+		log.Printf("[WARN] Get() unable to parse current ID: %s", s.D.Id())
+		return err
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	response, err := s.Client.GetRetentionRule(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RetentionRule
+	return nil
+}
+
+func (s *ObjectStorageRetentionRuleResourceCrud) Update() error {
+	request := oci_object_storage.UpdateRetentionRuleRequest{}
+
+	bucket, namespace, retentionRuleId, err := ParseRetentionRuleCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+	request.BucketName = &bucket
+	request.NamespaceName = &namespace
+	request.RetentionRuleId = &retentionRuleId
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if duration, ok := s.D.GetOkExists("duration"); ok {
+		if tmpList := duration.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "duration", 0)
+			tmp, err := s.mapToDuration(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.Duration = &tmp
+		}
+	}
+
+	// a locked rule keeps its lock time, it is only sent while the rule can still be unlocked
+	oldTimeRuleLocked, _ := s.D.GetChange("time_rule_locked")
+	if timeRuleLocked, ok := s.D.GetOkExists("time_rule_locked"); ok && !isRetentionRuleLocked(oldTimeRuleLocked) {
+		tmp, err := time.Parse(time.RFC3339, timeRuleLocked.(string))
+		if err != nil {
+			return err
+		}
+		request.TimeRuleLocked = &oci_common.SDKTime{Time: tmp}
+	}
+
+	if etag, ok := s.D.GetOkExists("etag"); ok {
+		tmp := etag.(string)
+		request.IfMatch = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	response, err := s.Client.UpdateRetentionRule(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.RetentionRule
+	return nil
+}
+
+func (s *ObjectStorageRetentionRuleResourceCrud) Delete() error {
+	if timeRuleLocked, ok := s.D.GetOkExists("time_rule_locked"); ok && isRetentionRuleLocked(timeRuleLocked) {
+		return fmt.Errorf("the retention rule %s is locked since %s and cannot be deleted, remove it from the state with 'terraform state rm' instead", s.D.Id(), timeRuleLocked)
+	}
+
+	request := oci_object_storage.DeleteRetentionRuleRequest{}
+
+	bucket, namespace, retentionRuleId, err := ParseRetentionRuleCompositeId(s.D.Id())
+	if err != nil {
+		return err
+	}
+	request.BucketName = &bucket
+	request.NamespaceName = &namespace
+	request.RetentionRuleId = &retentionRuleId
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "object_storage")
+
+	_, err = s.Client.DeleteRetentionRule(context.Background(), request)
+	return err
+}
+
+func (s *ObjectStorageRetentionRuleResourceCrud) SetData() error {
+	bucket, namespace, _, err := ParseRetentionRuleCompositeId(s.D.Id())
+	if err == nil {
+		s.D.Set("bucket", &bucket)
+		s.D.Set("namespace", &namespace)
+	} else {
+		log.Printf("[WARN] SetData() unable to parse current ID: %s", s.D.Id())
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	if s.Res.Duration != nil {
+		s.D.Set("duration", []interface{}{DurationToMap(s.Res.Duration)})
+	} else {
+		s.D.Set("duration", nil)
+	}
+
+	if s.Res.Etag != nil {
+		s.D.Set("etag", *s.Res.Etag)
+	}
+
+	if s.Res.Id != nil {
+		s.D.Set("retention_rule_id", *s.Res.Id)
+	}
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.TimeModified != nil {
+		s.D.Set("time_modified", s.Res.TimeModified.String())
+	}
+
+	if s.Res.TimeRuleLocked != nil {
+		s.D.Set("time_rule_locked", s.Res.TimeRuleLocked.Format(time.RFC3339Nano))
+	} else {
+		s.D.Set("time_rule_locked", nil)
+	}
+
+	return nil
+}
+
+func (s *ObjectStorageRetentionRuleResourceCrud) mapToDuration(fieldKeyFormat string) (oci_object_storage.Duration, error) {
+	result := oci_object_storage.Duration{}
+
+	if timeAmount, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "time_amount")); ok {
+		tmp := timeAmount.(string)
+		tmpInt64, err := strconv.ParseInt(tmp, 10, 64)
+		if err != nil {
+			return result, fmt.Errorf("unable to convert timeAmount string: %s to an int64 and encountered error: %v", tmp, err)
+		}
+		result.TimeAmount = &tmpInt64
+	}
+
+	if timeUnit, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "time_unit")); ok {
+		result.TimeUnit = oci_object_storage.DurationTimeUnitEnum(timeUnit.(string))
+	}
+
+	return result, nil
+}
+
+// isRetentionRuleLocked reports whether the lock time recorded in the state has passed
+func isRetentionRuleLocked(stateTimeRuleLocked interface{}) bool {
+	timeRuleLocked, ok := stateTimeRuleLocked.(string)
+	if !ok || timeRuleLocked == "" {
+		return false
+	}
+	lockTime, err := time.Parse(time.RFC3339Nano, timeRuleLocked)
+	if err != nil {
+		return false
+	}
+	return !lockTime.After(time.Now())
+}
+
+// retentionDurationInDays approximates a retention duration in days, to compare durations of different units
+func retentionDurationInDays(duration []interface{}) (int64, bool) {
+	if len(duration) == 0 || duration[0] == nil {
+		return 0, false
+	}
+	durationRaw := duration[0].(map[string]interface{})
+	timeAmount, err := strconv.ParseInt(durationRaw["time_amount"].(string), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	if durationRaw["time_unit"].(string) == string(oci_object_storage.DurationTimeUnitYears) {
+		return timeAmount * 365, true
+	}
+	return timeAmount, true
+}
+
+// validateLockedRetentionRuleDiff rejects the changes the service does not allow on a locked rule: removing or
+// shortening its duration. Changes to the lock time of a locked rule are cleared, as it cannot be changed anymore.
+func validateLockedRetentionRuleDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	oldTimeRuleLocked, newTimeRuleLocked := diff.GetChange("time_rule_locked")
+	if diff.Id() == "" || !isRetentionRuleLocked(oldTimeRuleLocked) {
+		return nil
+	}
+
+	if diff.HasChange("time_rule_locked") {
+		log.Printf("[WARN] the retention rule %s is locked since %v, ignoring the change of its lock time to %v", diff.Id(), oldTimeRuleLocked, newTimeRuleLocked)
+		if err := diff.Clear("time_rule_locked"); err != nil {
+			return err
+		}
+	}
+
+	if diff.HasChange("duration") {
+		oldDuration, newDuration := diff.GetChange("duration")
+		oldDays, _ := retentionDurationInDays(oldDuration.([]interface{}))
+		newDays, ok := retentionDurationInDays(newDuration.([]interface{}))
+		if !ok {
+			return fmt.Errorf("the duration of the locked retention rule %s cannot be removed", diff.Id())
+		}
+		if newDays < oldDays {
+			return fmt.Errorf("the duration of the locked retention rule %s can only be increased", diff.Id())
+		}
+	}
+
+	return nil
+}
+
+func GetRetentionRuleCompositeId(bucket string, namespace string, retentionRuleId string) string {
+	bucket = url.PathEscape(bucket)
+	namespace = url.PathEscape(namespace)
+	retentionRuleId = url.PathEscape(retentionRuleId)
+	compositeId := "n/" + namespace + "/b/" + bucket + "/retentionRules/" + retentionRuleId
+	return compositeId
+}
+
+func ParseRetentionRuleCompositeId(compositeId string) (bucket string, namespace string, retentionRuleId string, err error) {
+	parts := strings.Split(compositeId, "/")
+	match, _ := regexp.MatchString("n/.*/b/.*/retentionRules/.*", compositeId)
+	if !match || len(parts) != 6 {
+		err = fmt.Errorf("illegal compositeId %s encountered", compositeId)
+		return
+	}
+	namespace, _ = url.PathUnescape(parts[1])
+	bucket, _ = url.PathUnescape(parts[3])
+	retentionRuleId, _ = url.PathUnescape(parts[5])
+
+	return
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package psql
+
+import (
+	"context"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	oci_psql "github.com/oracle/oci-go-sdk/v65/psql"
+)
+
+func PsqlDbSystemRestoreResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: tfresource.GetTimeoutDuration("1h"),
+			Delete: tfresource.GetTimeoutDuration("1h"),
+		},
+		Create: createPsqlDbSystemRestore,
+		Read:   readPsqlDbSystemRestore,
+		Delete: deletePsqlDbSystemRestore,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_system_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"availability_domain": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+			},
+			"restore_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			// Computed
+			"lifecycle_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createPsqlDbSystemRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &PsqlDbSystemRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).PostgresqlClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readPsqlDbSystemRestore(d *schema.ResourceData, m interface{}) error {
+	sync := &PsqlDbSystemRestoreResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).PostgresqlClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+// The data of the database system cannot be brought back by destroying the resource, restore another backup instead
+func deletePsqlDbSystemRestore(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type PsqlDbSystemRestoreResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_psql.PostgresqlClient
+	Res                    *oci_psql.DbSystem
+	DisableNotFoundRetries bool
+}
+
+func (s *PsqlDbSystemRestoreResourceCrud) ID() string {
+	return tfresource.GenerateDataSourceHashID("PsqlDbSystemRestoreResource-", PsqlDbSystemRestoreResource(), s.D)
+}
+
+func (s *PsqlDbSystemRestoreResourceCrud) Create() error {
+	request := oci_psql.RestoreDbSystemRequest{}
+
+	if availabilityDomain, ok := s.D.GetOkExists("availability_domain"); ok {
+		tmp := availabilityDomain.(string)
+		request.Ad = &tmp
+	}
+
+	if backupId, ok := s.D.GetOkExists("backup_id"); ok {
+		tmp := backupId.(string)
+		request.BackupId = &tmp
+	}
+
+	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
+		tmp := dbSystemId.(string)
+		request.DbSystemId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
+
+	response, err := s.Client.RestoreDbSystem(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	workId := response.OpcWorkRequestId
+	if workId != nil {
+		_, err = dbSystemWaitForWorkRequest(workId, "dbsystem", oci_psql.ActionTypeUpdated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.Get()
+}
+
+func (s *PsqlDbSystemRestoreResourceCrud) Get() error {
+	request := oci_psql.GetDbSystemRequest{}
+
+	if dbSystemId, ok := s.D.GetOkExists("db_system_id"); ok {
+		tmp := dbSystemId.(string)
+		request.DbSystemId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "psql")
+
+	response, err := s.Client.GetDbSystem(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.DbSystem
+	return nil
+}
+
+func (s *PsqlDbSystemRestoreResourceCrud) SetData() error {
+	if s.Res.LifecycleDetails != nil {
+		s.D.Set("lifecycle_details", *s.Res.LifecycleDetails)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeUpdated != nil {
+		s.D.Set("time_updated", s.Res.TimeUpdated.String())
+	}
+
+	return nil
+}
@@ -9,4 +9,5 @@ func RegisterResource() {
 	tfresource.RegisterResource("oci_psql_backup", PsqlBackupResource())
 	tfresource.RegisterResource("oci_psql_configuration", PsqlConfigurationResource())
 	tfresource.RegisterResource("oci_psql_db_system", PsqlDbSystemResource())
+	tfresource.RegisterResource("oci_psql_db_system_restore", PsqlDbSystemRestoreResource())
 }
@@ -10,6 +10,7 @@ func RegisterDatasource() {
 	tfresource.RegisterDatasource("oci_resourcemanager_private_endpoint_reachable_ip", ResourcemanagerPrivateEndpointReachableIpDataSource())
 	tfresource.RegisterDatasource("oci_resourcemanager_private_endpoints", ResourcemanagerPrivateEndpointsDataSource())
 	tfresource.RegisterDatasource("oci_resourcemanager_stack", ResourcemanagerStackDataSource())
+	tfresource.RegisterDatasource("oci_resourcemanager_stack_drift", ResourcemanagerStackDriftDataSource())
 	tfresource.RegisterDatasource("oci_resourcemanager_stack_tf_state", ResourcemanagerStackTfStateDataSource())
 	tfresource.RegisterDatasource("oci_resourcemanager_stacks", ResourcemanagerStacksDataSource())
 }
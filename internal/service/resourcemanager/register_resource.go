@@ -6,5 +6,8 @@ package resourcemanager
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
+	tfresource.RegisterResource("oci_resourcemanager_configuration_source_provider", ResourcemanagerConfigurationSourceProviderResource())
+	tfresource.RegisterResource("oci_resourcemanager_job", ResourcemanagerJobResource())
 	tfresource.RegisterResource("oci_resourcemanager_private_endpoint", ResourcemanagerPrivateEndpointResource())
+	tfresource.RegisterResource("oci_resourcemanager_stack", ResourcemanagerStackResource())
 }
@@ -0,0 +1,518 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
+)
+
+func ResourcemanagerConfigurationSourceProviderResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createResourcemanagerConfigurationSourceProvider,
+		Read:     readResourcemanagerConfigurationSourceProvider,
+		Update:   updateResourcemanagerConfigurationSourceProvider,
+		Delete:   deleteResourcemanagerConfigurationSourceProvider,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"access_token": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"api_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"config_source_provider_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GITHUB_ACCESS_TOKEN",
+					"GITLAB_ACCESS_TOKEN",
+				}, true),
+			},
+
+			// Optional
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: tfresource.DefinedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"private_server_config_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"certificate_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"private_endpoint_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+
+						// Computed
+					},
+				},
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createResourcemanagerConfigurationSourceProvider(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerConfigurationSourceProviderResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readResourcemanagerConfigurationSourceProvider(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerConfigurationSourceProviderResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateResourcemanagerConfigurationSourceProvider(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerConfigurationSourceProviderResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteResourcemanagerConfigurationSourceProvider(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerConfigurationSourceProviderResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type ResourcemanagerConfigurationSourceProviderResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_resourcemanager.ResourceManagerClient
+	Res                    *oci_resourcemanager.ConfigurationSourceProvider
+	DisableNotFoundRetries bool
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) ID() string {
+	configurationSourceProvider := *s.Res
+	return *configurationSourceProvider.GetId()
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) Create() error {
+	request := oci_resourcemanager.CreateConfigurationSourceProviderRequest{}
+	err := s.populateTopLevelPolymorphicCreateConfigurationSourceProviderRequest(&request)
+	if err != nil {
+		return err
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.CreateConfigurationSourceProvider(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ConfigurationSourceProvider
+	return nil
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) Get() error {
+	request := oci_resourcemanager.GetConfigurationSourceProviderRequest{}
+
+	tmp := s.D.Id()
+	request.ConfigurationSourceProviderId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.GetConfigurationSourceProvider(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ConfigurationSourceProvider
+	return nil
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) Update() error {
+	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
+		oldRaw, newRaw := s.D.GetChange("compartment_id")
+		if newRaw != "" && oldRaw != "" {
+			err := s.updateCompartment(compartment)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	request := oci_resourcemanager.UpdateConfigurationSourceProviderRequest{}
+	err := s.populateTopLevelPolymorphicUpdateConfigurationSourceProviderRequest(&request)
+	if err != nil {
+		return err
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.UpdateConfigurationSourceProvider(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.ConfigurationSourceProvider
+	return nil
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) Delete() error {
+	request := oci_resourcemanager.DeleteConfigurationSourceProviderRequest{}
+
+	tmp := s.D.Id()
+	request.ConfigurationSourceProviderId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	_, err := s.Client.DeleteConfigurationSourceProvider(context.Background(), request)
+	return err
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) SetData() error {
+	switch v := (*s.Res).(type) {
+	case oci_resourcemanager.GithubAccessTokenConfigurationSourceProvider:
+		s.D.Set("config_source_provider_type", "GITHUB_ACCESS_TOKEN")
+
+		if v.ApiEndpoint != nil {
+			s.D.Set("api_endpoint", *v.ApiEndpoint)
+		}
+	case oci_resourcemanager.GitlabAccessTokenConfigurationSourceProvider:
+		s.D.Set("config_source_provider_type", "GITLAB_ACCESS_TOKEN")
+
+		if v.ApiEndpoint != nil {
+			s.D.Set("api_endpoint", *v.ApiEndpoint)
+		}
+	default:
+		log.Printf("[WARN] Received 'config_source_provider_type' of unknown type %v", *s.Res)
+		return nil
+	}
+
+	configurationSourceProvider := *s.Res
+
+	if configurationSourceProvider.GetCompartmentId() != nil {
+		s.D.Set("compartment_id", *configurationSourceProvider.GetCompartmentId())
+	}
+
+	if configurationSourceProvider.GetDefinedTags() != nil {
+		s.D.Set("defined_tags", tfresource.DefinedTagsToMap(configurationSourceProvider.GetDefinedTags()))
+	}
+
+	if configurationSourceProvider.GetDescription() != nil {
+		s.D.Set("description", *configurationSourceProvider.GetDescription())
+	}
+
+	if configurationSourceProvider.GetDisplayName() != nil {
+		s.D.Set("display_name", *configurationSourceProvider.GetDisplayName())
+	}
+
+	s.D.Set("freeform_tags", configurationSourceProvider.GetFreeformTags())
+
+	if configurationSourceProvider.GetPrivateServerConfigDetails() != nil {
+		s.D.Set("private_server_config_details", []interface{}{PrivateServerConfigDetailsToMap(configurationSourceProvider.GetPrivateServerConfigDetails())})
+	} else {
+		s.D.Set("private_server_config_details", nil)
+	}
+
+	s.D.Set("state", configurationSourceProvider.GetLifecycleState())
+
+	if configurationSourceProvider.GetTimeCreated() != nil {
+		s.D.Set("time_created", configurationSourceProvider.GetTimeCreated().String())
+	}
+
+	return nil
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) mapToPrivateServerConfigDetails(fieldKeyFormat string) (oci_resourcemanager.PrivateServerConfigDetails, error) {
+	result := oci_resourcemanager.PrivateServerConfigDetails{}
+
+	if certificateId, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "certificate_id")); ok {
+		tmp := certificateId.(string)
+		result.CertificateId = &tmp
+	}
+
+	if privateEndpointId, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "private_endpoint_id")); ok {
+		tmp := privateEndpointId.(string)
+		result.PrivateEndpointId = &tmp
+	}
+
+	return result, nil
+}
+
+func PrivateServerConfigDetailsToMap(obj *oci_resourcemanager.PrivateServerConfigDetails) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.CertificateId != nil {
+		result["certificate_id"] = string(*obj.CertificateId)
+	}
+
+	if obj.PrivateEndpointId != nil {
+		result["private_endpoint_id"] = string(*obj.PrivateEndpointId)
+	}
+
+	return result
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) privateServerConfigDetails() (*oci_resourcemanager.PrivateServerConfigDetails, error) {
+	privateServerConfigDetails, ok := s.D.GetOkExists("private_server_config_details")
+	if !ok {
+		return nil, nil
+	}
+	tmpList := privateServerConfigDetails.([]interface{})
+	if len(tmpList) == 0 {
+		return nil, nil
+	}
+	fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "private_server_config_details", 0)
+	tmp, err := s.mapToPrivateServerConfigDetails(fieldKeyFormat)
+	if err != nil {
+		return nil, err
+	}
+	return &tmp, nil
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) populateTopLevelPolymorphicCreateConfigurationSourceProviderRequest(request *oci_resourcemanager.CreateConfigurationSourceProviderRequest) error {
+	//discriminator
+	configSourceProviderTypeRaw, ok := s.D.GetOkExists("config_source_provider_type")
+	var configSourceProviderType string
+	if ok {
+		configSourceProviderType = strings.ToUpper(configSourceProviderTypeRaw.(string))
+	} else {
+		configSourceProviderType = "" // default value
+	}
+
+	var accessToken, apiEndpoint, compartmentId, description, displayName *string
+	if v, ok := s.D.GetOkExists("access_token"); ok {
+		tmp := v.(string)
+		accessToken = &tmp
+	}
+	if v, ok := s.D.GetOkExists("api_endpoint"); ok {
+		tmp := v.(string)
+		apiEndpoint = &tmp
+	}
+	if v, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := v.(string)
+		compartmentId = &tmp
+	}
+	if v, ok := s.D.GetOkExists("description"); ok {
+		tmp := v.(string)
+		description = &tmp
+	}
+	if v, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := v.(string)
+		displayName = &tmp
+	}
+
+	var definedTags map[string]map[string]interface{}
+	if v, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		definedTags = convertedDefinedTags
+	}
+
+	var freeformTags map[string]string
+	if v, ok := s.D.GetOkExists("freeform_tags"); ok {
+		freeformTags = tfresource.ObjectMapToStringMap(v.(map[string]interface{}))
+	}
+
+	privateServerConfigDetails, err := s.privateServerConfigDetails()
+	if err != nil {
+		return err
+	}
+
+	switch configSourceProviderType {
+	case "GITHUB_ACCESS_TOKEN":
+		request.CreateConfigurationSourceProviderDetails = oci_resourcemanager.CreateGithubAccessTokenConfigurationSourceProviderDetails{
+			AccessToken:                accessToken,
+			ApiEndpoint:                apiEndpoint,
+			CompartmentId:              compartmentId,
+			DefinedTags:                definedTags,
+			Description:                description,
+			DisplayName:                displayName,
+			FreeformTags:               freeformTags,
+			PrivateServerConfigDetails: privateServerConfigDetails,
+		}
+	case "GITLAB_ACCESS_TOKEN":
+		request.CreateConfigurationSourceProviderDetails = oci_resourcemanager.CreateGitlabAccessTokenConfigurationSourceProviderDetails{
+			AccessToken:                accessToken,
+			ApiEndpoint:                apiEndpoint,
+			CompartmentId:              compartmentId,
+			DefinedTags:                definedTags,
+			Description:                description,
+			DisplayName:                displayName,
+			FreeformTags:               freeformTags,
+			PrivateServerConfigDetails: privateServerConfigDetails,
+		}
+	default:
+		return fmt.Errorf("unknown config_source_provider_type '%v' was specified", configSourceProviderType)
+	}
+	return nil
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) populateTopLevelPolymorphicUpdateConfigurationSourceProviderRequest(request *oci_resourcemanager.UpdateConfigurationSourceProviderRequest) error {
+	//discriminator
+	configSourceProviderTypeRaw, ok := s.D.GetOkExists("config_source_provider_type")
+	var configSourceProviderType string
+	if ok {
+		configSourceProviderType = strings.ToUpper(configSourceProviderTypeRaw.(string))
+	} else {
+		configSourceProviderType = "" // default value
+	}
+
+	tmp := s.D.Id()
+	request.ConfigurationSourceProviderId = &tmp
+
+	var accessToken, apiEndpoint, description, displayName *string
+	// the token is only sent when it was rotated
+	if v, ok := s.D.GetOkExists("access_token"); ok && s.D.HasChange("access_token") {
+		tmp := v.(string)
+		accessToken = &tmp
+	}
+	if v, ok := s.D.GetOkExists("api_endpoint"); ok {
+		tmp := v.(string)
+		apiEndpoint = &tmp
+	}
+	if v, ok := s.D.GetOkExists("description"); ok {
+		tmp := v.(string)
+		description = &tmp
+	}
+	if v, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := v.(string)
+		displayName = &tmp
+	}
+
+	var definedTags map[string]map[string]interface{}
+	if v, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		definedTags = convertedDefinedTags
+	}
+
+	var freeformTags map[string]string
+	if v, ok := s.D.GetOkExists("freeform_tags"); ok {
+		freeformTags = tfresource.ObjectMapToStringMap(v.(map[string]interface{}))
+	}
+
+	privateServerConfigDetails, err := s.privateServerConfigDetails()
+	if err != nil {
+		return err
+	}
+
+	switch configSourceProviderType {
+	case "GITHUB_ACCESS_TOKEN":
+		request.UpdateConfigurationSourceProviderDetails = oci_resourcemanager.UpdateGithubAccessTokenConfigurationSourceProviderDetails{
+			AccessToken:                accessToken,
+			ApiEndpoint:                apiEndpoint,
+			DefinedTags:                definedTags,
+			Description:                description,
+			DisplayName:                displayName,
+			FreeformTags:               freeformTags,
+			PrivateServerConfigDetails: privateServerConfigDetails,
+		}
+	case "GITLAB_ACCESS_TOKEN":
+		request.UpdateConfigurationSourceProviderDetails = oci_resourcemanager.UpdateGitlabAccessTokenConfigurationSourceProviderDetails{
+			AccessToken:                accessToken,
+			ApiEndpoint:                apiEndpoint,
+			DefinedTags:                definedTags,
+			Description:                description,
+			DisplayName:                displayName,
+			FreeformTags:               freeformTags,
+			PrivateServerConfigDetails: privateServerConfigDetails,
+		}
+	default:
+		return fmt.Errorf("unknown config_source_provider_type '%v' was specified", configSourceProviderType)
+	}
+	return nil
+}
+
+func (s *ResourcemanagerConfigurationSourceProviderResourceCrud) updateCompartment(compartment interface{}) error {
+	changeCompartmentRequest := oci_resourcemanager.ChangeConfigurationSourceProviderCompartmentRequest{}
+
+	compartmentTmp := compartment.(string)
+	changeCompartmentRequest.CompartmentId = &compartmentTmp
+
+	idTmp := s.D.Id()
+	changeCompartmentRequest.ConfigurationSourceProviderId = &idTmp
+
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	_, err := s.Client.ChangeConfigurationSourceProviderCompartment(context.Background(), changeCompartmentRequest)
+	if err != nil {
+		return err
+	}
+
+	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		return waitErr
+	}
+
+	return nil
+}
@@ -0,0 +1,444 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
+)
+
+func ResourcemanagerJobResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: tfresource.GetTimeoutDuration("2h"),
+		},
+		Create: createResourcemanagerJob,
+		Read:   readResourcemanagerJob,
+		Delete: deleteResourcemanagerJob,
+		CustomizeDiff: customdiff.All(
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("operation", string(oci_resourcemanager.JobOperationApply)), "operation is not APPLY",
+				"execution_plan_job_id",
+				"execution_plan_strategy"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("execution_plan_strategy", string(oci_resourcemanager.ApplyJobOperationDetailsExecutionPlanStrategyFromPlanJobId)), "execution_plan_strategy is FROM_PLAN_JOB_ID", "execution_plan_job_id"),
+		),
+		Schema: map[string]*schema.Schema{
+			// Required
+			"operation": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(oci_resourcemanager.JobOperationApply),
+					string(oci_resourcemanager.JobOperationDestroy),
+					string(oci_resourcemanager.JobOperationPlan),
+				}, true),
+			},
+			"stack_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"execution_plan_job_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"execution_plan_strategy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+				ValidateFunc:     validation.StringInSlice(oci_resourcemanager.GetApplyJobOperationDetailsExecutionPlanStrategyEnumStringValues(), true),
+			},
+			"is_provider_upgrade_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"job_trigger": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"log_tail_lines": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			// Computed
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"log_tail": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"outputs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_sensitive": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"output_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_value": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"resolved_plan_job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_finished": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createResourcemanagerJob(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerJobResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readResourcemanagerJob(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerJobResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+// The job history is kept by the service, destroying the resource only removes it from the state. Run a DESTROY job
+// to remove the resources managed by the stack.
+func deleteResourcemanagerJob(d *schema.ResourceData, m interface{}) error {
+	return nil
+}
+
+type ResourcemanagerJobResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_resourcemanager.ResourceManagerClient
+	Res                    *oci_resourcemanager.Job
+	Outputs                []oci_resourcemanager.JobOutputSummary
+	LogTail                *string
+	DisableNotFoundRetries bool
+}
+
+func (s *ResourcemanagerJobResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *ResourcemanagerJobResourceCrud) Create() error {
+	request := oci_resourcemanager.CreateJobRequest{}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if stackId, ok := s.D.GetOkExists("stack_id"); ok {
+		tmp := stackId.(string)
+		request.StackId = &tmp
+	}
+
+	jobOperationDetails, err := s.mapToCreateJobOperationDetails()
+	if err != nil {
+		return err
+	}
+	request.JobOperationDetails = jobOperationDetails
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.CreateJob(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Job
+
+	err = tfresource.WaitForResourceCondition(s, func() bool {
+		switch s.Res.LifecycleState {
+		case oci_resourcemanager.JobLifecycleStateSucceeded, oci_resourcemanager.JobLifecycleStateFailed, oci_resourcemanager.JobLifecycleStateCanceled:
+			return true
+		}
+		return false
+	}, s.D.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	// the log tail helps to find out why a job failed, so it is fetched before the state is checked
+	if err := s.getLogTail(); err != nil {
+		return err
+	}
+
+	if s.Res.LifecycleState != oci_resourcemanager.JobLifecycleStateSucceeded {
+		message := fmt.Sprintf("job %s of stack %s finished in state %s", *s.Res.Id, *request.StackId, s.Res.LifecycleState)
+		if s.Res.FailureDetails != nil && s.Res.FailureDetails.Message != nil {
+			message = fmt.Sprintf("%s: %s", message, *s.Res.FailureDetails.Message)
+		}
+		if s.LogTail != nil && *s.LogTail != "" {
+			message = fmt.Sprintf("%s\n%s", message, *s.LogTail)
+		}
+		return fmt.Errorf("%s", message)
+	}
+
+	return nil
+}
+
+func (s *ResourcemanagerJobResourceCrud) mapToCreateJobOperationDetails() (oci_resourcemanager.CreateJobOperationDetails, error) {
+	var isProviderUpgradeRequired *bool
+	if isProviderUpgradeRequiredRaw, ok := s.D.GetOkExists("is_provider_upgrade_required"); ok {
+		tmp := isProviderUpgradeRequiredRaw.(bool)
+		isProviderUpgradeRequired = &tmp
+	}
+
+	switch operation := strings.ToUpper(s.D.Get("operation").(string)); operation {
+	case string(oci_resourcemanager.JobOperationPlan):
+		return oci_resourcemanager.CreatePlanJobOperationDetails{
+			IsProviderUpgradeRequired: isProviderUpgradeRequired,
+		}, nil
+	case string(oci_resourcemanager.JobOperationApply):
+		details := oci_resourcemanager.CreateApplyJobOperationDetails{
+			IsProviderUpgradeRequired: isProviderUpgradeRequired,
+			ExecutionPlanStrategy:     oci_resourcemanager.ApplyJobOperationDetailsExecutionPlanStrategyAutoApproved,
+		}
+		if executionPlanStrategy, ok := s.D.GetOkExists("execution_plan_strategy"); ok {
+			details.ExecutionPlanStrategy = oci_resourcemanager.ApplyJobOperationDetailsExecutionPlanStrategyEnum(strings.ToUpper(executionPlanStrategy.(string)))
+		}
+		if executionPlanJobId, ok := s.D.GetOkExists("execution_plan_job_id"); ok {
+			tmp := executionPlanJobId.(string)
+			details.ExecutionPlanJobId = &tmp
+		}
+		return details, nil
+	case string(oci_resourcemanager.JobOperationDestroy):
+		// the service only supports auto approved destroy jobs
+		return oci_resourcemanager.CreateDestroyJobOperationDetails{
+			IsProviderUpgradeRequired: isProviderUpgradeRequired,
+			ExecutionPlanStrategy:     oci_resourcemanager.DestroyJobOperationDetailsExecutionPlanStrategyAutoApproved,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown operation '%v' was specified", operation)
+	}
+}
+
+func (s *ResourcemanagerJobResourceCrud) Get() error {
+	request := oci_resourcemanager.GetJobRequest{}
+
+	if s.Res != nil && s.Res.Id != nil {
+		request.JobId = s.Res.Id
+	} else {
+		tmp := s.D.Id()
+		request.JobId = &tmp
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.GetJob(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Job
+
+	// outputs are only available once the job has succeeded
+	if s.Res.LifecycleState == oci_resourcemanager.JobLifecycleStateSucceeded {
+		return s.getOutputs()
+	}
+
+	return nil
+}
+
+func (s *ResourcemanagerJobResourceCrud) getOutputs() error {
+	request := oci_resourcemanager.ListJobOutputsRequest{
+		JobId: s.Res.Id,
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.ListJobOutputs(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	outputs := response.Items
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListJobOutputs(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		outputs = append(outputs, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	s.Outputs = outputs
+	return nil
+}
+
+func (s *ResourcemanagerJobResourceCrud) getLogTail() error {
+	lines := s.D.Get("log_tail_lines").(int)
+	if lines == 0 {
+		return nil
+	}
+
+	request := oci_resourcemanager.GetJobLogsContentRequest{
+		JobId: s.Res.Id,
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.GetJobLogsContent(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	if response.Value == nil {
+		return nil
+	}
+
+	logTail := tailLines(*response.Value, lines)
+	s.LogTail = &logTail
+	return nil
+}
+
+// tailLines returns the last count lines of the text
+func tailLines(text string, count int) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	if len(lines) > count {
+		lines = lines[len(lines)-count:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (s *ResourcemanagerJobResourceCrud) SetData() error {
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	if details, ok := s.Res.JobOperationDetails.(oci_resourcemanager.ApplyJobOperationDetails); ok {
+		s.D.Set("execution_plan_strategy", details.ExecutionPlanStrategy)
+	}
+
+	// the log is only read when the job is run, refreshing the resource keeps the recorded tail
+	if s.LogTail != nil {
+		s.D.Set("log_tail", *s.LogTail)
+	}
+
+	outputs := []interface{}{}
+	for _, item := range s.Outputs {
+		outputs = append(outputs, JobOutputSummaryToMap(item))
+	}
+	s.D.Set("outputs", outputs)
+
+	if s.Res.ResolvedPlanJobId != nil {
+		s.D.Set("resolved_plan_job_id", *s.Res.ResolvedPlanJobId)
+	}
+
+	if s.Res.StackId != nil {
+		s.D.Set("stack_id", *s.Res.StackId)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.TimeFinished != nil {
+		s.D.Set("time_finished", s.Res.TimeFinished.String())
+	}
+
+	return nil
+}
+
+func JobOutputSummaryToMap(obj oci_resourcemanager.JobOutputSummary) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.Description != nil {
+		result["description"] = string(*obj.Description)
+	}
+
+	if obj.IsSensitive != nil {
+		result["is_sensitive"] = bool(*obj.IsSensitive)
+	}
+
+	if obj.OutputName != nil {
+		result["output_name"] = string(*obj.OutputName)
+	}
+
+	if obj.OutputType != nil {
+		result["output_type"] = string(*obj.OutputType)
+	}
+
+	if obj.OutputValue != nil {
+		result["output_value"] = string(*obj.OutputValue)
+	}
+
+	return result
+}
@@ -8,7 +8,6 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -16,81 +15,12 @@ import (
 )
 
 func ResourcemanagerStackDataSource() *schema.Resource {
-	return &schema.Resource{
-		Read: readSingularResourcemanagerStack,
-		Schema: map[string]*schema.Schema{
-			"stack_id": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			// Computed
-			"compartment_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"config_source": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						// Required
-						"config_source_type": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
-							ValidateFunc: validation.StringInSlice([]string{
-								"ZIP_UPLOAD",
-							}, true),
-						},
-						"zip_file_base64encoded": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-
-						// Optional
-						"working_directory": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-
-						// Computed
-					},
-				},
-			},
-			"defined_tags": {
-				Type:     schema.TypeMap,
-				Computed: true,
-				Elem:     schema.TypeString,
-			},
-			"description": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"display_name": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"freeform_tags": {
-				Type:     schema.TypeMap,
-				Computed: true,
-				Elem:     schema.TypeString,
-			},
-			"state": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"time_created": {
-				Type:     schema.TypeString,
-				Computed: true,
-			},
-			"variables": {
-				Type:     schema.TypeMap,
-				Computed: true,
-				Elem:     schema.TypeString,
-			},
-		},
+	fieldMap := make(map[string]*schema.Schema)
+	fieldMap["stack_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
 	}
+	return tfresource.GetSingularDataSourceItemSchema(ResourcemanagerStackResource(), fieldMap, readSingularResourcemanagerStack)
 }
 
 func readSingularResourcemanagerStack(d *schema.ResourceData, m interface{}) error {
@@ -165,8 +95,14 @@ func (s *ResourcemanagerStackDataSourceCrud) SetData() error {
 
 	s.D.Set("freeform_tags", s.Res.FreeformTags)
 
+	s.D.Set("stack_drift_status", s.Res.StackDriftStatus)
+
 	s.D.Set("state", s.Res.LifecycleState)
 
+	if s.Res.TerraformVersion != nil {
+		s.D.Set("terraform_version", *s.Res.TerraformVersion)
+	}
+
 	if s.Res.TimeCreated != nil {
 		s.D.Set("time_created", s.Res.TimeCreated.String())
 	}
@@ -178,9 +114,49 @@ func (s *ResourcemanagerStackDataSourceCrud) SetData() error {
 
 func ConfigSourceToMap(obj *oci_resourcemanager.ConfigSource) map[string]interface{} {
 	result := map[string]interface{}{}
-	switch (*obj).(type) {
+	switch v := (*obj).(type) {
+	case oci_resourcemanager.GitConfigSource:
+		result["config_source_type"] = "GIT_CONFIG_SOURCE"
+
+		if v.BranchName != nil {
+			result["branch_name"] = string(*v.BranchName)
+		}
+
+		if v.ConfigurationSourceProviderId != nil {
+			result["configuration_source_provider_id"] = string(*v.ConfigurationSourceProviderId)
+		}
+
+		if v.RepositoryUrl != nil {
+			result["repository_url"] = string(*v.RepositoryUrl)
+		}
+
+		if v.WorkingDirectory != nil {
+			result["working_directory"] = string(*v.WorkingDirectory)
+		}
+	case oci_resourcemanager.ObjectStorageConfigSource:
+		result["config_source_type"] = "OBJECT_STORAGE_CONFIG_SOURCE"
+
+		if v.BucketName != nil {
+			result["bucket_name"] = string(*v.BucketName)
+		}
+
+		if v.Namespace != nil {
+			result["namespace"] = string(*v.Namespace)
+		}
+
+		if v.Region != nil {
+			result["region"] = string(*v.Region)
+		}
+
+		if v.WorkingDirectory != nil {
+			result["working_directory"] = string(*v.WorkingDirectory)
+		}
 	case oci_resourcemanager.ZipUploadConfigSource:
 		result["config_source_type"] = "ZIP_UPLOAD"
+
+		if v.WorkingDirectory != nil {
+			result["working_directory"] = string(*v.WorkingDirectory)
+		}
 	default:
 		log.Printf("[WARN] Received 'config_source_type' of unknown type %v", *obj)
 		return nil
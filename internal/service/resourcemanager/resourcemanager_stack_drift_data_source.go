@@ -0,0 +1,374 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
+)
+
+func ResourcemanagerStackDriftDataSource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Read: tfresource.GetTimeoutDuration("1h"),
+		},
+		Read: readSingularResourcemanagerStackDrift,
+		Schema: map[string]*schema.Schema{
+			"stack_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_provider_upgrade_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"resource_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"resource_drift_status": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(oci_resourcemanager.GetStackResourceDriftSummaryResourceDriftStatusEnumStringValues(), false),
+				},
+			},
+			// Computed
+			"resource_drift_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"actual_properties": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"compartment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expected_properties": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     schema.TypeString,
+						},
+						"resource_drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_drift_checked": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"stack_drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_drift_last_checked": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"work_request_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readSingularResourcemanagerStackDrift(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerStackDriftDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type ResourcemanagerStackDriftDataSourceCrud struct {
+	D             *schema.ResourceData
+	Client        *oci_resourcemanager.ResourceManagerClient
+	Res           []oci_resourcemanager.StackResourceDriftSummary
+	Stack         *oci_resourcemanager.Stack
+	WorkRequestId *string
+}
+
+func (s *ResourcemanagerStackDriftDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *ResourcemanagerStackDriftDataSourceCrud) Get() error {
+	stackId := s.D.Get("stack_id").(string)
+
+	if s.D.Get("detect_drift").(bool) {
+		if err := s.detectDrift(&stackId); err != nil {
+			return err
+		}
+	}
+
+	request := oci_resourcemanager.ListStackResourceDriftDetailsRequest{
+		StackId: &stackId,
+		// without a work request the results of the most recent drift detection are listed
+		WorkRequestId: s.WorkRequestId,
+	}
+
+	if resourceDriftStatus, ok := s.D.GetOkExists("resource_drift_status"); ok {
+		for _, status := range resourceDriftStatus.([]interface{}) {
+			request.ResourceDriftStatus = append(request.ResourceDriftStatus, oci_resourcemanager.StackResourceDriftSummaryResourceDriftStatusEnum(status.(string)))
+		}
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
+
+	response, err := s.Client.ListStackResourceDriftDetails(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = response.Items
+	request.Page = response.OpcNextPage
+
+	for request.Page != nil {
+		listResponse, err := s.Client.ListStackResourceDriftDetails(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		s.Res = append(s.Res, listResponse.Items...)
+		request.Page = listResponse.OpcNextPage
+	}
+
+	stackRequest := oci_resourcemanager.GetStackRequest{
+		StackId: &stackId,
+	}
+
+	stackRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
+
+	stackResponse, err := s.Client.GetStack(context.Background(), stackRequest)
+	if err != nil {
+		return err
+	}
+
+	s.Stack = &stackResponse.Stack
+	return nil
+}
+
+func (s *ResourcemanagerStackDriftDataSourceCrud) detectDrift(stackId *string) error {
+	request := oci_resourcemanager.DetectStackDriftRequest{
+		StackId: stackId,
+	}
+
+	if isProviderUpgradeRequired, ok := s.D.GetOkExists("is_provider_upgrade_required"); ok {
+		tmp := isProviderUpgradeRequired.(bool)
+		request.IsProviderUpgradeRequired = &tmp
+	}
+
+	if resourceAddresses, ok := s.D.GetOkExists("resource_addresses"); ok {
+		interfaces := resourceAddresses.([]interface{})
+		tmp := make([]string, len(interfaces))
+		for i := range interfaces {
+			if interfaces[i] != nil {
+				tmp[i] = interfaces[i].(string)
+			}
+		}
+		if len(tmp) != 0 {
+			request.ResourceAddresses = tmp
+		}
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "resourcemanager")
+
+	response, err := s.Client.DetectStackDrift(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.WorkRequestId = response.OpcWorkRequestId
+
+	return stackDriftWaitForWorkRequest(s.WorkRequestId, s.D.Timeout(schema.TimeoutRead), s.Client)
+}
+
+func stackDriftWorkRequestShouldRetryFunc(timeout time.Duration) func(response oci_common.OCIOperationResponse) bool {
+	startTime := time.Now()
+	stopTime := startTime.Add(timeout)
+	return func(response oci_common.OCIOperationResponse) bool {
+
+		// Stop after timeout has elapsed
+		if time.Now().After(stopTime) {
+			return false
+		}
+
+		// Make sure we stop on default rules
+		if tfresource.ShouldRetry(response, false, "resourcemanager", startTime) {
+			return true
+		}
+
+		// Only stop if the time Finished is set
+		if workRequestResponse, ok := response.Response.(oci_resourcemanager.GetWorkRequestResponse); ok {
+			return workRequestResponse.TimeFinished == nil
+		}
+		return false
+	}
+}
+
+func stackDriftWaitForWorkRequest(wId *string, timeout time.Duration, client *oci_resourcemanager.ResourceManagerClient) error {
+	retryPolicy := tfresource.GetRetryPolicy(false, "resourcemanager")
+	retryPolicy.ShouldRetryOperation = stackDriftWorkRequestShouldRetryFunc(timeout)
+
+	response := oci_resourcemanager.GetWorkRequestResponse{}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(oci_resourcemanager.WorkRequestStatusAccepted),
+			string(oci_resourcemanager.WorkRequestStatusInProgress),
+		},
+		Target: []string{
+			string(oci_resourcemanager.WorkRequestStatusFailed),
+			string(oci_resourcemanager.WorkRequestStatusSucceeded),
+		},
+		Refresh: func() (interface{}, string, error) {
+			var err error
+			response, err = client.GetWorkRequest(context.Background(),
+				oci_resourcemanager.GetWorkRequestRequest{
+					WorkRequestId: wId,
+					RequestMetadata: oci_common.RequestMetadata{
+						RetryPolicy: retryPolicy,
+					},
+				})
+			wr := &response.WorkRequest
+			return wr, string(wr.Status), err
+		},
+		Timeout: timeout,
+	}
+	if _, e := stateConf.WaitForState(); e != nil {
+		return e
+	}
+
+	if response.Status == oci_resourcemanager.WorkRequestStatusFailed {
+		return getErrorFromStackDriftWorkRequest(client, wId, retryPolicy)
+	}
+
+	return nil
+}
+
+func getErrorFromStackDriftWorkRequest(client *oci_resourcemanager.ResourceManagerClient, workId *string, retryPolicy *oci_common.RetryPolicy) error {
+	response, err := client.ListWorkRequestErrors(context.Background(),
+		oci_resourcemanager.ListWorkRequestErrorsRequest{
+			WorkRequestId: workId,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: retryPolicy,
+			},
+		})
+	if err != nil {
+		return err
+	}
+
+	allErrs := make([]string, 0)
+	for _, wrkErr := range response.Items {
+		allErrs = append(allErrs, *wrkErr.Message)
+	}
+	errorMessage := strings.Join(allErrs, "\n")
+
+	workRequestErr := fmt.Errorf("work request did not succeed, workId: %s, entity: stack, action: %s. Message: %s", *workId, oci_resourcemanager.WorkRequestOperationTypeDriftDetection, errorMessage)
+
+	return workRequestErr
+}
+
+func (s *ResourcemanagerStackDriftDataSourceCrud) SetData() error {
+	if s.Stack == nil {
+		return nil
+	}
+
+	s.D.SetId(tfresource.GenerateDataSourceHashID("ResourcemanagerStackDriftDataSource-", ResourcemanagerStackDriftDataSource(), s.D))
+
+	resourceDriftDetails := []interface{}{}
+	for _, item := range s.Res {
+		resourceDriftDetails = append(resourceDriftDetails, StackResourceDriftSummaryToMap(item))
+	}
+	s.D.Set("resource_drift_details", resourceDriftDetails)
+
+	s.D.Set("stack_drift_status", s.Stack.StackDriftStatus)
+
+	if s.Stack.TimeDriftLastChecked != nil {
+		s.D.Set("time_drift_last_checked", s.Stack.TimeDriftLastChecked.String())
+	}
+
+	if s.WorkRequestId != nil {
+		s.D.Set("work_request_id", *s.WorkRequestId)
+	}
+
+	return nil
+}
+
+func StackResourceDriftSummaryToMap(obj oci_resourcemanager.StackResourceDriftSummary) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	result["actual_properties"] = obj.ActualProperties
+
+	if obj.CompartmentId != nil {
+		result["compartment_id"] = string(*obj.CompartmentId)
+	}
+
+	result["expected_properties"] = obj.ExpectedProperties
+
+	result["resource_drift_status"] = string(obj.ResourceDriftStatus)
+
+	if obj.ResourceId != nil {
+		result["resource_id"] = string(*obj.ResourceId)
+	}
+
+	if obj.ResourceName != nil {
+		result["resource_name"] = string(*obj.ResourceName)
+	}
+
+	if obj.ResourceType != nil {
+		result["resource_type"] = string(*obj.ResourceType)
+	}
+
+	if obj.TimeDriftChecked != nil {
+		result["time_drift_checked"] = obj.TimeDriftChecked.String()
+	}
+
+	return result
+}
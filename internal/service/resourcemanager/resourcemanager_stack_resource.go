@@ -0,0 +1,607 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
+)
+
+func ResourcemanagerStackResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createResourcemanagerStack,
+		Read:     readResourcemanagerStack,
+		Update:   updateResourcemanagerStack,
+		Delete:   deleteResourcemanagerStack,
+		CustomizeDiff: customdiff.All(
+			tfresource.RequiredWhen(tfresource.AttributeIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeZipUpload)), "config_source_type is ZIP_UPLOAD",
+				"config_source.0.zip_file_base64encoded"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeGitConfigSource)), "config_source_type is GIT_CONFIG_SOURCE",
+				"config_source.0.configuration_source_provider_id"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeObjectStorageConfigSource)), "config_source_type is OBJECT_STORAGE_CONFIG_SOURCE",
+				"config_source.0.bucket_name"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeObjectStorageConfigSource)), "config_source_type is OBJECT_STORAGE_CONFIG_SOURCE",
+				"config_source.0.namespace"),
+			tfresource.RequiredWhen(tfresource.AttributeIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeObjectStorageConfigSource)), "config_source_type is OBJECT_STORAGE_CONFIG_SOURCE",
+				"config_source.0.region"),
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeZipUpload)), "config_source_type is not ZIP_UPLOAD",
+				"config_source.0.zip_file_base64encoded"),
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeGitConfigSource)), "config_source_type is not GIT_CONFIG_SOURCE",
+				"config_source.0.branch_name",
+				"config_source.0.configuration_source_provider_id",
+				"config_source.0.repository_url"),
+			tfresource.ConflictsWhen(tfresource.AttributeNotIn("config_source.0.config_source_type", string(oci_resourcemanager.ConfigSourceConfigSourceTypeObjectStorageConfigSource)), "config_source_type is not OBJECT_STORAGE_CONFIG_SOURCE",
+				"config_source.0.bucket_name",
+				"config_source.0.namespace",
+				"config_source.0.region"),
+		),
+		Schema: map[string]*schema.Schema{
+			// Required
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"config_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"config_source_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
+							ValidateFunc: validation.StringInSlice([]string{
+								"GIT_CONFIG_SOURCE",
+								"OBJECT_STORAGE_CONFIG_SOURCE",
+								"ZIP_UPLOAD",
+							}, true),
+						},
+
+						// Optional
+						"branch_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"bucket_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"configuration_source_provider_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"repository_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"working_directory": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"zip_file_base64encoded": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						// Computed
+					},
+				},
+			},
+
+			// Optional
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: tfresource.DefinedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"terraform_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+
+			// Computed
+			"stack_drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createResourcemanagerStack(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerStackResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readResourcemanagerStack(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerStackResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateResourcemanagerStack(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerStackResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteResourcemanagerStack(d *schema.ResourceData, m interface{}) error {
+	sync := &ResourcemanagerStackResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).ResourceManagerClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type ResourcemanagerStackResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_resourcemanager.ResourceManagerClient
+	Res                    *oci_resourcemanager.Stack
+	DisableNotFoundRetries bool
+}
+
+func (s *ResourcemanagerStackResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *ResourcemanagerStackResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_resourcemanager.StackLifecycleStateCreating),
+	}
+}
+
+func (s *ResourcemanagerStackResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_resourcemanager.StackLifecycleStateActive),
+	}
+}
+
+func (s *ResourcemanagerStackResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_resourcemanager.StackLifecycleStateDeleting),
+	}
+}
+
+func (s *ResourcemanagerStackResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_resourcemanager.StackLifecycleStateDeleted),
+	}
+}
+
+func (s *ResourcemanagerStackResourceCrud) Create() error {
+	request := oci_resourcemanager.CreateStackRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if configSource, ok := s.D.GetOkExists("config_source"); ok {
+		if tmpList := configSource.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "config_source", 0)
+			tmp, err := s.mapToCreateConfigSourceDetails(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.ConfigSource = tmp
+		}
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if description, ok := s.D.GetOkExists("description"); ok {
+		tmp := description.(string)
+		request.Description = &tmp
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	if terraformVersion, ok := s.D.GetOkExists("terraform_version"); ok {
+		tmp := terraformVersion.(string)
+		request.TerraformVersion = &tmp
+	}
+
+	if variables, ok := s.D.GetOkExists("variables"); ok {
+		request.Variables = tfresource.ObjectMapToStringMap(variables.(map[string]interface{}))
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.CreateStack(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Stack
+	return nil
+}
+
+func (s *ResourcemanagerStackResourceCrud) Get() error {
+	request := oci_resourcemanager.GetStackRequest{}
+
+	tmp := s.D.Id()
+	request.StackId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.GetStack(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Stack
+	return nil
+}
+
+func (s *ResourcemanagerStackResourceCrud) Update() error {
+	if compartment, ok := s.D.GetOkExists("compartment_id"); ok && s.D.HasChange("compartment_id") {
+		oldRaw, newRaw := s.D.GetChange("compartment_id")
+		if newRaw != "" && oldRaw != "" {
+			err := s.updateCompartment(compartment)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	request := oci_resourcemanager.UpdateStackRequest{}
+
+	if configSource, ok := s.D.GetOkExists("config_source"); ok && s.D.HasChange("config_source") {
+		if tmpList := configSource.([]interface{}); len(tmpList) > 0 {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "config_source", 0)
+			tmp, err := s.mapToUpdateConfigSourceDetails(fieldKeyFormat)
+			if err != nil {
+				return err
+			}
+			request.ConfigSource = tmp
+		}
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if description, ok := s.D.GetOkExists("description"); ok {
+		tmp := description.(string)
+		request.Description = &tmp
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	tmp := s.D.Id()
+	request.StackId = &tmp
+
+	if terraformVersion, ok := s.D.GetOkExists("terraform_version"); ok {
+		tmp := terraformVersion.(string)
+		request.TerraformVersion = &tmp
+	}
+
+	if variables, ok := s.D.GetOkExists("variables"); ok {
+		request.Variables = tfresource.ObjectMapToStringMap(variables.(map[string]interface{}))
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	response, err := s.Client.UpdateStack(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response.Stack
+	return nil
+}
+
+func (s *ResourcemanagerStackResourceCrud) Delete() error {
+	request := oci_resourcemanager.DeleteStackRequest{}
+
+	tmp := s.D.Id()
+	request.StackId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	_, err := s.Client.DeleteStack(context.Background(), request)
+	return err
+}
+
+func (s *ResourcemanagerStackResourceCrud) SetData() error {
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.ConfigSource != nil {
+		configSourceArray := []interface{}{}
+		if configSourceMap := ConfigSourceToMap(&s.Res.ConfigSource); configSourceMap != nil {
+			// the service never returns the uploaded archive, the configured value is kept instead
+			if zipFileBase64Encoded, ok := s.D.GetOkExists("config_source.0.zip_file_base64encoded"); ok {
+				configSourceMap["zip_file_base64encoded"] = zipFileBase64Encoded
+			}
+			configSourceArray = append(configSourceArray, configSourceMap)
+		}
+		s.D.Set("config_source", configSourceArray)
+	} else {
+		s.D.Set("config_source", nil)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", tfresource.DefinedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.Description != nil {
+		s.D.Set("description", *s.Res.Description)
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	s.D.Set("stack_drift_status", s.Res.StackDriftStatus)
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TerraformVersion != nil {
+		s.D.Set("terraform_version", *s.Res.TerraformVersion)
+	}
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	s.D.Set("variables", s.Res.Variables)
+
+	return nil
+}
+
+func (s *ResourcemanagerStackResourceCrud) mapToCreateConfigSourceDetails(fieldKeyFormat string) (oci_resourcemanager.CreateConfigSourceDetails, error) {
+	var baseObject oci_resourcemanager.CreateConfigSourceDetails
+	//discriminator
+	configSourceTypeRaw, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "config_source_type"))
+	var configSourceType string
+	if ok {
+		configSourceType = strings.ToUpper(configSourceTypeRaw.(string))
+	} else {
+		configSourceType = "" // default value
+	}
+	switch configSourceType {
+	case "GIT_CONFIG_SOURCE":
+		details := oci_resourcemanager.CreateGitConfigSourceDetails{}
+		if branchName, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "branch_name")); ok {
+			tmp := branchName.(string)
+			details.BranchName = &tmp
+		}
+		if configurationSourceProviderId, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "configuration_source_provider_id")); ok {
+			tmp := configurationSourceProviderId.(string)
+			details.ConfigurationSourceProviderId = &tmp
+		}
+		if repositoryUrl, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "repository_url")); ok {
+			tmp := repositoryUrl.(string)
+			details.RepositoryUrl = &tmp
+		}
+		if workingDirectory, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "working_directory")); ok {
+			tmp := workingDirectory.(string)
+			details.WorkingDirectory = &tmp
+		}
+		baseObject = details
+	case "OBJECT_STORAGE_CONFIG_SOURCE":
+		details := oci_resourcemanager.CreateObjectStorageConfigSourceDetails{}
+		if bucketName, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "bucket_name")); ok {
+			tmp := bucketName.(string)
+			details.BucketName = &tmp
+		}
+		if namespace, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "namespace")); ok {
+			tmp := namespace.(string)
+			details.Namespace = &tmp
+		}
+		if region, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "region")); ok {
+			tmp := region.(string)
+			details.Region = &tmp
+		}
+		if workingDirectory, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "working_directory")); ok {
+			tmp := workingDirectory.(string)
+			details.WorkingDirectory = &tmp
+		}
+		baseObject = details
+	case "ZIP_UPLOAD":
+		details := oci_resourcemanager.CreateZipUploadConfigSourceDetails{}
+		if workingDirectory, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "working_directory")); ok {
+			tmp := workingDirectory.(string)
+			details.WorkingDirectory = &tmp
+		}
+		if zipFileBase64Encoded, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "zip_file_base64encoded")); ok {
+			tmp := zipFileBase64Encoded.(string)
+			details.ZipFileBase64Encoded = &tmp
+		}
+		baseObject = details
+	default:
+		return nil, fmt.Errorf("unknown config_source_type '%v' was specified", configSourceType)
+	}
+	return baseObject, nil
+}
+
+func (s *ResourcemanagerStackResourceCrud) mapToUpdateConfigSourceDetails(fieldKeyFormat string) (oci_resourcemanager.UpdateConfigSourceDetails, error) {
+	var baseObject oci_resourcemanager.UpdateConfigSourceDetails
+	//discriminator
+	configSourceTypeRaw, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "config_source_type"))
+	var configSourceType string
+	if ok {
+		configSourceType = strings.ToUpper(configSourceTypeRaw.(string))
+	} else {
+		configSourceType = "" // default value
+	}
+	switch configSourceType {
+	case "GIT_CONFIG_SOURCE":
+		details := oci_resourcemanager.UpdateGitConfigSourceDetails{}
+		if branchName, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "branch_name")); ok {
+			tmp := branchName.(string)
+			details.BranchName = &tmp
+		}
+		if configurationSourceProviderId, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "configuration_source_provider_id")); ok {
+			tmp := configurationSourceProviderId.(string)
+			details.ConfigurationSourceProviderId = &tmp
+		}
+		if repositoryUrl, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "repository_url")); ok {
+			tmp := repositoryUrl.(string)
+			details.RepositoryUrl = &tmp
+		}
+		if workingDirectory, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "working_directory")); ok {
+			tmp := workingDirectory.(string)
+			details.WorkingDirectory = &tmp
+		}
+		baseObject = details
+	case "OBJECT_STORAGE_CONFIG_SOURCE":
+		details := oci_resourcemanager.UpdateObjectStorageConfigSourceDetails{}
+		if bucketName, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "bucket_name")); ok {
+			tmp := bucketName.(string)
+			details.BucketName = &tmp
+		}
+		if namespace, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "namespace")); ok {
+			tmp := namespace.(string)
+			details.Namespace = &tmp
+		}
+		if region, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "region")); ok {
+			tmp := region.(string)
+			details.Region = &tmp
+		}
+		if workingDirectory, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "working_directory")); ok {
+			tmp := workingDirectory.(string)
+			details.WorkingDirectory = &tmp
+		}
+		baseObject = details
+	case "ZIP_UPLOAD":
+		details := oci_resourcemanager.UpdateZipUploadConfigSourceDetails{}
+		if workingDirectory, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "working_directory")); ok {
+			tmp := workingDirectory.(string)
+			details.WorkingDirectory = &tmp
+		}
+		// only upload the archive again when it changed
+		if zipFileBase64Encoded, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "zip_file_base64encoded")); ok && s.D.HasChange(fmt.Sprintf(fieldKeyFormat, "zip_file_base64encoded")) {
+			tmp := zipFileBase64Encoded.(string)
+			details.ZipFileBase64Encoded = &tmp
+		}
+		baseObject = details
+	default:
+		return nil, fmt.Errorf("unknown config_source_type '%v' was specified", configSourceType)
+	}
+	return baseObject, nil
+}
+
+func (s *ResourcemanagerStackResourceCrud) updateCompartment(compartment interface{}) error {
+	changeCompartmentRequest := oci_resourcemanager.ChangeStackCompartmentRequest{}
+
+	compartmentTmp := compartment.(string)
+	changeCompartmentRequest.CompartmentId = &compartmentTmp
+
+	idTmp := s.D.Id()
+	changeCompartmentRequest.StackId = &idTmp
+
+	changeCompartmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "resourcemanager")
+
+	_, err := s.Client.ChangeStackCompartment(context.Background(), changeCompartmentRequest)
+	if err != nil {
+		return err
+	}
+
+	if waitErr := tfresource.WaitForUpdatedState(s.D, s); waitErr != nil {
+		return waitErr
+	}
+
+	return nil
+}
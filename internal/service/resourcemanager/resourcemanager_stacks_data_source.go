@@ -7,7 +7,6 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	oci_resourcemanager "github.com/oracle/oci-go-sdk/v65/resourcemanager"
 
 	"github.com/oracle/terraform-provider-oci/internal/client"
@@ -38,92 +37,7 @@ func ResourcemanagerStacksDataSource() *schema.Resource {
 			"stacks": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						// Required
-						"compartment_id": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"config_source": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							MinItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									// Required
-									"config_source_type": {
-										Type:             schema.TypeString,
-										Required:         true,
-										DiffSuppressFunc: tfresource.EqualIgnoreCaseSuppressDiff,
-										ValidateFunc: validation.StringInSlice([]string{
-											"ZIP_UPLOAD",
-										}, true),
-									},
-									"zip_file_base64encoded": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-
-									// Optional
-									"working_directory": {
-										Type:     schema.TypeString,
-										Optional: true,
-										Computed: true,
-									},
-
-									// Computed
-								},
-							},
-						},
-
-						// Optional
-						"defined_tags": {
-							Type:             schema.TypeMap,
-							Optional:         true,
-							Computed:         true,
-							DiffSuppressFunc: tfresource.DefinedTagsDiffSuppressFunction,
-							Elem:             schema.TypeString,
-						},
-						"description": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-						"display_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-						"freeform_tags": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Computed: true,
-							Elem:     schema.TypeString,
-						},
-						"variables": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Computed: true,
-							Elem:     schema.TypeString,
-						},
-
-						// Computed
-						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"state": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"time_created": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+				Elem:     tfresource.GetDataSourceItemSchema(ResourcemanagerStackResource()),
 			},
 		},
 	}
@@ -227,6 +141,10 @@ func (s *ResourcemanagerStacksDataSourceCrud) SetData() error {
 
 		stack["state"] = r.LifecycleState
 
+		if r.TerraformVersion != nil {
+			stack["terraform_version"] = *r.TerraformVersion
+		}
+
 		if r.TimeCreated != nil {
 			stack["time_created"] = r.TimeCreated.String()
 		}
@@ -6,6 +6,7 @@ package vn_monitoring
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -320,6 +321,90 @@ func VnMonitoringPathAnalysiResource() *schema.Resource {
 			},
 
 			// Computed
+			"paths": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"forward_route": vnMonitoringTrafficRouteSchema(),
+						"return_route":  vnMonitoringTrafficRouteSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func vnMonitoringTrafficRouteSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Required
+
+				// Optional
+
+				// Computed
+				"nodes": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							// Required
+
+							// Optional
+
+							// Computed
+							"destination_address": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"egress_security_action": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"entity_id": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"ingress_security_action": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"next_hop_routing_action": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"source_address": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"transformation_description": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"reachability_status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"route_analysis_description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
 		},
 	}
 }
@@ -343,6 +428,7 @@ func deleteVnMonitoringPathAnalysi(d *schema.ResourceData, m interface{}) error
 type VnMonitoringPathAnalysiResourceCrud struct {
 	tfresource.BaseCrud
 	Client                 *oci_vn_monitoring.VnMonitoringClient
+	Res                    []oci_vn_monitoring.Path
 	DisableNotFoundRetries bool
 }
 
@@ -397,6 +483,39 @@ func (s *VnMonitoringPathAnalysiResourceCrud) getPathAnalysiFromWorkRequest(work
 	}
 	s.D.SetId(*pathAnalysiId)
 
+	return s.getPathAnalysisResults(workId, retryPolicy)
+}
+
+// getPathAnalysisResults reads the paths found by the analysis from the results of the work request
+func (s *VnMonitoringPathAnalysiResourceCrud) getPathAnalysisResults(workId *string, retryPolicy *oci_common.RetryPolicy) error {
+	request := oci_vn_monitoring.ListWorkRequestResultsRequest{
+		WorkRequestId: workId,
+		ResultType:    oci_vn_monitoring.WorkRequestResultResultTypePathAnalysis,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: retryPolicy,
+		},
+	}
+
+	paths := []oci_vn_monitoring.Path{}
+	for {
+		response, err := s.Client.ListWorkRequestResults(context.Background(), request)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range response.Items {
+			if result, ok := item.(oci_vn_monitoring.PathAnalysisWorkRequestResult); ok {
+				paths = append(paths, result.Paths...)
+			}
+		}
+
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+
+	s.Res = paths
 	return nil
 }
 
@@ -499,9 +618,106 @@ func getErrorFromVnMonitoringPathAnalysiWorkRequest(client *oci_vn_monitoring.Vn
 }
 
 func (s *VnMonitoringPathAnalysiResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	paths := []interface{}{}
+	for _, item := range s.Res {
+		paths = append(paths, VnMonitoringPathToMap(item))
+	}
+	s.D.Set("paths", paths)
+
 	return nil
 }
 
+func VnMonitoringPathToMap(obj oci_vn_monitoring.Path) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.ForwardRoute != nil {
+		result["forward_route"] = []interface{}{VnMonitoringTrafficRouteToMap(obj.ForwardRoute)}
+	}
+
+	if obj.ReturnRoute != nil {
+		result["return_route"] = []interface{}{VnMonitoringTrafficRouteToMap(obj.ReturnRoute)}
+	}
+
+	return result
+}
+
+func VnMonitoringTrafficRouteToMap(obj *oci_vn_monitoring.TrafficRoute) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	nodes := []interface{}{}
+	for _, item := range obj.Nodes {
+		nodes = append(nodes, VnMonitoringTrafficNodeToMap(item))
+	}
+	result["nodes"] = nodes
+
+	result["reachability_status"] = string(obj.ReachabilityStatus)
+
+	if obj.RouteAnalysisDescription != nil {
+		result["route_analysis_description"] = string(*obj.RouteAnalysisDescription)
+	}
+
+	return result
+}
+
+func VnMonitoringTrafficNodeToMap(obj oci_vn_monitoring.TrafficNode) map[string]interface{} {
+	result := map[string]interface{}{}
+	switch v := (obj).(type) {
+	case oci_vn_monitoring.VisibleTrafficNode:
+		result["type"] = "VISIBLE"
+
+		if v.EntityId != nil {
+			result["entity_id"] = string(*v.EntityId)
+		}
+
+		if v.TransformationDescription != nil {
+			result["transformation_description"] = string(*v.TransformationDescription)
+		}
+	case oci_vn_monitoring.AccessDeniedTrafficNode:
+		result["type"] = "ACCESS_DENIED"
+	default:
+		log.Printf("[WARN] Received 'type' of unknown type %v", obj)
+		return nil
+	}
+
+	if egressTraffic := obj.GetEgressTraffic(); egressTraffic != nil {
+		if egressTraffic.DestinationAddress != nil {
+			result["destination_address"] = string(*egressTraffic.DestinationAddress)
+		}
+
+		if egressTraffic.SourceAddress != nil {
+			result["source_address"] = string(*egressTraffic.SourceAddress)
+		}
+	}
+
+	switch obj.GetNextHopRoutingAction().(type) {
+	case oci_vn_monitoring.ForwardedRoutingAction:
+		result["next_hop_routing_action"] = string(oci_vn_monitoring.RoutingActionActionForwarded)
+	case oci_vn_monitoring.NoRouteRoutingAction:
+		result["next_hop_routing_action"] = string(oci_vn_monitoring.RoutingActionActionNoRoute)
+	case oci_vn_monitoring.IndeterminateRoutingAction:
+		result["next_hop_routing_action"] = string(oci_vn_monitoring.RoutingActionActionIndeterminate)
+	}
+
+	result["egress_security_action"] = vnMonitoringSecurityActionToString(obj.GetEgressSecurityAction())
+	result["ingress_security_action"] = vnMonitoringSecurityActionToString(obj.GetIngressSecurityAction())
+
+	return result
+}
+
+func vnMonitoringSecurityActionToString(obj oci_vn_monitoring.SecurityAction) string {
+	switch obj.(type) {
+	case oci_vn_monitoring.AllowedSecurityAction:
+		return string(oci_vn_monitoring.SecurityActionActionAllowed)
+	case oci_vn_monitoring.DeniedSecurityAction:
+		return string(oci_vn_monitoring.SecurityActionActionDenied)
+	}
+	return ""
+}
+
 func (s *VnMonitoringPathAnalysiResourceCrud) mapToEndpoint(fieldKeyFormat string) (oci_vn_monitoring.Endpoint, error) {
 	var baseObject oci_vn_monitoring.Endpoint
 	//discriminator
@@ -8,6 +8,7 @@ import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 func RegisterDatasource() {
 	tfresource.RegisterDatasource("oci_vulnerability_scanning_container_scan_recipe", VulnerabilityScanningContainerScanRecipeDataSource())
 	tfresource.RegisterDatasource("oci_vulnerability_scanning_container_scan_recipes", VulnerabilityScanningContainerScanRecipesDataSource())
+	tfresource.RegisterDatasource("oci_vulnerability_scanning_container_scan_result", VulnerabilityScanningContainerScanResultDataSource())
 	tfresource.RegisterDatasource("oci_vulnerability_scanning_container_scan_target", VulnerabilityScanningContainerScanTargetDataSource())
 	tfresource.RegisterDatasource("oci_vulnerability_scanning_container_scan_targets", VulnerabilityScanningContainerScanTargetsDataSource())
 	tfresource.RegisterDatasource("oci_vulnerability_scanning_host_scan_recipe", VulnerabilityScanningHostScanRecipeDataSource())
@@ -0,0 +1,309 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vulnerability_scanning
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_vulnerability_scanning "github.com/oracle/oci-go-sdk/v65/vulnerabilityscanning"
+)
+
+func VulnerabilityScanningContainerScanResultDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: readSingularVulnerabilityScanningContainerScanResult,
+		Schema: map[string]*schema.Schema{
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"repository": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"are_subcompartments_included": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			// Computed
+			"container_scan_target_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"highest_problem_severity": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"problem_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"problems": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+
+						// Optional
+
+						// Computed
+						"cve_reference": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_first_detected": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time_last_detected": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vulnerable_packages": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Required
+
+									// Optional
+
+									// Computed
+									"cve_fix_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"registry_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_finished": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"time_started": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func readSingularVulnerabilityScanningContainerScanResult(d *schema.ResourceData, m interface{}) error {
+	sync := &VulnerabilityScanningContainerScanResultDataSourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).VulnerabilityScanningClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+type VulnerabilityScanningContainerScanResultDataSourceCrud struct {
+	D      *schema.ResourceData
+	Client *oci_vulnerability_scanning.VulnerabilityScanningClient
+	Res    *oci_vulnerability_scanning.GetContainerScanResultResponse
+}
+
+func (s *VulnerabilityScanningContainerScanResultDataSourceCrud) VoidState() {
+	s.D.SetId("")
+}
+
+func (s *VulnerabilityScanningContainerScanResultDataSourceCrud) Get() error {
+	listRequest := oci_vulnerability_scanning.ListContainerScanResultsRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		listRequest.CompartmentId = &tmp
+	}
+
+	if areSubcompartmentsIncluded, ok := s.D.GetOkExists("are_subcompartments_included"); ok {
+		tmp := areSubcompartmentsIncluded.(bool)
+		listRequest.AreSubcompartmentsIncluded = &tmp
+	}
+
+	image := s.D.Get("image").(string)
+	listRequest.Image = &image
+
+	repository := s.D.Get("repository").(string)
+	listRequest.Repository = &repository
+
+	// only the most recent scan of the image decides whether it can be deployed
+	isLatestOnly := true
+	listRequest.IsLatestOnly = &isLatestOnly
+	listRequest.SortBy = oci_vulnerability_scanning.ListContainerScanResultsSortByTimestarted
+	listRequest.SortOrder = oci_vulnerability_scanning.ListContainerScanResultsSortOrderDesc
+
+	listRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
+
+	listResponse, err := s.Client.ListContainerScanResults(context.Background(), listRequest)
+	if err != nil {
+		return err
+	}
+
+	if len(listResponse.Items) == 0 || listResponse.Items[0].Id == nil {
+		return fmt.Errorf("no container scan result was found for image %s in repository %s", image, repository)
+	}
+
+	request := oci_vulnerability_scanning.GetContainerScanResultRequest{
+		ContainerScanResultId: listResponse.Items[0].Id,
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(false, "vulnerability_scanning")
+
+	response, err := s.Client.GetContainerScanResult(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	s.Res = &response
+	return nil
+}
+
+func (s *VulnerabilityScanningContainerScanResultDataSourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	s.D.SetId(*s.Res.Id)
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.ContainerScanTargetId != nil {
+		s.D.Set("container_scan_target_id", *s.Res.ContainerScanTargetId)
+	}
+
+	s.D.Set("highest_problem_severity", s.Res.HighestProblemSeverity)
+
+	if s.Res.Image != nil {
+		s.D.Set("image", *s.Res.Image)
+	}
+
+	if s.Res.ProblemCount != nil {
+		s.D.Set("problem_count", *s.Res.ProblemCount)
+	}
+
+	problems := []interface{}{}
+	for _, item := range s.Res.Problems {
+		problems = append(problems, ContainerScanResultProblemToMap(item))
+	}
+	s.D.Set("problems", problems)
+
+	if s.Res.RegistryUrl != nil {
+		s.D.Set("registry_url", *s.Res.RegistryUrl)
+	}
+
+	if s.Res.Repository != nil {
+		s.D.Set("repository", *s.Res.Repository)
+	}
+
+	if s.Res.TimeFinished != nil {
+		s.D.Set("time_finished", s.Res.TimeFinished.String())
+	}
+
+	if s.Res.TimeStarted != nil {
+		s.D.Set("time_started", s.Res.TimeStarted.String())
+	}
+
+	return nil
+}
+
+func ContainerScanResultProblemToMap(obj oci_vulnerability_scanning.ContainerScanResultProblem) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.CveReference != nil {
+		result["cve_reference"] = string(*obj.CveReference)
+	}
+
+	if obj.Description != nil {
+		result["description"] = string(*obj.Description)
+	}
+
+	if obj.Name != nil {
+		result["name"] = string(*obj.Name)
+	}
+
+	result["severity"] = string(obj.Severity)
+
+	result["state"] = string(obj.State)
+
+	if obj.TimeFirstDetected != nil {
+		result["time_first_detected"] = obj.TimeFirstDetected.String()
+	}
+
+	if obj.TimeLastDetected != nil {
+		result["time_last_detected"] = obj.TimeLastDetected.String()
+	}
+
+	vulnerablePackages := []interface{}{}
+	for _, item := range obj.VulnerablePackages {
+		vulnerablePackages = append(vulnerablePackages, ModelPackageToMap(item))
+	}
+	result["vulnerable_packages"] = vulnerablePackages
+
+	return result
+}
+
+func ModelPackageToMap(obj oci_vulnerability_scanning.ModelPackage) map[string]interface{} {
+	result := map[string]interface{}{}
+
+	if obj.CveFixVersion != nil {
+		result["cve_fix_version"] = string(*obj.CveFixVersion)
+	}
+
+	if obj.Name != nil {
+		result["name"] = string(*obj.Name)
+	}
+
+	result["type"] = string(obj.Type)
+
+	if obj.Version != nil {
+		result["version"] = string(*obj.Version)
+	}
+
+	return result
+}
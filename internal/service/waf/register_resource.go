@@ -6,6 +6,7 @@ package waf
 import "github.com/oracle/terraform-provider-oci/internal/tfresource"
 
 func RegisterResource() {
+	tfresource.RegisterResource("oci_waf_load_balancer_attachment", WafLoadBalancerAttachmentResource())
 	tfresource.RegisterResource("oci_waf_network_address_list", WafNetworkAddressListResource())
 	tfresource.RegisterResource("oci_waf_web_app_firewall", WafWebAppFirewallResource())
 	tfresource.RegisterResource("oci_waf_web_app_firewall_policy", WafWebAppFirewallPolicyResource())
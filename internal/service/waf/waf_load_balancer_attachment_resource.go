@@ -0,0 +1,582 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package waf
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/oracle/terraform-provider-oci/internal/client"
+	"github.com/oracle/terraform-provider-oci/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	oci_waf "github.com/oracle/oci-go-sdk/v65/waf"
+)
+
+const (
+	wafLoadBalancerAttachmentBlockActionName    = "block"
+	wafLoadBalancerAttachmentProtectionRuleName = "protection"
+)
+
+// WafLoadBalancerAttachmentResource protects a load balancer with a web app firewall and the policy it enforces.
+// Changes to the protection settings create a new policy and switch the firewall to it, so that the load balancer is
+// never left without a policy attached. Only the policies created by the attachment are deleted, the policy of an
+// imported firewall is left untouched.
+func WafLoadBalancerAttachmentResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: tfresource.DefaultTimeout,
+		Create:   createWafLoadBalancerAttachment,
+		Read:     readWafLoadBalancerAttachment,
+		Update:   updateWafLoadBalancerAttachment,
+		Delete:   deleteWafLoadBalancerAttachment,
+		Schema: map[string]*schema.Schema{
+			// Required
+			"compartment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"load_balancer_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			// Optional
+			"block_response_body": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Request blocked by the web application firewall",
+			},
+			"block_response_code": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      401,
+				ValidateFunc: validation.IntBetween(200, 599),
+			},
+			"defined_tags": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: tfresource.DefinedTagsDiffSuppressFunction,
+				Elem:             schema.TypeString,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"freeform_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     schema.TypeString,
+			},
+			"is_body_inspection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"protection_capabilities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Required
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// Optional
+						"version": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+						},
+
+						// Computed
+					},
+				},
+			},
+
+			// Computed
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_policy_managed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_app_firewall_policy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func createWafLoadBalancerAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &WafLoadBalancerAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).WafClient()
+
+	return tfresource.CreateResource(d, sync)
+}
+
+func readWafLoadBalancerAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &WafLoadBalancerAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).WafClient()
+
+	return tfresource.ReadResource(sync)
+}
+
+func updateWafLoadBalancerAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &WafLoadBalancerAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).WafClient()
+
+	return tfresource.UpdateResource(d, sync)
+}
+
+func deleteWafLoadBalancerAttachment(d *schema.ResourceData, m interface{}) error {
+	sync := &WafLoadBalancerAttachmentResourceCrud{}
+	sync.D = d
+	sync.Client = m.(*client.OracleClients).WafClient()
+	sync.DisableNotFoundRetries = true
+
+	return tfresource.DeleteResource(d, sync)
+}
+
+type WafLoadBalancerAttachmentResourceCrud struct {
+	tfresource.BaseCrud
+	Client                 *oci_waf.WafClient
+	Res                    *oci_waf.WebAppFirewallLoadBalancer
+	Policy                 *oci_waf.WebAppFirewallPolicy
+	DisableNotFoundRetries bool
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) ID() string {
+	return *s.Res.Id
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) CreatedPending() []string {
+	return []string{
+		string(oci_waf.WebAppFirewallLifecycleStateCreating),
+	}
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) CreatedTarget() []string {
+	return []string{
+		string(oci_waf.WebAppFirewallLifecycleStateActive),
+	}
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) DeletedPending() []string {
+	return []string{
+		string(oci_waf.WebAppFirewallLifecycleStateDeleting),
+	}
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) DeletedTarget() []string {
+	return []string{
+		string(oci_waf.WebAppFirewallLifecycleStateDeleted),
+	}
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) Create() error {
+	policyId, err := s.createPolicy()
+	if err != nil {
+		return err
+	}
+
+	details := oci_waf.CreateWebAppFirewallLoadBalancerDetails{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		details.CompartmentId = &tmp
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		details.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		details.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		details.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	if loadBalancerId, ok := s.D.GetOkExists("load_balancer_id"); ok {
+		tmp := loadBalancerId.(string)
+		details.LoadBalancerId = &tmp
+	}
+
+	details.WebAppFirewallPolicyId = policyId
+
+	request := oci_waf.CreateWebAppFirewallRequest{}
+	request.CreateWebAppFirewallDetails = details
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+
+	response, err := s.Client.CreateWebAppFirewall(context.Background(), request)
+	if err != nil {
+		s.deletePolicy(policyId)
+		return err
+	}
+
+	webAppFirewallId, err := webAppFirewallWaitForWorkRequest(response.OpcWorkRequestId, "webAppFirewall",
+		oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	if err != nil {
+		if response.WebAppFirewall != nil && response.GetId() != nil {
+			// The firewall may still exist; keep it in the state so that it is cleaned up by a destroy
+			s.D.Set("is_policy_managed", true)
+			s.D.SetId(*response.GetId())
+		} else {
+			s.deletePolicy(policyId)
+		}
+		return err
+	}
+	s.D.Set("is_policy_managed", true)
+	s.D.SetId(*webAppFirewallId)
+
+	return s.Get()
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) Get() error {
+	request := oci_waf.GetWebAppFirewallRequest{}
+
+	tmp := s.D.Id()
+	request.WebAppFirewallId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+
+	response, err := s.Client.GetWebAppFirewall(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	webAppFirewall, ok := response.WebAppFirewall.(oci_waf.WebAppFirewallLoadBalancer)
+	if !ok {
+		return fmt.Errorf("web app firewall %s is not attached to a load balancer", tmp)
+	}
+	s.Res = &webAppFirewall
+
+	if webAppFirewall.WebAppFirewallPolicyId == nil || webAppFirewall.LifecycleState == oci_waf.WebAppFirewallLifecycleStateDeleted {
+		s.Policy = nil
+		return nil
+	}
+
+	policyRequest := oci_waf.GetWebAppFirewallPolicyRequest{}
+	policyRequest.WebAppFirewallPolicyId = webAppFirewall.WebAppFirewallPolicyId
+	policyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+
+	policyResponse, err := s.Client.GetWebAppFirewallPolicy(context.Background(), policyRequest)
+	if err != nil {
+		return err
+	}
+
+	s.Policy = &policyResponse.WebAppFirewallPolicy
+	return nil
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) Update() error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+	oldPolicyId := s.Res.WebAppFirewallPolicyId
+
+	request := oci_waf.UpdateWebAppFirewallRequest{}
+
+	if s.D.HasChange("block_response_body") || s.D.HasChange("block_response_code") ||
+		s.D.HasChange("is_body_inspection_enabled") || s.D.HasChange("protection_capabilities") {
+		policyId, err := s.createPolicy()
+		if err != nil {
+			return err
+		}
+		request.WebAppFirewallPolicyId = policyId
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok && s.D.HasChange("defined_tags") {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok && s.D.HasChange("display_name") {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok && s.D.HasChange("freeform_tags") {
+		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	tmp := s.D.Id()
+	request.WebAppFirewallId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+
+	response, err := s.Client.UpdateWebAppFirewall(context.Background(), request)
+	if err == nil {
+		_, err = webAppFirewallWaitForWorkRequest(response.OpcWorkRequestId, "webAppFirewall",
+			oci_waf.WorkRequestResourceActionTypeUpdated, s.D.Timeout(schema.TimeoutUpdate), s.DisableNotFoundRetries, s.Client)
+	}
+	if err != nil {
+		// The firewall still enforces the previous policy, discard the one created for this update
+		if request.WebAppFirewallPolicyId != nil {
+			s.deletePolicy(request.WebAppFirewallPolicyId)
+		}
+		return err
+	}
+
+	if request.WebAppFirewallPolicyId != nil {
+		// The previous policy of an imported firewall was not created by this resource and may be shared, keep it
+		isPolicyManaged := s.isPolicyManaged()
+		s.D.Set("is_policy_managed", true)
+
+		if isPolicyManaged && oldPolicyId != nil {
+			if err := s.deletePolicy(oldPolicyId); err != nil {
+				return fmt.Errorf("the load balancer is protected by web app firewall policy %s, but the previous policy %s could not be deleted: %v", *request.WebAppFirewallPolicyId, *oldPolicyId, err)
+			}
+		}
+	}
+
+	return s.Get()
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) Delete() error {
+	if err := s.Get(); err != nil {
+		return err
+	}
+	policyId := s.Res.WebAppFirewallPolicyId
+
+	request := oci_waf.DeleteWebAppFirewallRequest{}
+
+	tmp := s.D.Id()
+	request.WebAppFirewallId = &tmp
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+
+	response, err := s.Client.DeleteWebAppFirewall(context.Background(), request)
+	if err != nil {
+		return err
+	}
+
+	// Wait until it finishes
+	if _, err := webAppFirewallWaitForWorkRequest(response.OpcWorkRequestId, "webAppFirewall",
+		oci_waf.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), s.DisableNotFoundRetries, s.Client); err != nil {
+		return err
+	}
+
+	if policyId == nil || !s.isPolicyManaged() {
+		return nil
+	}
+	return s.deletePolicy(policyId)
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) SetData() error {
+	if s.Res == nil {
+		return nil
+	}
+
+	if s.Res.CompartmentId != nil {
+		s.D.Set("compartment_id", *s.Res.CompartmentId)
+	}
+
+	if s.Res.DefinedTags != nil {
+		s.D.Set("defined_tags", tfresource.DefinedTagsToMap(s.Res.DefinedTags))
+	}
+
+	if s.Res.DisplayName != nil {
+		s.D.Set("display_name", *s.Res.DisplayName)
+	}
+
+	s.D.Set("freeform_tags", s.Res.FreeformTags)
+
+	if s.Res.LoadBalancerId != nil {
+		s.D.Set("load_balancer_id", *s.Res.LoadBalancerId)
+	}
+
+	s.D.Set("state", s.Res.LifecycleState)
+
+	if s.Res.TimeCreated != nil {
+		s.D.Set("time_created", s.Res.TimeCreated.String())
+	}
+
+	if s.Res.WebAppFirewallPolicyId != nil {
+		s.D.Set("web_app_firewall_policy_id", *s.Res.WebAppFirewallPolicyId)
+	}
+
+	if s.Policy == nil {
+		return nil
+	}
+
+	for _, action := range s.Policy.Actions {
+		if v, ok := action.(oci_waf.ReturnHttpResponseAction); ok && v.Name != nil && *v.Name == wafLoadBalancerAttachmentBlockActionName {
+			if v.Code != nil {
+				s.D.Set("block_response_code", *v.Code)
+			}
+			if body, ok := v.Body.(oci_waf.StaticTextHttpResponseBody); ok && body.Text != nil {
+				s.D.Set("block_response_body", *body.Text)
+			}
+		}
+	}
+
+	protectionCapabilities := []interface{}{}
+	if s.Policy.RequestProtection != nil {
+		for _, rule := range s.Policy.RequestProtection.Rules {
+			if rule.Name == nil || *rule.Name != wafLoadBalancerAttachmentProtectionRuleName {
+				continue
+			}
+			for _, capability := range rule.ProtectionCapabilities {
+				result := map[string]interface{}{}
+				if capability.Key != nil {
+					result["key"] = *capability.Key
+				}
+				if capability.Version != nil {
+					result["version"] = *capability.Version
+				}
+				protectionCapabilities = append(protectionCapabilities, result)
+			}
+			if rule.IsBodyInspectionEnabled != nil {
+				s.D.Set("is_body_inspection_enabled", *rule.IsBodyInspectionEnabled)
+			}
+		}
+	}
+	s.D.Set("protection_capabilities", protectionCapabilities)
+
+	return nil
+}
+
+// createPolicy creates the policy enforced by the firewall from the protection settings of the configuration and
+// waits for it to become active.
+func (s *WafLoadBalancerAttachmentResourceCrud) createPolicy() (*string, error) {
+	request := oci_waf.CreateWebAppFirewallPolicyRequest{}
+
+	if compartmentId, ok := s.D.GetOkExists("compartment_id"); ok {
+		tmp := compartmentId.(string)
+		request.CompartmentId = &tmp
+	}
+
+	if definedTags, ok := s.D.GetOkExists("defined_tags"); ok {
+		convertedDefinedTags, err := tfresource.MapToDefinedTags(definedTags.(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		request.DefinedTags = convertedDefinedTags
+	}
+
+	if displayName, ok := s.D.GetOkExists("display_name"); ok {
+		tmp := displayName.(string)
+		request.DisplayName = &tmp
+	}
+
+	if freeformTags, ok := s.D.GetOkExists("freeform_tags"); ok {
+		request.FreeformTags = tfresource.ObjectMapToStringMap(freeformTags.(map[string]interface{}))
+	}
+
+	blockAction := oci_waf.ReturnHttpResponseAction{}
+	blockActionName := wafLoadBalancerAttachmentBlockActionName
+	blockAction.Name = &blockActionName
+	if code, ok := s.D.GetOkExists("block_response_code"); ok {
+		tmp := code.(int)
+		blockAction.Code = &tmp
+	}
+	if body, ok := s.D.GetOkExists("block_response_body"); ok && body.(string) != "" {
+		tmp := body.(string)
+		blockAction.Body = oci_waf.StaticTextHttpResponseBody{Text: &tmp}
+	}
+	request.Actions = []oci_waf.Action{blockAction}
+
+	if interfaces, ok := s.D.GetOkExists("protection_capabilities"); ok && len(interfaces.([]interface{})) > 0 {
+		capabilities := []oci_waf.ProtectionCapability{}
+		for i := range interfaces.([]interface{}) {
+			fieldKeyFormat := fmt.Sprintf("%s.%d.%%s", "protection_capabilities", i)
+			capability := oci_waf.ProtectionCapability{}
+			if key, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "key")); ok {
+				tmp := key.(string)
+				capability.Key = &tmp
+			}
+			if version, ok := s.D.GetOkExists(fmt.Sprintf(fieldKeyFormat, "version")); ok {
+				tmp := version.(int)
+				capability.Version = &tmp
+			}
+			capabilities = append(capabilities, capability)
+		}
+
+		ruleName := wafLoadBalancerAttachmentProtectionRuleName
+		rule := oci_waf.ProtectionRule{
+			Name:                   &ruleName,
+			ActionName:             &blockActionName,
+			ProtectionCapabilities: capabilities,
+		}
+		if isBodyInspectionEnabled, ok := s.D.GetOkExists("is_body_inspection_enabled"); ok {
+			tmp := isBodyInspectionEnabled.(bool)
+			rule.IsBodyInspectionEnabled = &tmp
+		}
+		request.RequestProtection = &oci_waf.RequestProtection{Rules: []oci_waf.ProtectionRule{rule}}
+	}
+
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(s.DisableNotFoundRetries, "waf")
+
+	response, err := s.Client.CreateWebAppFirewallPolicy(context.Background(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	policyId, err := webAppFirewallPolicyWaitForWorkRequest(response.OpcWorkRequestId, "webAppFirewallPolicy",
+		oci_waf.WorkRequestResourceActionTypeCreated, s.D.Timeout(schema.TimeoutCreate), s.DisableNotFoundRetries, s.Client)
+	if err != nil {
+		if response.Id != nil {
+			s.deletePolicy(response.Id)
+		}
+		return nil, err
+	}
+
+	return policyId, nil
+}
+
+// isPolicyManaged returns whether the policy enforced by the firewall was created by this resource. It is false for
+// imported firewalls until the protection settings are changed.
+func (s *WafLoadBalancerAttachmentResourceCrud) isPolicyManaged() bool {
+	isPolicyManaged, ok := s.D.GetOkExists("is_policy_managed")
+	return ok && isPolicyManaged.(bool)
+}
+
+func (s *WafLoadBalancerAttachmentResourceCrud) deletePolicy(policyId *string) error {
+	request := oci_waf.DeleteWebAppFirewallPolicyRequest{}
+	request.WebAppFirewallPolicyId = policyId
+	request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waf")
+
+	response, err := s.Client.DeleteWebAppFirewallPolicy(context.Background(), request)
+	if err != nil {
+		log.Printf("[WARN] unable to delete web app firewall policy %s: %v", *policyId, err)
+		return err
+	}
+
+	_, err = webAppFirewallPolicyWaitForWorkRequest(response.OpcWorkRequestId, "webAppFirewallPolicy",
+		oci_waf.WorkRequestResourceActionTypeDeleted, s.D.Timeout(schema.TimeoutDelete), true, s.Client)
+	return err
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+// RegisterResourceAlias registers a resource under an additional name, e.g. a legacy name kept so that existing
+// configurations and states keep working. The schema must be a new instance of the aliased resource.
+func RegisterResourceAlias(alias string, name string, resourceSchema *schema.Resource) {
+	if globalvar.OciResourceAliases == nil {
+		globalvar.OciResourceAliases = make(map[string]string)
+	}
+	globalvar.OciResourceAliases[alias] = name
+	RegisterResource(alias, resourceSchema)
+}
+
+// RegisterDeprecatedResourceAlias registers a resource under a legacy name and warns users to move to the new name
+func RegisterDeprecatedResourceAlias(alias string, name string, resourceSchema *schema.Resource) {
+	if resourceSchema != nil {
+		resourceSchema.DeprecationMessage = ResourceDeprecatedForAnother(alias, name)
+	}
+	RegisterResourceAlias(alias, name, resourceSchema)
+}
+
+// RegisterDatasourceAlias registers a datasource under an additional name
+func RegisterDatasourceAlias(alias string, name string, datasourceSchema *schema.Resource) {
+	if globalvar.OciDatasourceAliases == nil {
+		globalvar.OciDatasourceAliases = make(map[string]string)
+	}
+	globalvar.OciDatasourceAliases[alias] = name
+	RegisterDatasource(alias, datasourceSchema)
+}
+
+// RegisterDeprecatedDatasourceAlias registers a datasource under a legacy name and warns users to move to the new name
+func RegisterDeprecatedDatasourceAlias(alias string, name string, datasourceSchema *schema.Resource) {
+	if datasourceSchema != nil {
+		datasourceSchema.DeprecationMessage = DatasourceDeprecatedForAnother(alias, name)
+	}
+	RegisterDatasourceAlias(alias, name, datasourceSchema)
+}
+
+// ResolveResourceAlias returns the name of the resource an alias stands for, or the name itself if it is not an alias
+func ResolveResourceAlias(name string) string {
+	if resourceName, ok := globalvar.OciResourceAliases[name]; ok {
+		return resourceName
+	}
+	return name
+}
+
+// ResolveDatasourceAlias returns the name of the datasource an alias stands for, or the name itself if it is not an alias
+func ResolveDatasourceAlias(name string) string {
+	if datasourceName, ok := globalvar.OciDatasourceAliases[name]; ok {
+		return datasourceName
+	}
+	return name
+}
@@ -0,0 +1,28 @@
+package tfresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oracle/terraform-provider-oci/internal/globalvar"
+)
+
+func TestUnitRegisterResourceAlias(t *testing.T) {
+	t.Cleanup(func() {
+		delete(globalvar.OciResourceAliases, "oci_test_legacy_name")
+		delete(globalvar.OciResources, "oci_test_legacy_name")
+	})
+	resourceSchema := &schema.Resource{}
+	RegisterDeprecatedResourceAlias("oci_test_legacy_name", "oci_test_name", resourceSchema)
+
+	if resourceSchema.DeprecationMessage == "" {
+		t.Errorf("RegisterDeprecatedResourceAlias() did not set a deprecation message")
+	}
+
+	if got := ResolveResourceAlias("oci_test_legacy_name"); got != "oci_test_name" {
+		t.Errorf("ResolveResourceAlias() = %v, want oci_test_name", got)
+	}
+	if got := ResolveResourceAlias("oci_test_name"); got != "oci_test_name" {
+		t.Errorf("ResolveResourceAlias() = %v, want oci_test_name", got)
+	}
+}
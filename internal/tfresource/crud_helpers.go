@@ -1325,6 +1325,21 @@ func ValidateInt64TypeString(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// ValidateInt64TypeStringInRange validates that a 64-bit integer set as a string is between min and max, inclusive.
+func ValidateInt64TypeStringInRange(min int64, max int64) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, err := strconv.ParseInt(v.(string), 10, 64)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q (%q) must be a 64-bit integer", k, v))
+			return
+		}
+		if value < min || value > max {
+			errors = append(errors, fmt.Errorf("expected %s to be in the range (%d - %d), got %d", k, min, max, value))
+		}
+		return
+	}
+}
+
 // Set the state for the input source file using the file path and last modification time
 // this information helps us to identify if the file has changed.
 func GetSourceFileState(source interface{}) string {
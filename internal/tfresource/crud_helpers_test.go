@@ -2423,6 +2423,47 @@ func TestUnitValidateInt64TypeString(t *testing.T) {
 	}
 }
 
+func TestUnitValidateInt64TypeStringInRange(t *testing.T) {
+
+	type args struct {
+		v interface{}
+		k string
+	}
+	type testFormat struct {
+		name   string
+		args   args
+		errors int
+	}
+	tests := []testFormat{
+		{
+			name:   "Test value in range",
+			args:   args{k: "idle_timeout_in_seconds", v: "300"},
+			errors: 0,
+		},
+		{
+			name:   "Test value equal to the bounds",
+			args:   args{k: "idle_timeout_in_seconds", v: "7200"},
+			errors: 0,
+		},
+		{
+			name:   "Test value out of range",
+			args:   args{k: "idle_timeout_in_seconds", v: "0"},
+			errors: 1,
+		},
+		{
+			name:   "Test non int value",
+			args:   args{k: "idle_timeout_in_seconds", v: "test"},
+			errors: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running %s", test.name)
+		if _, errs := ValidateInt64TypeStringInRange(1, 7200)(test.args.v, test.args.k); len(errs) != test.errors {
+			t.Errorf("Output errors - %v which is not equal to expected count - %d", errs, test.errors)
+		}
+	}
+}
+
 func TestUnitValidateBoolInSlice(t *testing.T) {
 
 	type args struct {
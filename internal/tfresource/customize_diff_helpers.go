@@ -0,0 +1,261 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DiffCondition decides whether a cross attribute rule applies to the planned values of a resource. Conditions
+// evaluate to false while the values they depend on are unknown, so rules are only enforced once they can be decided.
+type DiffCondition func(d *schema.ResourceDiff) bool
+
+// AttributeIn is true when the planned value of the attribute is one of the given values, ignoring case
+func AttributeIn(key string, values ...string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		value, ok := knownStringValue(d, key)
+		if !ok {
+			return false
+		}
+		for _, v := range values {
+			if strings.EqualFold(value, v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AttributeNotIn is true when the attribute has a planned value that is none of the given values, ignoring case
+func AttributeNotIn(key string, values ...string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		if _, ok := knownStringValue(d, key); !ok {
+			return false
+		}
+		return !AttributeIn(key, values...)(d)
+	}
+}
+
+// AttributeContains is true when the planned value of the attribute contains the substring, ignoring case
+func AttributeContains(key string, substring string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		value, ok := knownStringValue(d, key)
+		return ok && strings.Contains(strings.ToLower(value), strings.ToLower(substring))
+	}
+}
+
+// AttributesEqual is true when both attributes have the same planned value, ignoring case
+func AttributesEqual(key string, otherKey string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		value, ok := knownStringValue(d, key)
+		if !ok {
+			return false
+		}
+		otherValue, ok := knownStringValue(d, otherKey)
+		return ok && strings.EqualFold(value, otherValue)
+	}
+}
+
+// AttributeIsTrue is true when the attribute is set to true in the config
+func AttributeIsTrue(key string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		value, ok := configValue(d, key)
+		return ok && value.IsKnown() && !value.IsNull() && value.Type().Equals(cty.Bool) && value.True()
+	}
+}
+
+// AttributeIsFalse is true when the attribute is set to false in the config
+func AttributeIsFalse(key string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		value, ok := configValue(d, key)
+		return ok && value.IsKnown() && !value.IsNull() && value.Type().Equals(cty.Bool) && value.False()
+	}
+}
+
+// AttributeIsConfigured is true when the attribute is set in the config
+func AttributeIsConfigured(key string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		return IsAttributeConfigured(d, key)
+	}
+}
+
+// AttributeDecreased is true when an existing resource is planned with a lower value of the integer attribute
+func AttributeDecreased(key string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		if d.Id() == "" || !d.NewValueKnown(key) {
+			return false
+		}
+		oldValue, newValue := d.GetChange(key)
+		oldCount, ok := oldValue.(int)
+		if !ok {
+			return false
+		}
+		newCount, ok := newValue.(int)
+		return ok && newCount < oldCount
+	}
+}
+
+// AttributeCountChanged is true when an existing resource is planned with a different number of elements in the list
+// attribute
+func AttributeCountChanged(key string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		if d.Id() == "" || !d.NewValueKnown(key) {
+			return false
+		}
+		oldValue, newValue := d.GetChange(key)
+		oldList, ok := oldValue.([]interface{})
+		if !ok {
+			return false
+		}
+		newList, ok := newValue.([]interface{})
+		return ok && len(newList) != len(oldList)
+	}
+}
+
+// AttributeChanged is true when an existing resource is planned with a different value of the attribute
+func AttributeChanged(key string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		return d.Id() != "" && d.HasChange(key)
+	}
+}
+
+// IsCreate is true when the resource is being created
+func IsCreate() DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		return d.Id() == ""
+	}
+}
+
+// IsCreateOrChange is true when the resource is being created or any of the attributes is being updated
+func IsCreateOrChange(keys ...string) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		if d.Id() == "" {
+			return true
+		}
+		for _, key := range keys {
+			if d.HasChange(key) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AllOf is true when every condition is true
+func AllOf(conditions ...DiffCondition) DiffCondition {
+	return func(d *schema.ResourceDiff) bool {
+		for _, condition := range conditions {
+			if !condition(d) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// RequiredWhen fails the plan if the condition holds and none of the attributes are set in the config
+func RequiredWhen(condition DiffCondition, reason string, keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if !condition(d) {
+			return nil
+		}
+		for _, key := range keys {
+			if IsAttributeConfigured(d, key) {
+				return nil
+			}
+		}
+		if len(keys) == 1 {
+			return fmt.Errorf("%s is required when %s", keys[0], reason)
+		}
+		return fmt.Errorf("one of %s is required when %s", strings.Join(keys, ", "), reason)
+	}
+}
+
+// ConflictsWhen fails the plan if the condition holds and any of the attributes is set in the config
+func ConflictsWhen(condition DiffCondition, reason string, keys ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if !condition(d) {
+			return nil
+		}
+		for _, key := range keys {
+			if IsAttributeConfigured(d, key) {
+				return fmt.Errorf("%s cannot be set when %s", key, reason)
+			}
+		}
+		return nil
+	}
+}
+
+// ErrorWhen fails the plan with the message if the condition holds
+func ErrorWhen(condition DiffCondition, message string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if condition(d) {
+			return fmt.Errorf("%s", message)
+		}
+		return nil
+	}
+}
+
+// IsAttributeConfigured returns true if the attribute is set in the config, nested attributes are addressed the same
+// way as with ResourceDiff.Get (e.g. "shape_config.0.ocpus"). Values that are not known yet count as configured.
+func IsAttributeConfigured(d *schema.ResourceDiff, key string) bool {
+	value, ok := configValue(d, key)
+	if !ok {
+		return false
+	}
+	return !value.IsKnown() || !value.IsNull()
+}
+
+func knownStringValue(d *schema.ResourceDiff, key string) (string, bool) {
+	if !d.NewValueKnown(key) {
+		return "", false
+	}
+	value, ok := d.Get(key).(string)
+	if !ok || value == "" {
+		return "", false
+	}
+	return value, true
+}
+
+// configValue walks the raw config along the attribute path, the second return value is false if the path does not
+// exist in the config
+func configValue(d *schema.ResourceDiff, key string) (cty.Value, bool) {
+	value := d.GetRawConfig()
+	for _, part := range strings.Split(key, ".") {
+		if !value.IsKnown() {
+			return value, true
+		}
+		if value.IsNull() {
+			return value, false
+		}
+		valueType := value.Type()
+		switch {
+		case valueType.IsObjectType():
+			if !valueType.HasAttribute(part) {
+				return cty.NilVal, false
+			}
+			value = value.GetAttr(part)
+		case valueType.IsListType() || valueType.IsTupleType() || valueType.IsSetType():
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= value.LengthInt() {
+				return cty.NilVal, false
+			}
+			value = value.AsValueSlice()[index]
+		case valueType.IsMapType():
+			if !value.HasIndex(cty.StringVal(part)).True() {
+				return cty.NilVal, false
+			}
+			value = value.Index(cty.StringVal(part))
+		default:
+			return cty.NilVal, false
+		}
+	}
+	return value, true
+}
@@ -0,0 +1,243 @@
+package tfresource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitCrossAttributeCustomizeDiff(t *testing.T) {
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"shape": {Type: schema.TypeString, Optional: true},
+			"shape_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ocpus": {Type: schema.TypeFloat, Optional: true},
+					},
+				},
+			},
+			"use_latest": {Type: schema.TypeBool, Optional: true},
+			"timestamp":  {Type: schema.TypeString, Optional: true},
+		},
+		CustomizeDiff: customdiff.All(
+			RequiredWhen(AttributeContains("shape", ".Flex"), "a flexible shape is used", "shape_config.0"),
+			ConflictsWhen(AttributeIsTrue("use_latest"), "use_latest is true", "timestamp"),
+		),
+	}
+	shapeConfigType := cty.List(cty.Object(map[string]cty.Type{"ocpus": cty.Number}))
+
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		config  cty.Value
+		wantErr bool
+	}{
+		{
+			name: "Test flex shape with shape config",
+			raw:  map[string]interface{}{"shape": "VM.Standard.E4.Flex", "shape_config": []interface{}{map[string]interface{}{"ocpus": 1}}},
+			config: cty.ObjectVal(map[string]cty.Value{
+				"shape":        cty.StringVal("VM.Standard.E4.Flex"),
+				"shape_config": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"ocpus": cty.NumberIntVal(1)})}),
+				"use_latest":   cty.NullVal(cty.Bool),
+				"timestamp":    cty.NullVal(cty.String),
+			}),
+			wantErr: false,
+		},
+		{
+			name: "Test flex shape with empty shape config",
+			raw:  map[string]interface{}{"shape": "VM.Standard.E4.Flex", "shape_config": []interface{}{map[string]interface{}{}}},
+			config: cty.ObjectVal(map[string]cty.Value{
+				"shape":        cty.StringVal("VM.Standard.E4.Flex"),
+				"shape_config": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"ocpus": cty.NullVal(cty.Number)})}),
+				"use_latest":   cty.NullVal(cty.Bool),
+				"timestamp":    cty.NullVal(cty.String),
+			}),
+			wantErr: false,
+		},
+		{
+			name: "Test flex shape without shape config",
+			raw:  map[string]interface{}{"shape": "VM.Standard.E4.Flex"},
+			config: cty.ObjectVal(map[string]cty.Value{
+				"shape":        cty.StringVal("VM.Standard.E4.Flex"),
+				"shape_config": cty.NullVal(shapeConfigType),
+				"use_latest":   cty.NullVal(cty.Bool),
+				"timestamp":    cty.NullVal(cty.String),
+			}),
+			wantErr: true,
+		},
+		{
+			name: "Test conflicting attributes",
+			raw:  map[string]interface{}{"shape": "VM.Standard2.1", "use_latest": true, "timestamp": "2024-01-01T00:00:00Z"},
+			config: cty.ObjectVal(map[string]cty.Value{
+				"shape":        cty.StringVal("VM.Standard2.1"),
+				"shape_config": cty.NullVal(shapeConfigType),
+				"use_latest":   cty.True,
+				"timestamp":    cty.StringVal("2024-01-01T00:00:00Z"),
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{RawConfig: tt.config}
+			_, err := testResource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.raw), nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnitAttributeDecreasedCustomizeDiff(t *testing.T) {
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"storage_count": {Type: schema.TypeInt, Optional: true, Computed: true},
+		},
+		CustomizeDiff: ErrorWhen(AttributeDecreased("storage_count"), "storage_count can only be increased"),
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		count   int
+		wantErr bool
+	}{
+		{name: "Test create with a lower count", id: "", count: 1, wantErr: false},
+		{name: "Test increase of the count", id: "ocid1.test", count: 4, wantErr: false},
+		{name: "Test decrease of the count", id: "ocid1.test", count: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"storage_count": cty.NumberIntVal(int64(tt.count))}),
+			}
+			if tt.id != "" {
+				state.ID = tt.id
+				state.Attributes = map[string]string{"id": tt.id, "storage_count": "3"}
+			}
+			_, err := testResource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"storage_count": tt.count}), nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnitAttributeChangedCustomizeDiff(t *testing.T) {
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"trigger": {Type: schema.TypeInt, Optional: true},
+		},
+		CustomizeDiff: customdiff.All(
+			ConflictsWhen(IsCreate(), "the resource is created", "trigger"),
+			ErrorWhen(AttributeChanged("trigger"), "trigger cannot be changed"),
+		),
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		trigger int
+		wantErr bool
+	}{
+		{name: "Test create with a trigger", id: "", trigger: 1, wantErr: true},
+		{name: "Test update without a change", id: "ocid1.test", trigger: 1, wantErr: false},
+		{name: "Test update of the trigger", id: "ocid1.test", trigger: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"trigger": cty.NumberIntVal(int64(tt.trigger))}),
+			}
+			if tt.id != "" {
+				state.ID = tt.id
+				state.Attributes = map[string]string{"id": tt.id, "trigger": "1"}
+			}
+			_, err := testResource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"trigger": tt.trigger}), nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnitAttributeCountChangedCustomizeDiff(t *testing.T) {
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"pools": {Type: schema.TypeList, Required: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+		CustomizeDiff: ErrorWhen(AttributeCountChanged("pools"), "pools cannot be added or removed"),
+	}
+
+	tests := []struct {
+		name    string
+		id      string
+		pools   []string
+		wantErr bool
+	}{
+		{name: "Test create", id: "", pools: []string{"a", "b"}, wantErr: false},
+		{name: "Test update of a pool", id: "ocid1.test", pools: []string{"c"}, wantErr: false},
+		{name: "Test added pool", id: "ocid1.test", pools: []string{"a", "b"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPools := make([]cty.Value, len(tt.pools))
+			rawPools := make([]interface{}, len(tt.pools))
+			for i, pool := range tt.pools {
+				configPools[i] = cty.StringVal(pool)
+				rawPools[i] = pool
+			}
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"pools": cty.ListVal(configPools)}),
+			}
+			if tt.id != "" {
+				state.ID = tt.id
+				state.Attributes = map[string]string{"id": tt.id, "pools.#": "1", "pools.0": "a"}
+			}
+			_, err := testResource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"pools": rawPools}), nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnitAttributesEqualCustomizeDiff(t *testing.T) {
+	testResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"environment_id_a": {Type: schema.TypeString, Optional: true},
+			"environment_id_b": {Type: schema.TypeString, Optional: true},
+		},
+		CustomizeDiff: ErrorWhen(AttributesEqual("environment_id_a", "environment_id_b"), "environment_id_a and environment_id_b must be different"),
+	}
+
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		wantErr bool
+	}{
+		{name: "Test different environments", a: "ocid1.environment.a", b: "ocid1.environment.b", wantErr: false},
+		{name: "Test same environment", a: "ocid1.environment.a", b: "ocid1.environment.a", wantErr: true},
+		{name: "Test single environment", a: "ocid1.environment.a", b: "", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				RawConfig: cty.ObjectVal(map[string]cty.Value{"environment_id_a": cty.StringVal(tt.a), "environment_id_b": cty.StringVal(tt.b)}),
+			}
+			_, err := testResource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{"environment_id_a": tt.a, "environment_id_b": tt.b}), nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Diff() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
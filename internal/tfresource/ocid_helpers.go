@@ -0,0 +1,54 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ocid1.<RESOURCE TYPE>.<REALM>.[REGION][.FUTURE USE].<UNIQUE ID>
+var ocidRegex = regexp.MustCompile(`^ocid1\.([a-z0-9]+)\.[a-z0-9]+\.[a-z0-9-]*(\.[a-z0-9-]+)*\.[a-zA-Z0-9_-]+$`)
+
+// IsOcid returns true if the value is a syntactically valid OCID of one of the given resource types. Any resource
+// type is accepted when none is given.
+func IsOcid(value string, resourceTypes ...string) bool {
+	matches := ocidRegex.FindStringSubmatch(value)
+	if matches == nil {
+		return false
+	}
+	if len(resourceTypes) == 0 {
+		return true
+	}
+	for _, resourceType := range resourceTypes {
+		if strings.EqualFold(matches[1], resourceType) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateOcid checks the OCID syntax and, if any are given, that the OCID belongs to one of the resource types. It is
+// set per attribute since the accepted types depend on the resource, e.g. a volume attachment takes boot volumes too.
+func ValidateOcid(resourceTypes ...string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+		if v == "" || IsOcid(v, resourceTypes...) {
+			return
+		}
+		if len(resourceTypes) == 0 || !IsOcid(v) {
+			es = append(es, fmt.Errorf("%s: %q is not a valid OCID, expected format ocid1.<resource type>.<realm>.[region].<unique id>", k, v))
+			return
+		}
+		es = append(es, fmt.Errorf("%s: %q is not an OCID of the expected resource type, expected one of: ocid1.%s.", k, v, strings.Join(resourceTypes, ".*, ocid1.")))
+		return
+	}
+}
@@ -0,0 +1,56 @@
+package tfresource
+
+import (
+	"testing"
+)
+
+func TestUnitValidateOcid(t *testing.T) {
+	type args struct {
+		value         string
+		resourceTypes []string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name:    "Test regional OCID of expected type",
+			args:    args{value: "ocid1.vcn.oc1.phx.amaaaaaaexample", resourceTypes: []string{"vcn"}},
+			wantErr: false,
+		},
+		{
+			name:    "Test global OCID of expected type",
+			args:    args{value: "ocid1.compartment.oc1..aaaaaaaaexample", resourceTypes: []string{"compartment", "tenancy"}},
+			wantErr: false,
+		},
+		{
+			name:    "Test tenancy OCID as a compartment",
+			args:    args{value: "ocid1.tenancy.oc1..aaaaaaaaexample", resourceTypes: []string{"compartment", "tenancy"}},
+			wantErr: false,
+		},
+		{
+			name:    "Test OCID of unexpected type",
+			args:    args{value: "ocid1.subnet.oc1.phx.amaaaaaaexample", resourceTypes: []string{"vcn"}},
+			wantErr: true,
+		},
+		{
+			name:    "Test malformed OCID",
+			args:    args{value: "ocid1.vcn.oc1", resourceTypes: []string{"vcn"}},
+			wantErr: true,
+		},
+		{
+			name:    "Test empty value",
+			args:    args{value: "", resourceTypes: []string{"vcn"}},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := ValidateOcid(tt.args.resourceTypes...)(tt.args.value, "vcn_id")
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("ValidateOcid() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
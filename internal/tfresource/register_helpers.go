@@ -1,6 +1,7 @@
 package tfresource
 
 import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/terraform-provider-oci/internal/globalvar"
 )
@@ -9,6 +10,8 @@ func RegisterResource(name string, resourceSchema *schema.Resource) {
 	if globalvar.OciResources == nil {
 		globalvar.OciResources = make(map[string]*schema.Resource)
 	}
+	addDefinedTagsCustomizeDiff(resourceSchema)
+	applyTimeoutOverrides(name, resourceSchema)
 	globalvar.OciResources[name] = resourceSchema
 }
 
@@ -18,3 +21,20 @@ func RegisterDatasource(name string, datasourceSchema *schema.Resource) {
 	}
 	globalvar.OciDatasources[name] = datasourceSchema
 }
+
+// addDefinedTagsCustomizeDiff plans the top level defined_tags of resources through DefinedTagsCustomizeDiff, which
+// only takes effect once the provider opts in to ignored tags or computed tag namespaces
+func addDefinedTagsCustomizeDiff(resourceSchema *schema.Resource) {
+	if resourceSchema == nil {
+		return
+	}
+	definedTags, ok := resourceSchema.Schema["defined_tags"]
+	if !ok || definedTags.Type != schema.TypeMap || !definedTags.Optional || !definedTags.Computed {
+		return
+	}
+	if resourceSchema.CustomizeDiff == nil {
+		resourceSchema.CustomizeDiff = DefinedTagsCustomizeDiff
+		return
+	}
+	resourceSchema.CustomizeDiff = customdiff.All(resourceSchema.CustomizeDiff, DefinedTagsCustomizeDiff)
+}
@@ -510,3 +510,21 @@ func GetDbHomeRetryDurationFunction(retryTimeout time.Duration) expectedRetryDur
 		return defaultRetryTime
 	}
 }
+
+// GetConflictRetryDurationFunction returns a retry duration override that keeps retrying conflicts (409) for the given
+// timeout, e.g. while the resource is busy with a work request started by another resource of the same apply.
+func GetConflictRetryDurationFunction(retryTimeout time.Duration) expectedRetryDurationFn {
+	return func(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+		defaultRetryTime := GetDefaultExpectedRetryDuration(response, disableNotFoundRetries)
+		if response.Response == nil || response.Response.HTTPResponse() == nil || response.Response.HTTPResponse().StatusCode != 409 {
+			return defaultRetryTime
+		}
+		if isDisable409Retry, _ := strconv.ParseBool(utils.GetEnvSettingWithDefault("disable_409_retry", "false")); isDisable409Retry {
+			return defaultRetryTime
+		}
+		if e := response.Error; e != nil && strings.Contains(e.Error(), "InvalidatedRetryToken") {
+			return 0
+		}
+		return retryTimeout
+	}
+}
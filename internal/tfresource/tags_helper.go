@@ -4,6 +4,7 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -13,6 +14,11 @@ import (
 
 var DefinedTagsToSuppress []string
 
+// ComputedDefinedTagNamespaces lists the tag namespaces whose values are populated by the service (e.g. through tag
+// defaults) rather than by the user, as set in the provider's `computed_defined_tag_namespaces`. Tags from these
+// namespaces that only exist in state are treated as computed. No namespace is treated as computed by default.
+var ComputedDefinedTagNamespaces []string
+
 func DefinedTagsToMap(definedTags map[string]map[string]interface{}) map[string]interface{} {
 	var tags = make(map[string]interface{})
 	if len(definedTags) > 0 {
@@ -43,16 +49,11 @@ func MapToDefinedTags(rawMap map[string]interface{}) (map[string]map[string]inte
 	return definedTags, nil
 }
 
+// DefinedTagsDiffSuppressFunction compares a single defined tag element of the plan against state. Only differences
+// in the casing of the namespace/key, tags listed in `ignore_defined_tags` and service computed tags missing from
+// the config are suppressed, so that real value changes and removals are always planned.
 func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *schema.ResourceData) bool {
 	keyParts := strings.Split(key, ".")
-	for _, tags := range DefinedTagsToSuppress {
-		if strings.EqualFold(strings.Join(keyParts[1:], "."), tags) {
-			return true
-		}
-	}
-	if old != "" && new != "" {
-		return false
-	}
 
 	// Find the specific defined_tag key name (mainly if a resource supports tagging at multiple levels)
 	// For example: "create_vnic_details.0.defined_tags.mynamespace.mykey" => "create_vnic_details.0.defined_tags"
@@ -63,6 +64,17 @@ func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 			break
 		}
 	}
+	tagKey := strings.Join(keyParts[len(definedTagKeyParts):], ".")
+
+	if isIgnoredDefinedTag(tagKey) {
+		return true
+	}
+	if old != "" && new != "" {
+		return false
+	}
+	if d == nil {
+		return false
+	}
 
 	//Old value comes from refreshed state, while new value comes from config
 	oldRaw, newRaw := d.GetChange(strings.Join(definedTagKeyParts, "."))
@@ -76,11 +88,106 @@ func DefinedTagsDiffSuppressFunction(key string, old string, new string, d *sche
 		return false
 	}
 
-	lowerCaseNewValueMap := ToLowerCaseKeyMap(newValue)
-	lowerCaseOldValueMap := ToLowerCaseKeyMap(oldValue)
+	// The element count changes whenever a tag is added or removed; it can only be suppressed if the planned tags
+	// end up identical to the ones in state.
+	if tagKey == "%" {
+		return reflect.DeepEqual(NormalizeDefinedTagsMap(oldValue), NormalizeDefinedTagsMap(PlannedDefinedTags(newValue, oldValue)))
+	}
 
-	if reflect.DeepEqual(lowerCaseOldValueMap, lowerCaseNewValueMap) {
-		return true
+	lowerCaseTagKey := strings.ToLower(tagKey)
+	if old != "" {
+		// Tag only exists in state under this exact name, check whether config still has it with a different casing
+		if value, ok := NormalizeDefinedTagsMap(newValue)[lowerCaseTagKey]; ok {
+			return value == old
+		}
+		return isComputedDefinedTag(tagKey)
+	}
+
+	// Tag only exists in config under this exact name, check whether state already has it with a different casing
+	if value, ok := NormalizeDefinedTagsMap(oldValue)[lowerCaseTagKey]; ok {
+		return value == new
+	}
+	return false
+}
+
+// DefinedTagsCustomizeDiff plans the top level `defined_tags` attribute from the normalized config: namespace and
+// key casing is taken from state, ignored tags and service computed tags are carried over from state, and every
+// other difference is kept so that it shows up in the plan. It does nothing unless the provider configures
+// `ignore_defined_tags` or `computed_defined_tag_namespaces`.
+func DefinedTagsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if len(DefinedTagsToSuppress) == 0 && len(ComputedDefinedTagNamespaces) == 0 {
+		return nil
+	}
+	if diff.Id() == "" || !diff.HasChange("defined_tags") || !diff.NewValueKnown("defined_tags") {
+		return nil
+	}
+
+	oldRaw, newRaw := diff.GetChange("defined_tags")
+	oldValue, oldValueOk := oldRaw.(map[string]interface{})
+	newValue, newValueOk := newRaw.(map[string]interface{})
+	if !oldValueOk || !newValueOk {
+		return nil
+	}
+
+	planned := PlannedDefinedTags(newValue, oldValue)
+	if reflect.DeepEqual(planned, newValue) {
+		return nil
+	}
+	return diff.SetNew("defined_tags", planned)
+}
+
+// PlannedDefinedTags merges the defined tags from config with the ones from state. Keys present in both (ignoring
+// case) keep the casing used in state, while ignored and service computed tags that are only in state are retained.
+func PlannedDefinedTags(config map[string]interface{}, state map[string]interface{}) map[string]interface{} {
+	stateKeys := make(map[string]string, len(state))
+	for key := range state {
+		stateKeys[strings.ToLower(key)] = key
+	}
+
+	planned := make(map[string]interface{}, len(config))
+	configKeys := make(map[string]bool, len(config))
+	for key, value := range config {
+		lowerCaseKey := strings.ToLower(key)
+		configKeys[lowerCaseKey] = true
+		if stateKey, ok := stateKeys[lowerCaseKey]; ok {
+			planned[stateKey] = value
+			continue
+		}
+		planned[key] = value
+	}
+
+	for key, value := range state {
+		if configKeys[strings.ToLower(key)] {
+			continue
+		}
+		if isIgnoredDefinedTag(key) || isComputedDefinedTag(key) {
+			planned[key] = value
+		}
+	}
+	return planned
+}
+
+// NormalizeDefinedTagsMap returns the flattened defined tags keyed by their lower case "namespace.key" name, since
+// tag namespaces and keys are case insensitive.
+func NormalizeDefinedTagsMap(definedTags map[string]interface{}) map[string]interface{} {
+	return ToLowerCaseKeyMap(definedTags)
+}
+
+func isIgnoredDefinedTag(tagKey string) bool {
+	for _, tags := range DefinedTagsToSuppress {
+		if strings.EqualFold(tagKey, tags) {
+			return true
+		}
+	}
+	return false
+}
+
+func isComputedDefinedTag(tagKey string) bool {
+	namespace := strings.SplitN(tagKey, ".", 2)[0]
+	for _, computedNamespace := range ComputedDefinedTagNamespaces {
+		if strings.EqualFold(namespace, computedNamespace) {
+			return true
+		}
 	}
 	return false
 }
@@ -268,3 +268,70 @@ func TestUnitDefinedTagsDiffSuppressFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitPlannedDefinedTags(t *testing.T) {
+	ComputedDefinedTagNamespaces = []string{"Oracle-Tags"}
+	defer func() { ComputedDefinedTagNamespaces = nil }()
+
+	type args struct {
+		config map[string]interface{}
+		state  map[string]interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]interface{}
+	}{
+		{
+			name: "Test casing from state is retained",
+			args: args{
+				config: map[string]interface{}{"mynamespace.mykey": "value"},
+				state:  map[string]interface{}{"MyNamespace.MyKey": "value"},
+			},
+			want: map[string]interface{}{"MyNamespace.MyKey": "value"},
+		},
+		{
+			name: "Test value change is planned",
+			args: args{
+				config: map[string]interface{}{"mynamespace.mykey": "new"},
+				state:  map[string]interface{}{"MyNamespace.MyKey": "old"},
+			},
+			want: map[string]interface{}{"MyNamespace.MyKey": "new"},
+		},
+		{
+			name: "Test removed tag is planned and computed tag is retained",
+			args: args{
+				config: map[string]interface{}{},
+				state:  map[string]interface{}{"MyNamespace.MyKey": "value", "Oracle-Tags.CreatedBy": "user"},
+			},
+			want: map[string]interface{}{"Oracle-Tags.CreatedBy": "user"},
+		},
+		{
+			name: "Test tag from another namespace is not retained",
+			args: args{
+				config: map[string]interface{}{},
+				state:  map[string]interface{}{"Other-Tags.CreatedBy": "user"},
+			},
+			want: map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlannedDefinedTags(tt.args.config, tt.args.state); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlannedDefinedTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitDefinedTagsDiffSuppressFunction_ignoredTags(t *testing.T) {
+	DefinedTagsToSuppress = []string{"MyNamespace.MyKey"}
+	defer func() { DefinedTagsToSuppress = nil }()
+
+	if !DefinedTagsDiffSuppressFunction("defined_tags.mynamespace.mykey", "abc", "", nil) {
+		t.Errorf("DefinedTagsDiffSuppressFunction() = false, want true for ignored tag")
+	}
+	if DefinedTagsDiffSuppressFunction("defined_tags.mynamespace.otherkey", "abc", "def", nil) {
+		t.Errorf("DefinedTagsDiffSuppressFunction() = true, want false for changed tag")
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2017, 2024, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/oracle/terraform-provider-oci/internal/utils"
+)
+
+const (
+	createTimeoutSuffix = "_CREATE_TIMEOUT"
+	updateTimeoutSuffix = "_UPDATE_TIMEOUT"
+	deleteTimeoutSuffix = "_DELETE_TIMEOUT"
+)
+
+// timeoutServices maps the resource name prefix of every service to the name used in its timeout environment variables.
+// Services whose name is a prefix of another service, e.g. database and database_tools, are told apart by the longest match.
+var timeoutServices = map[string]string{
+	"oci_adm_":                           "ADM",
+	"oci_ai_anomaly_detection_":          "AI_ANOMALY_DETECTION",
+	"oci_ai_document_":                   "AI_DOCUMENT",
+	"oci_ai_language_":                   "AI_LANGUAGE",
+	"oci_ai_vision_":                     "AI_VISION",
+	"oci_analytics_":                     "ANALYTICS",
+	"oci_announcements_service_":         "ANNOUNCEMENTS_SERVICE",
+	"oci_apigateway_":                    "APIGATEWAY",
+	"oci_apm_":                           "APM",
+	"oci_apm_config_":                    "APM_CONFIG",
+	"oci_apm_synthetics_":                "APM_SYNTHETICS",
+	"oci_appmgmt_control_":               "APPMGMT_CONTROL",
+	"oci_artifacts_":                     "ARTIFACTS",
+	"oci_audit_":                         "AUDIT",
+	"oci_autoscaling_":                   "AUTOSCALING",
+	"oci_bastion_":                       "BASTION",
+	"oci_bds_":                           "BDS",
+	"oci_blockchain_":                    "BLOCKCHAIN",
+	"oci_budget_":                        "BUDGET",
+	"oci_capacity_management_":           "CAPACITY_MANAGEMENT",
+	"oci_certificates_management_":       "CERTIFICATES_MANAGEMENT",
+	"oci_cloud_bridge_":                  "CLOUD_BRIDGE",
+	"oci_cloud_guard_":                   "CLOUD_GUARD",
+	"oci_cloud_migrations_":              "CLOUD_MIGRATIONS",
+	"oci_cluster_placement_groups_":      "CLUSTER_PLACEMENT_GROUPS",
+	"oci_compute_cloud_at_customer_":     "COMPUTE_CLOUD_AT_CUSTOMER",
+	"oci_container_instances_":           "CONTAINER_INSTANCES",
+	"oci_containerengine_":               "CONTAINERENGINE",
+	"oci_core_":                          "CORE",
+	"oci_data_labeling_service_":         "DATA_LABELING_SERVICE",
+	"oci_data_safe_":                     "DATA_SAFE",
+	"oci_database_":                      "DATABASE",
+	"oci_database_management_":           "DATABASE_MANAGEMENT",
+	"oci_database_migration_":            "DATABASE_MIGRATION",
+	"oci_database_tools_":                "DATABASE_TOOLS",
+	"oci_datacatalog_":                   "DATACATALOG",
+	"oci_dataflow_":                      "DATAFLOW",
+	"oci_dataintegration_":               "DATAINTEGRATION",
+	"oci_datascience_":                   "DATASCIENCE",
+	"oci_delegate_access_control_":       "DELEGATE_ACCESS_CONTROL",
+	"oci_demand_signal_":                 "DEMAND_SIGNAL",
+	"oci_desktops_":                      "DESKTOPS",
+	"oci_devops_":                        "DEVOPS",
+	"oci_disaster_recovery_":             "DISASTER_RECOVERY",
+	"oci_dns_":                           "DNS",
+	"oci_email_":                         "EMAIL",
+	"oci_events_":                        "EVENTS",
+	"oci_file_storage_":                  "FILE_STORAGE",
+	"oci_fleet_apps_management_":         "FLEET_APPS_MANAGEMENT",
+	"oci_fleet_software_update_":         "FLEET_SOFTWARE_UPDATE",
+	"oci_functions_":                     "FUNCTIONS",
+	"oci_fusion_apps_":                   "FUSION_APPS",
+	"oci_generative_ai_":                 "GENERATIVE_AI",
+	"oci_generative_ai_agent_":           "GENERATIVE_AI_AGENT",
+	"oci_generic_artifacts_content_":     "GENERIC_ARTIFACTS_CONTENT",
+	"oci_globally_distributed_database_": "GLOBALLY_DISTRIBUTED_DATABASE",
+	"oci_golden_gate_":                   "GOLDEN_GATE",
+	"oci_health_checks_":                 "HEALTH_CHECKS",
+	"oci_identity_":                      "IDENTITY",
+	"oci_identity_data_plane_":           "IDENTITY_DATA_PLANE",
+	"oci_identity_domains_":              "IDENTITY_DOMAINS",
+	"oci_integration_":                   "INTEGRATION",
+	"oci_jms_":                           "JMS",
+	"oci_jms_java_downloads_":            "JMS_JAVA_DOWNLOADS",
+	"oci_kms_":                           "KMS",
+	"oci_license_manager_":               "LICENSE_MANAGER",
+	"oci_limits_":                        "LIMITS",
+	"oci_load_balancer_":                 "LOAD_BALANCER",
+	"oci_log_analytics_":                 "LOG_ANALYTICS",
+	"oci_logging_":                       "LOGGING",
+	"oci_management_agent_":              "MANAGEMENT_AGENT",
+	"oci_management_dashboard_":          "MANAGEMENT_DASHBOARD",
+	"oci_marketplace_":                   "MARKETPLACE",
+	"oci_media_services_":                "MEDIA_SERVICES",
+	"oci_metering_computation_":          "METERING_COMPUTATION",
+	"oci_monitoring_":                    "MONITORING",
+	"oci_mysql_":                         "MYSQL",
+	"oci_network_firewall_":              "NETWORK_FIREWALL",
+	"oci_network_load_balancer_":         "NETWORK_LOAD_BALANCER",
+	"oci_nosql_":                         "NOSQL",
+	"oci_objectstorage_":                 "OBJECTSTORAGE",
+	"oci_oce_":                           "OCE",
+	"oci_ocvp_":                          "OCVP",
+	"oci_oda_":                           "ODA",
+	"oci_ons_":                           "ONS",
+	"oci_opa_":                           "OPA",
+	"oci_opensearch_":                    "OPENSEARCH",
+	"oci_operator_access_control_":       "OPERATOR_ACCESS_CONTROL",
+	"oci_opsi_":                          "OPSI",
+	"oci_optimizer_":                     "OPTIMIZER",
+	"oci_os_management_hub_":             "OS_MANAGEMENT_HUB",
+	"oci_osmanagement_":                  "OSMANAGEMENT",
+	"oci_osp_gateway_":                   "OSP_GATEWAY",
+	"oci_psql_":                          "PSQL",
+	"oci_queue_":                         "QUEUE",
+	"oci_recovery_":                      "RECOVERY",
+	"oci_redis_":                         "REDIS",
+	"oci_resource_scheduler_":            "RESOURCE_SCHEDULER",
+	"oci_resourcemanager_":               "RESOURCEMANAGER",
+	"oci_sch_":                           "SCH",
+	"oci_security_attribute_":            "SECURITY_ATTRIBUTE",
+	"oci_service_catalog_":               "SERVICE_CATALOG",
+	"oci_service_mesh_":                  "SERVICE_MESH",
+	"oci_stack_monitoring_":              "STACK_MONITORING",
+	"oci_streaming_":                     "STREAMING",
+	"oci_usage_proxy_":                   "USAGE_PROXY",
+	"oci_vault_":                         "VAULT",
+	"oci_vbs_inst_":                      "VBS_INST",
+	"oci_visual_builder_":                "VISUAL_BUILDER",
+	"oci_vn_monitoring_":                 "VN_MONITORING",
+	"oci_vulnerability_scanning_":        "VULNERABILITY_SCANNING",
+	"oci_waa_":                           "WAA",
+	"oci_waas_":                          "WAAS",
+	"oci_waf_":                           "WAF",
+	"oci_zpr_":                           "ZPR",
+}
+
+// applyTimeoutOverrides replaces the default Create/Update/Delete timeouts of a resource with the ones found in the
+// environment. For "oci_database_autonomous_database" the create timeout is taken from the first valid value of
+// DATABASE_AUTONOMOUS_DATABASE_CREATE_TIMEOUT and DATABASE_CREATE_TIMEOUT, each looked up with the TF_VAR_ and OCI_
+// prefixes and unprefixed. Invalid values are ignored. Timeouts configured in a resource's timeouts block still take precedence.
+func applyTimeoutOverrides(name string, resourceSchema *schema.Resource) {
+	if resourceSchema == nil || resourceSchema.Timeouts == nil {
+		return
+	}
+
+	// Timeouts are commonly shared across resources (e.g. DefaultTimeout), so only a copy can be modified
+	timeouts := *resourceSchema.Timeouts
+	overridden := false
+	if timeouts.Create != nil {
+		overridden = overrideTimeout(name, createTimeoutSuffix, &timeouts.Create) || overridden
+	}
+	if timeouts.Update != nil {
+		overridden = overrideTimeout(name, updateTimeoutSuffix, &timeouts.Update) || overridden
+	}
+	if timeouts.Delete != nil {
+		overridden = overrideTimeout(name, deleteTimeoutSuffix, &timeouts.Delete) || overridden
+	}
+	if overridden {
+		resourceSchema.Timeouts = &timeouts
+	}
+}
+
+func overrideTimeout(name string, suffix string, timeout **time.Duration) bool {
+	for _, prefix := range timeoutEnvPrefixes(name) {
+		value := utils.GetEnvSettingWithBlankDefault(prefix + suffix)
+		if value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(value)
+		if err != nil || duration <= 0 {
+			log.Printf("[WARN] ignoring invalid timeout %q set for %s%s", value, prefix, suffix)
+			continue
+		}
+		*timeout = &duration
+		return true
+	}
+	return false
+}
+
+// timeoutEnvPrefixes returns the environment variable prefixes for a resource, the resource itself first and then its service:
+// "oci_database_tools_connection" => ["DATABASE_TOOLS_CONNECTION", "DATABASE_TOOLS"]
+func timeoutEnvPrefixes(name string) []string {
+	prefixes := []string{strings.ToUpper(strings.TrimPrefix(name, "oci_"))}
+
+	servicePrefix := ""
+	for resourcePrefix := range timeoutServices {
+		if strings.HasPrefix(name, resourcePrefix) && len(resourcePrefix) > len(servicePrefix) {
+			servicePrefix = resourcePrefix
+		}
+	}
+	if servicePrefix != "" {
+		prefixes = append(prefixes, timeoutServices[servicePrefix])
+	}
+	return prefixes
+}
@@ -0,0 +1,54 @@
+package tfresource
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitTimeoutEnvPrefixes(t *testing.T) {
+	tests := map[string][]string{
+		"oci_load_balancer_backend_set":    {"LOAD_BALANCER_BACKEND_SET", "LOAD_BALANCER"},
+		"oci_database_autonomous_database": {"DATABASE_AUTONOMOUS_DATABASE", "DATABASE"},
+		"oci_database_tools_connection":    {"DATABASE_TOOLS_CONNECTION", "DATABASE_TOOLS"},
+		"oci_unknown_resource":             {"UNKNOWN_RESOURCE"},
+	}
+	for name, want := range tests {
+		if got := timeoutEnvPrefixes(name); !reflect.DeepEqual(got, want) {
+			t.Errorf("timeoutEnvPrefixes(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestUnitApplyTimeoutOverrides(t *testing.T) {
+	t.Setenv("OCI_DATABASE_CREATE_TIMEOUT", "6h")
+	t.Setenv("OCI_DATABASE_AUTONOMOUS_DATABASE_DELETE_TIMEOUT", "3h")
+	t.Setenv("OCI_DATABASE_UPDATE_TIMEOUT", "invalid")
+	t.Setenv("OCI_DATABASE_DELETE_TIMEOUT", "1h")
+	t.Setenv("OCI_DATABASE_AUTONOMOUS_DATABASE_CREATE_TIMEOUT", "invalid")
+
+	resourceSchema := &schema.Resource{Timeouts: DefaultTimeout}
+	applyTimeoutOverrides("oci_database_autonomous_database", resourceSchema)
+
+	if *resourceSchema.Timeouts.Create != 6*time.Hour {
+		t.Errorf("expected create timeout to be 6h, got %v", *resourceSchema.Timeouts.Create)
+	}
+	if *resourceSchema.Timeouts.Update != TwentyMinutes {
+		t.Errorf("expected update timeout to keep the default, got %v", *resourceSchema.Timeouts.Update)
+	}
+	if *resourceSchema.Timeouts.Delete != 3*time.Hour {
+		t.Errorf("expected delete timeout to be 3h, got %v", *resourceSchema.Timeouts.Delete)
+	}
+	if *DefaultTimeout.Create != TwentyMinutes {
+		t.Errorf("expected the shared DefaultTimeout not to be modified")
+	}
+
+	databaseToolsSchema := &schema.Resource{Timeouts: DefaultTimeout}
+	applyTimeoutOverrides("oci_database_tools_connection", databaseToolsSchema)
+
+	if *databaseToolsSchema.Timeouts.Create != TwentyMinutes {
+		t.Errorf("expected the database timeouts not to apply to database_tools, got %v", *databaseToolsSchema.Timeouts.Create)
+	}
+}